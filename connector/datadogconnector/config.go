@@ -75,6 +75,22 @@ type TracesConfig struct {
 
 	// ResourceAttributesAsContainerTags specifies the list of resource attributes to be used as container tags.
 	ResourceAttributesAsContainerTags []string `mapstructure:"resource_attributes_as_container_tags"`
+
+	// SamplingRateAttribute is the name of a span or resource attribute holding the rate, in (0, 1], at which
+	// the span was sampled upstream. When set, each span counts as 1/rate hits in the computed stats so they
+	// reflect the unsampled population. Span attributes take precedence over resource attributes.
+	// If unset, every span counts as a single hit.
+	SamplingRateAttribute string `mapstructure:"sampling_rate_attribute"`
+
+	// AgentVersion overrides the agent version reported in the APM stats payloads computed by the connector.
+	// If unset, the Datadog exporter reports a version derived from the collector build info.
+	AgentVersion string `mapstructure:"agent_version"`
+
+	// AggregationGranularity controls how finely the computed APM stats are bucketed.
+	// Valid values are `span`, which keeps the default Datadog Agent buckets, `operation`, which merges
+	// the stats of a service operation regardless of resource and status code, and `service`, which
+	// merges all the stats of a service into a single bucket. The default value is `span`.
+	AggregationGranularity string `mapstructure:"aggregation_granularity"`
 }
 
 // Validate the configuration for errors. This is required by component.Config.
@@ -103,5 +119,11 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("Trace buffer must be non-negative")
 	}
 
+	switch c.Traces.AggregationGranularity {
+	case "", aggregationSpan, aggregationOperation, aggregationService:
+	default:
+		return fmt.Errorf("%q is not a valid aggregation granularity; must be one of %q, %q or %q", c.Traces.AggregationGranularity, aggregationSpan, aggregationOperation, aggregationService)
+	}
+
 	return nil
 }
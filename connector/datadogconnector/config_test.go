@@ -70,6 +70,19 @@ func TestValidate(t *testing.T) {
 				Traces: TracesConfig{PeerTags: []string{"tag1", "tag2"}},
 			},
 		},
+		{
+			name: "With aggregation_granularity",
+			cfg: &Config{
+				Traces: TracesConfig{AggregationGranularity: "service"},
+			},
+		},
+		{
+			name: "invalid aggregation_granularity",
+			cfg: &Config{
+				Traces: TracesConfig{AggregationGranularity: "resource"},
+			},
+			err: "\"resource\" is not a valid aggregation granularity; must be one of \"span\", \"operation\" or \"service\"",
+		},
 	}
 	for _, testInstance := range tests {
 		t.Run(testInstance.name, func(t *testing.T) {
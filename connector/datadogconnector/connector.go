@@ -6,6 +6,7 @@ package datadogconnector // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/patrickmn/go-cache"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	semconv "go.opentelemetry.io/collector/semconv/v1.17.0"
@@ -43,6 +45,15 @@ type traceToMetricConnector struct {
 	enrichedTags      map[string]string
 	containerTagCache *cache.Cache
 
+	// samplingRateAttribute is the attribute holding the upstream sampling rate of a span.
+	samplingRateAttribute string
+
+	// agentVersion, if set, overrides the agent version reported in the stats payloads.
+	agentVersion string
+
+	// aggregationGranularity controls how finely the stats are bucketed, see aggregateStats.
+	aggregationGranularity string
+
 	// in specifies the channel through which the agent will output Stats Payloads
 	// resulting from ingested traces.
 	in chan *pb.StatsPayload
@@ -56,6 +67,9 @@ type traceToMetricConnector struct {
 
 var _ component.Component = (*traceToMetricConnector)(nil) // testing that the connectorImp properly implements the type Component interface
 
+// keySamplingRate is the span metric the trace agent reads to weight the stats of a span.
+const keySamplingRate = "_sample_rate"
+
 // cacheExpiration is the time after which a container tag cache entry will expire
 // and be removed from the cache.
 var cacheExpiration = time.Minute * 5
@@ -95,6 +109,11 @@ func newTraceToMetricConnector(set component.TelemetrySettings, cfg component.Co
 		enrichedTags:      ctags,
 		containerTagCache: cache.New(cacheExpiration, cacheCleanupInterval),
 		exit:              make(chan struct{}),
+
+		samplingRateAttribute: cfg.(*Config).Traces.SamplingRateAttribute,
+		agentVersion:          cfg.(*Config).Traces.AgentVersion,
+
+		aggregationGranularity: cfg.(*Config).Traces.AggregationGranularity,
 	}, nil
 }
 
@@ -187,10 +206,66 @@ func (c *traceToMetricConnector) populateContainerTagsCache(traces ptrace.Traces
 
 func (c *traceToMetricConnector) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
 	c.populateContainerTagsCache(traces)
+	if c.samplingRateAttribute != "" {
+		traces = c.weightBySamplingRate(traces)
+	}
 	c.agent.Ingest(ctx, traces)
 	return nil
 }
 
+// weightBySamplingRate returns a copy of traces where spans carrying the configured sampling rate attribute,
+// on the span or its resource, have it set as the `_sample_rate` metric. The trace agent then counts each
+// of those spans as 1/rate hits. A copy is made since the connector does not mutate the data it consumes.
+func (c *traceToMetricConnector) weightBySamplingRate(traces ptrace.Traces) ptrace.Traces {
+	weighted := ptrace.NewTraces()
+	traces.CopyTo(weighted)
+	for i := 0; i < weighted.ResourceSpans().Len(); i++ {
+		rs := weighted.ResourceSpans().At(i)
+		resRate, resOK := samplingRate(rs.Resource().Attributes(), c.samplingRateAttribute)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				rate, ok := samplingRate(span.Attributes(), c.samplingRateAttribute)
+				if !ok {
+					rate, ok = resRate, resOK
+				}
+				if ok {
+					span.Attributes().PutDouble(keySamplingRate, rate)
+				}
+			}
+		}
+	}
+	return weighted
+}
+
+// samplingRate returns the sampling rate held by the given attribute, if it is a number in (0, 1].
+func samplingRate(attrs pcommon.Map, key string) (float64, bool) {
+	v, ok := attrs.Get(key)
+	if !ok {
+		return 0, false
+	}
+	var rate float64
+	switch v.Type() {
+	case pcommon.ValueTypeDouble:
+		rate = v.Double()
+	case pcommon.ValueTypeInt:
+		rate = float64(v.Int())
+	case pcommon.ValueTypeStr:
+		parsed, err := strconv.ParseFloat(v.Str(), 64)
+		if err != nil {
+			return 0, false
+		}
+		rate = parsed
+	default:
+		return 0, false
+	}
+	if rate <= 0 || rate > 1 {
+		return 0, false
+	}
+	return rate, true
+}
+
 func (c *traceToMetricConnector) enrichStatsPayload(stats *pb.StatsPayload) {
 	for _, stat := range stats.Stats {
 		if stat.ContainerID != "" {
@@ -226,6 +301,12 @@ func (c *traceToMetricConnector) run() {
 			if len(c.enrichedTags) > 0 {
 				c.enrichStatsPayload(stats)
 			}
+			if c.agentVersion != "" {
+				stats.AgentVersion = c.agentVersion
+			}
+			if c.aggregationGranularity != "" && c.aggregationGranularity != aggregationSpan {
+				aggregateStats(stats, c.aggregationGranularity, c.logger)
+			}
 
 			c.logger.Debug("Received stats payload", zap.Any("stats", stats))
 
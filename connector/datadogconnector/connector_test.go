@@ -12,6 +12,7 @@ import (
 	pb "github.com/DataDog/datadog-agent/pkg/proto/pbgo/trace"
 	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes"
 	otlpmetrics "github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/metrics"
+	"github.com/DataDog/sketches-go/ddsketch"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -172,6 +173,109 @@ func TestContainerTags(t *testing.T) {
 	assert.ElementsMatch(t, []string{"region:my-region", "zone:my-zone", "az:my-az"}, tags)
 }
 
+// recordingIngester records the traces it ingests.
+type recordingIngester struct {
+	traces []ptrace.Traces
+}
+
+func (r *recordingIngester) Start() {}
+
+func (r *recordingIngester) Ingest(_ context.Context, traces ptrace.Traces) {
+	r.traces = append(r.traces, traces)
+}
+
+func (r *recordingIngester) Stop() {}
+
+func TestSamplingRateAttribute(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutDouble("sampling.rate", 0.5)
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().Attributes().PutDouble("sampling.rate", 0.1)
+	spans.AppendEmpty().Attributes().PutStr("sampling.rate", "0.25")
+	spans.AppendEmpty() // inherits the resource sampling rate
+	spans.AppendEmpty().Attributes().PutDouble("sampling.rate", 2)
+
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Traces.SamplingRateAttribute = "sampling.rate"
+	conn, err := NewFactory().CreateTracesToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	c := conn.(*traceToMetricConnector)
+	ingester := &recordingIngester{}
+	c.agent = ingester
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), td))
+	require.Len(t, ingester.traces, 1)
+
+	var rates []float64
+	ingested := ingester.traces[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	for i := 0; i < ingested.Len(); i++ {
+		rate, ok := ingested.At(i).Attributes().Get(keySamplingRate)
+		if !ok {
+			rates = append(rates, 0)
+			continue
+		}
+		rates = append(rates, rate.Double())
+	}
+	// The out of range span rate falls back to the resource sampling rate.
+	assert.Equal(t, []float64{0.1, 0.25, 0.5, 0.5}, rates)
+
+	// The consumed traces are left untouched.
+	_, ok := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().Get(keySamplingRate)
+	assert.False(t, ok)
+}
+
+func TestAggregateStats(t *testing.T) {
+	newSketch := func(values ...float64) []byte {
+		s, err := ddsketch.NewDefaultDDSketch(0.01)
+		require.NoError(t, err)
+		for _, v := range values {
+			require.NoError(t, s.Add(v))
+		}
+		return encodeSketch(s)
+	}
+	newPayload := func() *pb.StatsPayload {
+		return &pb.StatsPayload{Stats: []*pb.ClientStatsPayload{{Stats: []*pb.ClientStatsBucket{{Stats: []*pb.ClientGroupedStats{
+			{Service: "svc", Name: "http.request", Resource: "GET /a", HTTPStatusCode: 200, Hits: 2, Duration: 20, OkSummary: newSketch(10, 10)},
+			{Service: "svc", Name: "http.request", Resource: "GET /b", HTTPStatusCode: 500, Hits: 1, Errors: 1, Duration: 30, ErrorSummary: newSketch(30)},
+			{Service: "svc", Name: "db.query", Resource: "SELECT", Hits: 3, Duration: 3, OkSummary: newSketch(1, 1, 1)},
+			{Service: "other", Name: "db.query", Resource: "SELECT", Hits: 1, Duration: 1, OkSummary: newSketch(1)},
+		}}}}}}
+	}
+
+	t.Run("operation", func(t *testing.T) {
+		stats := newPayload()
+		aggregateStats(stats, aggregationOperation, zap.NewNop())
+		got := stats.Stats[0].Stats[0].Stats
+		require.Len(t, got, 3)
+		assert.Equal(t, "http.request", got[0].Name)
+		assert.Empty(t, got[0].Resource)
+		assert.Zero(t, got[0].HTTPStatusCode)
+		assert.Equal(t, uint64(3), got[0].Hits)
+		assert.Equal(t, uint64(1), got[0].Errors)
+		assert.Equal(t, uint64(50), got[0].Duration)
+	})
+
+	t.Run("service", func(t *testing.T) {
+		stats := newPayload()
+		aggregateStats(stats, aggregationService, zap.NewNop())
+		got := stats.Stats[0].Stats[0].Stats
+		require.Len(t, got, 2)
+		assert.Equal(t, "svc", got[0].Service)
+		assert.Empty(t, got[0].Name)
+		assert.Equal(t, uint64(6), got[0].Hits)
+		assert.Equal(t, uint64(53), got[0].Duration)
+		okSummary, err := mergeSketch(nil, got[0].OkSummary)
+		require.NoError(t, err)
+		assert.Equal(t, float64(5), okSummary.GetCount())
+		errorSummary, err := mergeSketch(nil, got[0].ErrorSummary)
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), errorSummary.GetCount())
+		assert.Equal(t, "other", got[1].Service)
+		assert.Equal(t, uint64(1), got[1].Hits)
+	})
+}
+
 func newTranslatorWithStatsChannel(t *testing.T, logger *zap.Logger, ch chan []byte) *otlpmetrics.Translator {
 	options := []otlpmetrics.TranslatorOption{
 		otlpmetrics.WithHistogramMode(otlpmetrics.HistogramModeDistributions),
@@ -8,6 +8,7 @@ require (
 	github.com/DataDog/datadog-go/v5 v5.5.0
 	github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes v0.16.0
 	github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/metrics v0.16.0
+	github.com/DataDog/sketches-go v1.4.5
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter v0.101.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/datadog v0.101.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor v0.101.0
@@ -91,7 +92,6 @@ require (
 	github.com/DataDog/opentelemetry-mapping-go/pkg/inframetadata v0.16.0 // indirect
 	github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/logs v0.16.0 // indirect
 	github.com/DataDog/opentelemetry-mapping-go/pkg/quantile v0.16.0 // indirect
-	github.com/DataDog/sketches-go v1.4.5 // indirect
 	github.com/DataDog/viper v1.13.3 // indirect
 	github.com/DataDog/zstd v1.5.2 // indirect
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/detectors/gcp v1.23.0 // indirect
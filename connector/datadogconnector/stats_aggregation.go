@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/datadogconnector"
+
+import (
+	pb "github.com/DataDog/datadog-agent/pkg/proto/pbgo/trace"
+	"github.com/DataDog/sketches-go/ddsketch"
+	"github.com/DataDog/sketches-go/ddsketch/pb/sketchpb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// aggregationSpan keeps the stats buckets computed by the trace agent.
+	aggregationSpan = "span"
+	// aggregationOperation merges the stats of each operation of a service.
+	aggregationOperation = "operation"
+	// aggregationService merges the stats of each service.
+	aggregationService = "service"
+)
+
+// aggregationKey holds the dimensions that are kept for a given aggregation granularity.
+type aggregationKey struct {
+	service    string
+	name       string
+	spanType   string
+	spanKind   string
+	synthetics bool
+}
+
+func newAggregationKey(gs *pb.ClientGroupedStats, granularity string) aggregationKey {
+	if granularity == aggregationService {
+		return aggregationKey{service: gs.Service}
+	}
+	return aggregationKey{
+		service:    gs.Service,
+		name:       gs.Name,
+		spanType:   gs.Type,
+		spanKind:   gs.SpanKind,
+		synthetics: gs.Synthetics,
+	}
+}
+
+// aggregatedStats holds the merged stats of an aggregation key, with decoded latency sketches.
+type aggregatedStats struct {
+	stats        *pb.ClientGroupedStats
+	okSummary    *ddsketch.DDSketch
+	errorSummary *ddsketch.DDSketch
+}
+
+// aggregateStats merges, within each time bucket of stats, the grouped stats that share the
+// dimensions kept by granularity. Dimensions that are not kept are left empty.
+func aggregateStats(stats *pb.StatsPayload, granularity string, logger *zap.Logger) {
+	for _, csp := range stats.Stats {
+		for _, bucket := range csp.Stats {
+			var keys []aggregationKey
+			aggregated := make(map[aggregationKey]*aggregatedStats)
+			for _, gs := range bucket.Stats {
+				key := newAggregationKey(gs, granularity)
+				agg, ok := aggregated[key]
+				if !ok {
+					agg = &aggregatedStats{stats: &pb.ClientGroupedStats{
+						Service:    key.service,
+						Name:       key.name,
+						Type:       key.spanType,
+						SpanKind:   key.spanKind,
+						Synthetics: key.synthetics,
+					}}
+					aggregated[key] = agg
+					keys = append(keys, key)
+				}
+				agg.stats.Hits += gs.Hits
+				agg.stats.Errors += gs.Errors
+				agg.stats.Duration += gs.Duration
+				agg.stats.TopLevelHits += gs.TopLevelHits
+				var err error
+				if agg.okSummary, err = mergeSketch(agg.okSummary, gs.OkSummary); err != nil {
+					logger.Debug("Failed to merge ok summary", zap.Error(err))
+				}
+				if agg.errorSummary, err = mergeSketch(agg.errorSummary, gs.ErrorSummary); err != nil {
+					logger.Debug("Failed to merge error summary", zap.Error(err))
+				}
+			}
+
+			bucket.Stats = make([]*pb.ClientGroupedStats, 0, len(keys))
+			for _, key := range keys {
+				agg := aggregated[key]
+				agg.stats.OkSummary = encodeSketch(agg.okSummary)
+				agg.stats.ErrorSummary = encodeSketch(agg.errorSummary)
+				bucket.Stats = append(bucket.Stats, agg.stats)
+			}
+		}
+	}
+}
+
+// mergeSketch merges the encoded sketch data into s, which may be nil.
+func mergeSketch(s *ddsketch.DDSketch, data []byte) (*ddsketch.DDSketch, error) {
+	if len(data) == 0 {
+		return s, nil
+	}
+	var msg sketchpb.DDSketch
+	if err := proto.Unmarshal(data, &msg); err != nil {
+		return s, err
+	}
+	other, err := ddsketch.FromProto(&msg)
+	if err != nil {
+		return s, err
+	}
+	if s == nil {
+		return other, nil
+	}
+	return s, s.MergeWith(other)
+}
+
+func encodeSketch(s *ddsketch.DDSketch) []byte {
+	if s == nil {
+		return nil
+	}
+	data, err := proto.Marshal(s.ToProto())
+	if err != nil {
+		return nil
+	}
+	return data
+}
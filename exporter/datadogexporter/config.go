@@ -307,6 +307,18 @@ type TracesConfig struct {
 	// https://github.com/DataDog/datadog-agent/blob/505170c4ac8c3cbff1a61cf5f84b28d835c91058/pkg/trace/stats/concentrator.go#L55.
 	PeerTags []string `mapstructure:"peer_tags"`
 
+	// Env, if set, is used as the Datadog env tag on all spans and APM stats, taking precedence
+	// over any env found in resource attributes.
+	Env string `mapstructure:"env"`
+
+	// EnvAttribute is the name of a resource attribute holding the Datadog env tag. It takes
+	// precedence over `deployment.environment` but not over Env.
+	EnvAttribute string `mapstructure:"env_attribute"`
+
+	// StatsCompression is the compression used for APM stats payloads, independently of traces.
+	// Valid values are `gzip` and `none`. The default value is `gzip`.
+	StatsCompression string `mapstructure:"stats_compression"`
+
 	// TraceBuffer specifies the number of Datadog Agent TracerPayloads to buffer before dropping.
 	// The default value is 0, meaning the Datadog Agent TracerPayloads are unbuffered.
 	TraceBuffer int `mapstructure:"trace_buffer"`
@@ -382,6 +394,41 @@ func (sm *HostnameSource) UnmarshalText(in []byte) error {
 	}
 }
 
+// OnMissingHost is the policy applied to traces whose resource lacks hostname-identifying attributes.
+type OnMissingHost string
+
+const (
+	// OnMissingHostUseHostname tags the traces with the fallback hostname from the 'hostname'
+	// setting or, if it is empty, from available system APIs and cloud provider endpoints.
+	OnMissingHostUseHostname OnMissingHost = "use_hostname"
+
+	// OnMissingHostUseAttribute tags the traces with the value of the resource attribute
+	// set in 'host_metadata::host_attribute', and falls back to 'use_hostname' if it is missing.
+	OnMissingHostUseAttribute OnMissingHost = "use_attribute"
+
+	// OnMissingHostFail rejects the traces with a permanent error.
+	OnMissingHostFail OnMissingHost = "fail"
+
+	// OnMissingHostOmit sends the traces without a hostname.
+	OnMissingHostOmit OnMissingHost = "omit"
+)
+
+var _ encoding.TextUnmarshaler = (*OnMissingHost)(nil)
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (p *OnMissingHost) UnmarshalText(in []byte) error {
+	switch policy := OnMissingHost(in); policy {
+	case OnMissingHostUseHostname,
+		OnMissingHostUseAttribute,
+		OnMissingHostFail,
+		OnMissingHostOmit:
+		*p = policy
+		return nil
+	default:
+		return fmt.Errorf("invalid host metadata on missing host policy %q", policy)
+	}
+}
+
 // HostMetadataConfig defines the host metadata related configuration.
 // Host metadata is the information used for populating the infrastructure list,
 // the host map and providing host tags functionality.
@@ -408,6 +455,21 @@ type HostMetadataConfig struct {
 	// The default is 'config_or_system'.
 	HostnameSource HostnameSource `mapstructure:"hostname_source"`
 
+	// OnMissingHost is the policy applied to traces whose resource lacks hostname-identifying attributes.
+	//
+	// Valid values are 'use_hostname', 'use_attribute', 'fail' and 'omit':
+	// - 'use_hostname' tags the traces with the fallback hostname (see the 'hostname' setting).
+	// - 'use_attribute' tags the traces with the value of the resource attribute set in 'host_attribute'.
+	//    If the attribute is missing, it will fallback to 'use_hostname'.
+	// - 'fail' rejects the traces with a permanent error.
+	// - 'omit' sends the traces without a hostname.
+	//
+	// The default is 'use_hostname'.
+	OnMissingHost OnMissingHost `mapstructure:"on_missing_host"`
+
+	// HostAttribute is the resource attribute holding the hostname when 'on_missing_host' is 'use_attribute'.
+	HostAttribute string `mapstructure:"host_attribute"`
+
 	// Tags is a list of host tags.
 	// These tags will be attached to telemetry signals that have the host metadata hostname.
 	// To attach tags to telemetry signals regardless of the host, use a processor instead.
@@ -469,6 +531,10 @@ func (c *Config) Validate() error {
 		return errNoMetadata
 	}
 
+	if c.HostMetadata.OnMissingHost == OnMissingHostUseAttribute && c.HostMetadata.HostAttribute == "" {
+		return errors.New("host_metadata::host_attribute must be set when host_metadata::on_missing_host is use_attribute")
+	}
+
 	if err := valid.Hostname(c.Hostname); c.Hostname != "" && err != nil {
 		return fmt.Errorf("hostname field is invalid: %w", err)
 	}
@@ -497,6 +563,12 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	switch c.Traces.StatsCompression {
+	case "", statsCompressionGzip, statsCompressionNone:
+	default:
+		return fmt.Errorf("'%s' is not a valid traces::stats_compression; must be one of %q or %q", c.Traces.StatsCompression, statsCompressionGzip, statsCompressionNone)
+	}
+
 	err := c.Metrics.HistConfig.validate()
 	if err != nil {
 		return err
@@ -121,6 +121,36 @@ func TestValidate(t *testing.T) {
 				Traces: TracesConfig{TraceBuffer: 10},
 			},
 		},
+		{
+			name: "With stats_compression",
+			cfg: &Config{
+				API:    APIConfig{Key: "notnull"},
+				Traces: TracesConfig{StatsCompression: "none"},
+			},
+		},
+		{
+			name: "invalid stats_compression",
+			cfg: &Config{
+				API:    APIConfig{Key: "notnull"},
+				Traces: TracesConfig{StatsCompression: "zstd"},
+			},
+			err: "'zstd' is not a valid traces::stats_compression; must be one of \"gzip\" or \"none\"",
+		},
+		{
+			name: "use_attribute on_missing_host",
+			cfg: &Config{
+				API:          APIConfig{Key: "notnull"},
+				HostMetadata: HostMetadataConfig{OnMissingHost: OnMissingHostUseAttribute, HostAttribute: "node.name"},
+			},
+		},
+		{
+			name: "use_attribute on_missing_host without host_attribute",
+			cfg: &Config{
+				API:          APIConfig{Key: "notnull"},
+				HostMetadata: HostMetadataConfig{OnMissingHost: OnMissingHostUseAttribute},
+			},
+			err: "host_metadata::host_attribute must be set when host_metadata::on_missing_host is use_attribute",
+		},
 		{
 			name: "With peer_tags",
 			cfg: &Config{
@@ -218,6 +248,15 @@ func TestUnmarshal(t *testing.T) {
 			}),
 			err: "1 error(s) decoding:\n\n* error decoding 'host_metadata.hostname_source': invalid host metadata hostname source \"invalid_source\"",
 		},
+		{
+			name: "invalid host metadata on missing host policy",
+			configMap: confmap.NewFromStringMap(map[string]any{
+				"host_metadata": map[string]any{
+					"on_missing_host": "invalid_policy",
+				},
+			}),
+			err: "1 error(s) decoding:\n\n* error decoding 'host_metadata.on_missing_host': invalid host metadata on missing host policy \"invalid_policy\"",
+		},
 		{
 			name: "invalid summary mode",
 			configMap: confmap.NewFromStringMap(map[string]any{
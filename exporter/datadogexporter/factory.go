@@ -238,6 +238,7 @@ func (f *factory) createDefaultConfig() component.Config {
 		HostMetadata: HostMetadataConfig{
 			Enabled:        true,
 			HostnameSource: HostnameSourceConfigOrSystem,
+			OnMissingHost:  OnMissingHostUseHostname,
 		},
 	}
 }
@@ -275,8 +276,10 @@ func (f *factory) consumeStatsPayload(ctx context.Context, statsIn <-chan []byte
 							csp.TracerVersion = tracerVersion
 						}
 					}
-					// The DD Connector doesn't set the agent version, so we'll set it here
-					sp.AgentVersion = agentVersion
+					// The DD Connector only sets the agent version when it is configured to, so we'll set it here otherwise
+					if sp.AgentVersion == "" {
+						sp.AgentVersion = agentVersion
+					}
 					statsToAgent <- sp
 				}
 			}
@@ -314,9 +317,14 @@ func (f *factory) createMetricsExporter(
 	statsToAgent := make(chan *pb.StatsPayload)
 	metricsClient := datadog.InitializeMetricClient(set.MeterProvider, datadog.ExporterSourceTag)
 	timingReporter := timing.New(metricsClient)
-	statsWriter := writer.NewStatsWriter(acfg, statsToAgent, telemetry.NewNoopCollector(), metricsClient, timingReporter)
-
-	set.Logger.Debug("Starting Datadog Trace-Agent StatsWriter")
+	var statsWriter apmStatsWriter
+	if cfg.Traces.StatsCompression == statsCompressionNone {
+		statsWriter = newUncompressedStatsWriter(set, cfg, statsToAgent)
+		set.Logger.Debug("Starting uncompressed APM StatsWriter")
+	} else {
+		statsWriter = writer.NewStatsWriter(acfg, statsToAgent, telemetry.NewNoopCollector(), metricsClient, timingReporter)
+		set.Logger.Debug("Starting Datadog Trace-Agent StatsWriter")
+	}
 	go statsWriter.Run()
 
 	statsIn := make(chan []byte, 1000)
@@ -459,7 +467,7 @@ func (f *factory) createTracesExporter(
 			return nil
 		}
 	} else {
-		tracex, err2 := newTracesExporter(ctx, set, cfg, &f.onceMetadata, hostProvider, traceagent, metadataReporter)
+		tracex, err2 := newTracesExporter(ctx, set, cfg, &f.onceMetadata, hostProvider, traceagent, metadataReporter, attrsTranslator)
 		if err2 != nil {
 			cancel()
 			f.wg.Wait() // then wait for shutdown
@@ -115,6 +115,7 @@ func TestCreateDefaultConfig(t *testing.T) {
 		HostMetadata: HostMetadataConfig{
 			Enabled:        true,
 			HostnameSource: HostnameSourceConfigOrSystem,
+			OnMissingHost:  OnMissingHostUseHostname,
 		},
 		OnlyMetadata: false,
 	}, cfg, "failed to create default config")
@@ -174,6 +175,7 @@ func TestCreateDefaultConfigLogsAgent(t *testing.T) {
 		HostMetadata: HostMetadataConfig{
 			Enabled:        true,
 			HostnameSource: HostnameSourceConfigOrSystem,
+			OnMissingHost:  OnMissingHostUseHostname,
 		},
 		OnlyMetadata: false,
 	}, cfg, "failed to create default config")
@@ -240,6 +242,7 @@ func TestLoadConfig(t *testing.T) {
 				HostMetadata: HostMetadataConfig{
 					Enabled:        true,
 					HostnameSource: HostnameSourceConfigOrSystem,
+					OnMissingHost:  OnMissingHostUseHostname,
 				},
 				OnlyMetadata: false,
 			},
@@ -299,6 +302,7 @@ func TestLoadConfig(t *testing.T) {
 				HostMetadata: HostMetadataConfig{
 					Enabled:        true,
 					HostnameSource: HostnameSourceConfigOrSystem,
+					OnMissingHost:  OnMissingHostUseHostname,
 				},
 			},
 		},
@@ -354,6 +358,7 @@ func TestLoadConfig(t *testing.T) {
 				HostMetadata: HostMetadataConfig{
 					Enabled:        true,
 					HostnameSource: HostnameSourceConfigOrSystem,
+					OnMissingHost:  OnMissingHostUseHostname,
 					Tags:           []string{"example:tag"},
 				},
 			},
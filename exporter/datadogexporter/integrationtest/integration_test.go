@@ -521,3 +521,581 @@ func sendTracesComputeTopLevelBySpanKind(t *testing.T) {
 	}
 	time.Sleep(1 * time.Second)
 }
+
+const collectorConfigSamplingRate = `
+receivers:
+  otlp:
+    protocols:
+      http:
+        endpoint: "localhost:4318"
+      grpc:
+        endpoint: "localhost:4317"
+
+processors:
+  batch:
+    send_batch_size: 10
+    timeout: 5s
+
+connectors:
+  datadog/connector:
+    traces:
+      sampling_rate_attribute: _sample_rate
+
+exporters:
+  debug:
+    verbosity: detailed
+  datadog:
+    api:
+      key: "key"
+    tls:
+      insecure_skip_verify: true
+    host_metadata:
+      enabled: false
+    traces:
+      endpoint: %q
+      trace_buffer: 10
+    metrics:
+      endpoint: %q
+
+service:
+  telemetry:
+    metrics:
+      level: none
+  pipelines:
+    traces:
+      receivers: [otlp]
+      processors: [batch]
+      exporters: [datadog/connector]
+    metrics:
+      receivers: [datadog/connector]
+      processors: [batch]
+      exporters: [datadog, debug]`
+
+func TestIntegrationSamplingRate(t *testing.T) {
+	// 1. Set up mock Datadog server
+	apmstatsRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.APMStatsEndpoint, ReqChan: make(chan []byte)}
+	tracesRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.TraceEndpoint, ReqChan: make(chan []byte)}
+	server := testutil.DatadogServerMock(apmstatsRec.HandlerFunc, tracesRec.HandlerFunc)
+	defer server.Close()
+
+	// 2. Start in-process collector
+	factories := getIntegrationTestComponents(t)
+	app, confFilePath := getIntegrationTestCollector(t, collectorConfigSamplingRate, server.URL, factories)
+	go func() {
+		assert.NoError(t, app.Run(context.Background()))
+	}()
+	defer app.Shutdown()
+	defer os.Remove(confFilePath)
+	waitForReadiness(app)
+
+	// 3. Generate and send traces sampled upstream at a rate of 0.1
+	sendTracesWithSamplingRate(t, 0.1)
+
+	// 4. Validate that APM stats are scaled by the inverse of the sampling rate
+	var stats []*pb.ClientGroupedStats
+	for len(stats) < 5 {
+		select {
+		case <-tracesRec.ReqChan:
+		case apmstatsBytes := <-apmstatsRec.ReqChan:
+			gz := getGzipReader(t, apmstatsBytes)
+			var spl pb.StatsPayload
+			require.NoError(t, msgp.Decode(gz, &spl))
+			for _, csps := range spl.Stats {
+				for _, csbs := range csps.Stats {
+					stats = append(stats, csbs.Stats...)
+					for _, stat := range csbs.Stats {
+						assert.True(t, strings.HasPrefix(stat.Resource, "TestSpan"))
+						assert.Equal(t, uint64(10), stat.Hits)
+						assert.Equal(t, uint64(10), stat.TopLevelHits)
+					}
+				}
+			}
+		}
+	}
+	assert.Len(t, stats, 5)
+}
+
+func sendTracesWithSamplingRate(t *testing.T, rate float64) {
+	ctx := context.Background()
+
+	// Set up OTel-Go SDK and exporter
+	traceExporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithInsecure())
+	require.NoError(t, err)
+	bsp := sdktrace.NewBatchSpanProcessor(traceExporter)
+	r, _ := resource.New(ctx, resource.WithAttributes(attribute.String("k8s.node.name", "aaaa")))
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSpanProcessor(bsp),
+		sdktrace.WithResource(r),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	defer func() {
+		require.NoError(t, tracerProvider.Shutdown(ctx))
+	}()
+
+	tracer := otel.Tracer("test-tracer")
+	for i := 0; i < 5; i++ {
+		_, span := tracer.Start(ctx, fmt.Sprintf("TestSpan%d", i), apitrace.WithSpanKind(apitrace.SpanKindServer))
+		span.SetAttributes(attribute.Float64("_sample_rate", rate))
+		span.End()
+	}
+	time.Sleep(1 * time.Second)
+}
+
+const collectorConfigEnv = `
+receivers:
+  otlp:
+    protocols:
+      http:
+        endpoint: "localhost:4318"
+      grpc:
+        endpoint: "localhost:4317"
+
+processors:
+  batch:
+    send_batch_size: 10
+    timeout: 5s
+
+exporters:
+  datadog:
+    api:
+      key: "key"
+    tls:
+      insecure_skip_verify: true
+    host_metadata:
+      enabled: false
+    traces:
+      endpoint: %q
+      trace_buffer: 10
+__TRACES_CONFIG__
+    metrics:
+      endpoint: %q
+
+service:
+  telemetry:
+    metrics:
+      level: none
+  pipelines:
+    traces:
+      receivers: [otlp]
+      processors: [batch]
+      exporters: [datadog]`
+
+func TestIntegrationEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		tracesCfg   string
+		resAttrs    []attribute.KeyValue
+		expectedEnv string
+	}{
+		{
+			name:        "static env",
+			tracesCfg:   "      env: static-env\n      env_attribute: team.env",
+			resAttrs:    []attribute.KeyValue{attribute.String("team.env", "attr-env"), attribute.String("deployment.environment", "deployment-env")},
+			expectedEnv: "static-env",
+		},
+		{
+			name:        "env attribute",
+			tracesCfg:   "      env_attribute: team.env",
+			resAttrs:    []attribute.KeyValue{attribute.String("team.env", "attr-env"), attribute.String("deployment.environment", "deployment-env")},
+			expectedEnv: "attr-env",
+		},
+		{
+			name:        "env attribute missing",
+			tracesCfg:   "      env_attribute: team.env",
+			resAttrs:    []attribute.KeyValue{attribute.String("deployment.environment", "deployment-env")},
+			expectedEnv: "deployment-env",
+		},
+		{
+			name:        "default",
+			resAttrs:    []attribute.KeyValue{attribute.String("k8s.node.name", "aaaa")},
+			expectedEnv: "none",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 1. Set up mock Datadog server
+			apmstatsRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.APMStatsEndpoint, ReqChan: make(chan []byte)}
+			tracesRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.TraceEndpoint, ReqChan: make(chan []byte)}
+			server := testutil.DatadogServerMock(apmstatsRec.HandlerFunc, tracesRec.HandlerFunc)
+			defer server.Close()
+
+			// 2. Start in-process collector
+			factories := getIntegrationTestComponents(t)
+			cfgStr := strings.Replace(collectorConfigEnv, "__TRACES_CONFIG__", tt.tracesCfg, 1)
+			app, confFilePath := getIntegrationTestCollector(t, cfgStr, server.URL, factories)
+			go func() {
+				assert.NoError(t, app.Run(context.Background()))
+			}()
+			defer app.Shutdown()
+			defer os.Remove(confFilePath)
+			waitForReadiness(app)
+
+			// 3. Generate and send traces
+			sendTracesWithResource(t, tt.resAttrs...)
+
+			// 4. Validate the env of the exported spans and APM stats
+			var gotTraces, gotStats bool
+			for !gotTraces || !gotStats {
+				select {
+				case tracesBytes := <-tracesRec.ReqChan:
+					gz := getGzipReader(t, tracesBytes)
+					slurp, err := io.ReadAll(gz)
+					require.NoError(t, err)
+					var traces pb.AgentPayload
+					require.NoError(t, proto.Unmarshal(slurp, &traces))
+					for _, tps := range traces.TracerPayloads {
+						assert.Equal(t, tt.expectedEnv, tps.Env)
+						gotTraces = true
+					}
+				case apmstatsBytes := <-apmstatsRec.ReqChan:
+					gz := getGzipReader(t, apmstatsBytes)
+					var spl pb.StatsPayload
+					require.NoError(t, msgp.Decode(gz, &spl))
+					for _, csps := range spl.Stats {
+						assert.Equal(t, tt.expectedEnv, csps.Env)
+						gotStats = true
+					}
+				}
+			}
+		})
+	}
+}
+
+func sendTracesWithResource(t *testing.T, resAttrs ...attribute.KeyValue) {
+	ctx := context.Background()
+
+	// Set up OTel-Go SDK and exporter
+	traceExporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithInsecure())
+	require.NoError(t, err)
+	bsp := sdktrace.NewBatchSpanProcessor(traceExporter)
+	r, _ := resource.New(ctx, resource.WithAttributes(resAttrs...))
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSpanProcessor(bsp),
+		sdktrace.WithResource(r),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	defer func() {
+		require.NoError(t, tracerProvider.Shutdown(ctx))
+	}()
+
+	tracer := otel.Tracer("test-tracer")
+	_, span := tracer.Start(ctx, "TestSpan", apitrace.WithSpanKind(apitrace.SpanKindServer))
+	span.End()
+	time.Sleep(1 * time.Second)
+}
+
+const collectorConfigAgentVersion = `
+receivers:
+  otlp:
+    protocols:
+      http:
+        endpoint: "localhost:4318"
+      grpc:
+        endpoint: "localhost:4317"
+
+processors:
+  batch:
+    send_batch_size: 10
+    timeout: 5s
+
+connectors:
+  datadog/connector:
+    traces:
+      agent_version: custom-agent-version
+
+exporters:
+  datadog:
+    api:
+      key: "key"
+    tls:
+      insecure_skip_verify: true
+    host_metadata:
+      enabled: false
+    traces:
+      endpoint: %q
+      trace_buffer: 10
+    metrics:
+      endpoint: %q
+
+service:
+  telemetry:
+    metrics:
+      level: none
+  pipelines:
+    traces:
+      receivers: [otlp]
+      processors: [batch]
+      exporters: [datadog/connector]
+    metrics:
+      receivers: [datadog/connector]
+      processors: [batch]
+      exporters: [datadog]`
+
+func TestIntegrationAgentVersion(t *testing.T) {
+	// 1. Set up mock Datadog server
+	apmstatsRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.APMStatsEndpoint, ReqChan: make(chan []byte)}
+	tracesRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.TraceEndpoint, ReqChan: make(chan []byte)}
+	server := testutil.DatadogServerMock(apmstatsRec.HandlerFunc, tracesRec.HandlerFunc)
+	defer server.Close()
+
+	// 2. Start in-process collector
+	factories := getIntegrationTestComponents(t)
+	app, confFilePath := getIntegrationTestCollector(t, collectorConfigAgentVersion, server.URL, factories)
+	go func() {
+		assert.NoError(t, app.Run(context.Background()))
+	}()
+	defer app.Shutdown()
+	defer os.Remove(confFilePath)
+	waitForReadiness(app)
+
+	// 3. Generate and send traces
+	sendTracesWithResource(t, attribute.String("k8s.node.name", "aaaa"))
+
+	// 4. Validate the agent version of the APM stats
+	for gotStats := false; !gotStats; {
+		select {
+		case <-tracesRec.ReqChan:
+		case apmstatsBytes := <-apmstatsRec.ReqChan:
+			gz := getGzipReader(t, apmstatsBytes)
+			var spl pb.StatsPayload
+			require.NoError(t, msgp.Decode(gz, &spl))
+			assert.Equal(t, "custom-agent-version", spl.AgentVersion)
+			gotStats = true
+		}
+	}
+}
+
+const collectorConfigStatsCompression = `
+receivers:
+  otlp:
+    protocols:
+      http:
+        endpoint: "localhost:4318"
+      grpc:
+        endpoint: "localhost:4317"
+
+processors:
+  batch:
+    send_batch_size: 10
+    timeout: 5s
+
+connectors:
+  datadog/connector:
+
+exporters:
+  datadog:
+    api:
+      key: "key"
+    tls:
+      insecure_skip_verify: true
+    host_metadata:
+      enabled: false
+    traces:
+      endpoint: %q
+      trace_buffer: 10
+__TRACES_CONFIG__
+    metrics:
+      endpoint: %q
+
+service:
+  telemetry:
+    metrics:
+      level: none
+  pipelines:
+    traces:
+      receivers: [otlp]
+      processors: [batch]
+      exporters: [datadog/connector]
+    metrics:
+      receivers: [datadog/connector]
+      processors: [batch]
+      exporters: [datadog]`
+
+func TestIntegrationStatsCompression(t *testing.T) {
+	tests := []struct {
+		name      string
+		tracesCfg string
+		reader    func(t *testing.T, reqBytes []byte) io.Reader
+	}{
+		{
+			name:   "default",
+			reader: getGzipReader,
+		},
+		{
+			name:      "gzip",
+			tracesCfg: "      stats_compression: gzip",
+			reader:    getGzipReader,
+		},
+		{
+			name:      "none",
+			tracesCfg: "      stats_compression: none",
+			reader: func(_ *testing.T, reqBytes []byte) io.Reader {
+				return bytes.NewReader(reqBytes)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 1. Set up mock Datadog server
+			apmstatsRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.APMStatsEndpoint, ReqChan: make(chan []byte)}
+			tracesRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.TraceEndpoint, ReqChan: make(chan []byte)}
+			server := testutil.DatadogServerMock(apmstatsRec.HandlerFunc, tracesRec.HandlerFunc)
+			defer server.Close()
+
+			// 2. Start in-process collector
+			factories := getIntegrationTestComponents(t)
+			cfgStr := strings.Replace(collectorConfigStatsCompression, "__TRACES_CONFIG__", tt.tracesCfg, 1)
+			app, confFilePath := getIntegrationTestCollector(t, cfgStr, server.URL, factories)
+			go func() {
+				assert.NoError(t, app.Run(context.Background()))
+			}()
+			defer app.Shutdown()
+			defer os.Remove(confFilePath)
+			waitForReadiness(app)
+
+			// 3. Generate and send traces
+			sendTracesWithResource(t, attribute.String("k8s.node.name", "aaaa"))
+
+			// 4. Validate that the APM stats payload can be decoded
+			for gotStats := false; !gotStats; {
+				select {
+				case <-tracesRec.ReqChan:
+				case apmstatsBytes := <-apmstatsRec.ReqChan:
+					var spl pb.StatsPayload
+					require.NoError(t, msgp.Decode(tt.reader(t, apmstatsBytes), &spl))
+					for _, csps := range spl.Stats {
+						for _, csbs := range csps.Stats {
+							for _, stat := range csbs.Stats {
+								assert.Equal(t, "TestSpan", stat.Resource)
+								gotStats = true
+							}
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+const collectorConfigAggregationGranularity = `
+receivers:
+  otlp:
+    protocols:
+      http:
+        endpoint: "localhost:4318"
+      grpc:
+        endpoint: "localhost:4317"
+
+processors:
+  batch:
+    send_batch_size: 10
+    timeout: 5s
+
+connectors:
+  datadog/connector:
+    traces:
+      aggregation_granularity: service
+
+exporters:
+  datadog:
+    api:
+      key: "key"
+    tls:
+      insecure_skip_verify: true
+    host_metadata:
+      enabled: false
+    traces:
+      endpoint: %q
+      trace_buffer: 10
+    metrics:
+      endpoint: %q
+
+service:
+  telemetry:
+    metrics:
+      level: none
+  pipelines:
+    traces:
+      receivers: [otlp]
+      processors: [batch]
+      exporters: [datadog/connector]
+    metrics:
+      receivers: [datadog/connector]
+      processors: [batch]
+      exporters: [datadog]`
+
+func TestIntegrationAggregationGranularity(t *testing.T) {
+	// 1. Set up mock Datadog server
+	apmstatsRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.APMStatsEndpoint, ReqChan: make(chan []byte)}
+	tracesRec := &testutil.HTTPRequestRecorderWithChan{Pattern: testutil.TraceEndpoint, ReqChan: make(chan []byte)}
+	server := testutil.DatadogServerMock(apmstatsRec.HandlerFunc, tracesRec.HandlerFunc)
+	defer server.Close()
+
+	// 2. Start in-process collector
+	factories := getIntegrationTestComponents(t)
+	app, confFilePath := getIntegrationTestCollector(t, collectorConfigAggregationGranularity, server.URL, factories)
+	go func() {
+		assert.NoError(t, app.Run(context.Background()))
+	}()
+	defer app.Shutdown()
+	defer os.Remove(confFilePath)
+	waitForReadiness(app)
+
+	// 3. Generate and send spans with different operation names in the same service
+	sendTracesWithOperations(t)
+
+	// 4. Validate that the stats of the service are merged into one bucket
+	var hits uint64
+	for hits < 4 {
+		select {
+		case <-tracesRec.ReqChan:
+		case apmstatsBytes := <-apmstatsRec.ReqChan:
+			gz := getGzipReader(t, apmstatsBytes)
+			var spl pb.StatsPayload
+			require.NoError(t, msgp.Decode(gz, &spl))
+			for _, csps := range spl.Stats {
+				for _, csbs := range csps.Stats {
+					require.Len(t, csbs.Stats, 1)
+					assert.Equal(t, "svc", csbs.Stats[0].Service)
+					assert.Empty(t, csbs.Stats[0].Name)
+					assert.Empty(t, csbs.Stats[0].Resource)
+					hits += csbs.Stats[0].Hits
+				}
+			}
+		}
+	}
+	assert.Equal(t, uint64(4), hits)
+}
+
+// sendTracesWithOperations sends spans of the same service from two instrumentation scopes,
+// which the trace agent maps to different operation names.
+func sendTracesWithOperations(t *testing.T) {
+	ctx := context.Background()
+
+	// Set up OTel-Go SDK and exporter
+	traceExporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithInsecure())
+	require.NoError(t, err)
+	bsp := sdktrace.NewBatchSpanProcessor(traceExporter)
+	r, _ := resource.New(ctx, resource.WithAttributes(attribute.String("service.name", "svc")))
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSpanProcessor(bsp),
+		sdktrace.WithResource(r),
+	)
+	defer func() {
+		require.NoError(t, tracerProvider.Shutdown(ctx))
+	}()
+
+	for _, name := range []string{"test-tracer-a", "test-tracer-b"} {
+		tracer := tracerProvider.Tracer(name)
+		for i := 0; i < 2; i++ {
+			_, span := tracer.Start(ctx, fmt.Sprintf("TestSpan%d", i), apitrace.WithSpanKind(apitrace.SpanKindServer))
+			span.End()
+		}
+	}
+	time.Sleep(1 * time.Second)
+}
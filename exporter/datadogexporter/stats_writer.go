@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	pb "github.com/DataDog/datadog-agent/pkg/proto/pbgo/trace"
+	"go.opentelemetry.io/collector/exporter"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/scrub"
+)
+
+const (
+	// statsCompressionGzip sends APM stats gzip-compressed through the trace agent's stats writer.
+	statsCompressionGzip = "gzip"
+	// statsCompressionNone sends APM stats uncompressed.
+	statsCompressionNone = "none"
+
+	statsPath = "/api/v0.2/stats"
+)
+
+// apmStatsWriter sends APM stats payloads to the Datadog trace intake.
+type apmStatsWriter interface {
+	Run()
+	Stop()
+}
+
+// uncompressedStatsWriter sends APM stats payloads to the Datadog trace intake without compressing
+// them. The trace agent's stats writer always gzips payloads, so it is replaced by this writer
+// when traces::stats_compression is set to none.
+type uncompressedStatsWriter struct {
+	in         <-chan *pb.StatsPayload
+	params     exporter.CreateSettings
+	endpoint   string
+	apiKey     string
+	retrier    *clientutil.Retrier
+	httpClient *http.Client
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newUncompressedStatsWriter(params exporter.CreateSettings, cfg *Config, in <-chan *pb.StatsPayload) *uncompressedStatsWriter {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &uncompressedStatsWriter{
+		in:         in,
+		params:     params,
+		endpoint:   cfg.Traces.Endpoint + statsPath,
+		apiKey:     string(cfg.API.Key),
+		retrier:    clientutil.NewRetrier(params.Logger, cfg.BackOffConfig, scrub.NewScrubber()),
+		httpClient: clientutil.NewHTTPClient(cfg.ClientConfig),
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+}
+
+// Run sends stats payloads until the writer is stopped.
+func (w *uncompressedStatsWriter) Run() {
+	defer close(w.done)
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case sp, ok := <-w.in:
+			if !ok {
+				return
+			}
+			if _, err := w.retrier.DoWithRetries(w.ctx, func(ctx context.Context) error {
+				return w.send(ctx, sp)
+			}); err != nil {
+				w.params.Logger.Error("Failed to send APM stats payload", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Stop stops a running writer.
+func (w *uncompressedStatsWriter) Stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *uncompressedStatsWriter) send(ctx context.Context, sp *pb.StatsPayload) error {
+	body, err := sp.MarshalMsg(nil)
+	if err != nil {
+		return fmt.Errorf("error encoding stats payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating stats request: %w", err)
+	}
+	clientutil.SetDDHeaders(req.Header, w.params.BuildInfo, w.apiKey)
+	req.Header.Set("Content-Type", "application/msgpack")
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return clientutil.WrapError(fmt.Errorf("%q error when sending stats payload to %s", resp.Status, w.endpoint), resp)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/DataDog/datadog-agent/pkg/proto/pbgo/trace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+)
+
+func TestUncompressedStatsWriter(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		received <- req
+		bodies <- body
+		rw.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	cfg := &Config{
+		API: APIConfig{Key: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		Traces: TracesConfig{
+			TCPAddrConfig:    confignet.TCPAddrConfig{Endpoint: server.URL},
+			StatsCompression: statsCompressionNone,
+		},
+	}
+	in := make(chan *pb.StatsPayload, 1)
+	w := newUncompressedStatsWriter(exportertest.NewNopCreateSettings(), cfg, in)
+	go w.Run()
+	defer w.Stop()
+
+	sent := &pb.StatsPayload{
+		AgentHostname: "host",
+		Stats: []*pb.ClientStatsPayload{{
+			Hostname: "host",
+			Stats: []*pb.ClientStatsBucket{{
+				Stats: []*pb.ClientGroupedStats{{Service: "svc", Name: "op", Resource: "res", Hits: 1}},
+			}},
+		}},
+	}
+	in <- sent
+
+	req := <-received
+	assert.Equal(t, statsPath, req.URL.Path)
+	assert.Empty(t, req.Header.Get("Content-Encoding"))
+	assert.Equal(t, "application/msgpack", req.Header.Get("Content-Type"))
+	assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", req.Header.Get("DD-Api-Key"))
+
+	var got pb.StatsPayload
+	_, err := got.UnmarshalMsg(<-bodies)
+	require.NoError(t, err)
+	assert.Equal(t, "host", got.AgentHostname)
+	require.Len(t, got.Stats, 1)
+	require.Len(t, got.Stats[0].Stats, 1)
+	require.Len(t, got.Stats[0].Stats[0].Stats, 1)
+	assert.Equal(t, "res", got.Stats[0].Stats[0].Stats[0].Resource)
+}
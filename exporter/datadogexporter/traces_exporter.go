@@ -5,6 +5,7 @@ package datadogexporter // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -20,9 +21,11 @@ import (
 	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes"
 	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes/source"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/zap"
 	zorkian "gopkg.in/zorkian/go-datadog-api.v2"
 
@@ -44,6 +47,8 @@ type traceExporter struct {
 	sourceProvider   source.Provider         // is able to source the origin of a trace (hostname, container, etc)
 	metadataReporter *inframetadata.Reporter // reports host metadata from resource attributes and metrics
 	retrier          *clientutil.Retrier     // retrier handles retries on requests
+	attrsTranslator  *attributes.Translator  // attrsTranslator finds the source of a resource
+	onceMissingHost  *sync.Once              // onceMissingHost ensures that the missing host fallback is logged only once
 }
 
 func newTracesExporter(
@@ -54,6 +59,7 @@ func newTracesExporter(
 	sourceProvider source.Provider,
 	agent *agent.Agent,
 	metadataReporter *inframetadata.Reporter,
+	attrsTranslator *attributes.Translator,
 ) (*traceExporter, error) {
 	scrubber := scrub.NewScrubber()
 	exp := &traceExporter{
@@ -66,6 +72,8 @@ func newTracesExporter(
 		sourceProvider:   sourceProvider,
 		retrier:          clientutil.NewRetrier(params.Logger, cfg.BackOffConfig, scrubber),
 		metadataReporter: metadataReporter,
+		attrsTranslator:  attrsTranslator,
+		onceMissingHost:  &sync.Once{},
 	}
 	// client to send running metric to the backend & perform API key validation
 	errchan := make(chan error)
@@ -124,12 +132,23 @@ func (exp *traceExporter) consumeTraces(
 	if noAPMStatsFeatureGate.IsEnabled() {
 		header[headerComputedStats] = []string{"true"}
 	}
+	if exp.cfg.HostMetadata.OnMissingHost == OnMissingHostFail {
+		for i := 0; i < rspans.Len(); i++ {
+			if !exp.hasHost(ctx, rspans.At(i).Resource()) {
+				return consumererror.NewPermanent(errMissingHost)
+			}
+		}
+	}
 	for i := 0; i < rspans.Len(); i++ {
-		rspan := rspans.At(i)
+		rspan := exp.overrideEnv(rspans.At(i))
+		rspan = exp.overrideMissingHost(ctx, rspan)
 		src := exp.agent.OTLPReceiver.ReceiveResourceSpans(ctx, rspan, header)
 		switch src.Kind {
 		case source.HostnameKind:
-			hosts[src.Identifier] = struct{}{}
+			if src.Identifier != "" {
+				// the hostname is empty when omitted by host_metadata::on_missing_host
+				hosts[src.Identifier] = struct{}{}
+			}
 		case source.AWSECSFargateKind:
 			tags[src.Tag()] = struct{}{}
 		case source.InvalidKind:
@@ -140,6 +159,72 @@ func (exp *traceExporter) consumeTraces(
 	return nil
 }
 
+// overrideEnv sets the deployment.environment resource attribute of rspan according to the
+// traces::env and traces::env_attribute settings. rspan is copied before being modified.
+func (exp *traceExporter) overrideEnv(rspan ptrace.ResourceSpans) ptrace.ResourceSpans {
+	env := exp.cfg.Traces.Env
+	if env == "" && exp.cfg.Traces.EnvAttribute != "" {
+		if v, ok := rspan.Resource().Attributes().Get(exp.cfg.Traces.EnvAttribute); ok {
+			env = v.AsString()
+		}
+	}
+	if env == "" {
+		return rspan
+	}
+	rs := ptrace.NewResourceSpans()
+	rspan.CopyTo(rs)
+	rs.Resource().Attributes().PutStr(conventions.AttributeDeploymentEnvironment, env)
+	return rs
+}
+
+// ddHostnameAttribute is the resource attribute the trace agent reads the hostname from
+// when the resource lacks hostname-identifying attributes.
+const ddHostnameAttribute = "_dd.hostname"
+
+var errMissingHost = errors.New("resource has no hostname-identifying attributes and host_metadata::on_missing_host is fail")
+
+// hasHost reports whether res has attributes identifying its source.
+func (exp *traceExporter) hasHost(ctx context.Context, res pcommon.Resource) bool {
+	if _, ok := exp.attrsTranslator.AttributesToSource(ctx, res.Attributes()); ok {
+		return true
+	}
+	_, ok := res.Attributes().Get(ddHostnameAttribute)
+	return ok
+}
+
+// overrideMissingHost sets the hostname of rspan according to the host_metadata::on_missing_host
+// and host_metadata::host_attribute settings when its resource lacks hostname-identifying attributes.
+// rspan is copied before being modified.
+func (exp *traceExporter) overrideMissingHost(ctx context.Context, rspan ptrace.ResourceSpans) ptrace.ResourceSpans {
+	if exp.hasHost(ctx, rspan.Resource()) {
+		return rspan
+	}
+	var hostname string
+	switch exp.cfg.HostMetadata.OnMissingHost {
+	case OnMissingHostOmit:
+	case OnMissingHostUseAttribute:
+		v, ok := rspan.Resource().Attributes().Get(exp.cfg.HostMetadata.HostAttribute)
+		if ok && v.AsString() != "" {
+			hostname = v.AsString()
+			break
+		}
+		exp.onceMissingHost.Do(func() {
+			exp.params.Logger.Info("Resource has no hostname-identifying attributes nor host attribute, falling back to the configured or system hostname",
+				zap.String("host_attribute", exp.cfg.HostMetadata.HostAttribute))
+		})
+		return rspan
+	default:
+		exp.onceMissingHost.Do(func() {
+			exp.params.Logger.Info("Resource has no hostname-identifying attributes, falling back to the configured or system hostname")
+		})
+		return rspan
+	}
+	rs := ptrace.NewResourceSpans()
+	rspan.CopyTo(rs)
+	rs.Resource().Attributes().PutStr(ddHostnameAttribute, hostname)
+	return rs
+}
+
 func (exp *traceExporter) exportUsageMetrics(ctx context.Context, hosts map[string]struct{}, tags map[string]struct{}) {
 	now := pcommon.NewTimestampFromTime(time.Now())
 	buildTags := metrics.TagsFromBuildInfo(exp.params.BuildInfo)
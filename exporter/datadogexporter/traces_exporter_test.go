@@ -9,22 +9,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
+	pb "github.com/DataDog/datadog-agent/pkg/proto/pbgo/trace"
 	tracelog "github.com/DataDog/datadog-agent/pkg/trace/log"
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
 	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	semconv "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/testutil"
 )
@@ -279,6 +283,110 @@ func TestTraceExporter(t *testing.T) {
 	require.NoError(t, exporter.Shutdown(context.Background()))
 }
 
+func TestTraceExporterOnMissingHost(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		policy        OnMissingHost
+		hostAttribute string
+		attrs         map[string]any
+		host          string
+		err           bool
+	}{
+		{
+			name:   "use_hostname",
+			policy: OnMissingHostUseHostname,
+			host:   "fallbackHostname",
+		},
+		{
+			name:          "use_attribute",
+			policy:        OnMissingHostUseAttribute,
+			hostAttribute: "node.name",
+			attrs:         map[string]any{"node.name": "attributeHostname"},
+			host:          "attributeHostname",
+		},
+		{
+			name:          "use_attribute without attribute",
+			policy:        OnMissingHostUseAttribute,
+			hostAttribute: "node.name",
+			host:          "fallbackHostname",
+		},
+		{
+			name:   "fail",
+			policy: OnMissingHostFail,
+			err:    true,
+		},
+		{
+			name:   "omit",
+			policy: OnMissingHostOmit,
+			host:   "",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsServer := testutil.DatadogServerMock()
+			defer metricsServer.Close()
+
+			got := make(chan []byte, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				buf := new(bytes.Buffer)
+				_, err := buf.ReadFrom(req.Body)
+				assert.NoError(t, err)
+				got <- buf.Bytes()
+				rw.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			cfg := Config{
+				API: APIConfig{
+					Key: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				},
+				TagsConfig: TagsConfig{
+					Hostname: "fallbackHostname",
+				},
+				Metrics: MetricsConfig{
+					TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: metricsServer.URL},
+				},
+				Traces: TracesConfig{
+					TCPAddrConfig:   confignet.TCPAddrConfig{Endpoint: server.URL},
+					IgnoreResources: []string{},
+					flushInterval:   0.1,
+				},
+				HostMetadata: HostMetadataConfig{
+					OnMissingHost: tt.policy,
+					HostAttribute: tt.hostAttribute,
+				},
+			}
+
+			params := exportertest.NewNopCreateSettings()
+			f := NewFactory()
+			exporter, err := f.CreateTracesExporter(context.Background(), params, &cfg)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, exporter.Shutdown(context.Background())) }()
+
+			err = exporter.ConsumeTraces(context.Background(), simpleTracesWithAttributes(tt.attrs))
+			if tt.err {
+				require.Error(t, err)
+				assert.True(t, consumererror.IsPermanent(err))
+				return
+			}
+			require.NoError(t, err)
+
+			select {
+			case data := <-got:
+				reader, err := gzip.NewReader(bytes.NewReader(data))
+				require.NoError(t, err)
+				slurp, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				var payload pb.AgentPayload
+				require.NoError(t, proto.Unmarshal(slurp, &payload))
+				require.Len(t, payload.TracerPayloads, 1)
+				assert.Equal(t, tt.host, payload.TracerPayloads[0].Hostname)
+			case <-time.After(2 * time.Second):
+				t.Fatal("Timed out")
+			}
+		})
+	}
+}
+
 func TestNewTracesExporter(t *testing.T) {
 	metricsServer := testutil.DatadogServerMock()
 	defer metricsServer.Close()
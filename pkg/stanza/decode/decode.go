@@ -4,16 +4,22 @@
 package decode // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"golang.org/x/text/encoding"
 	"golang.org/x/text/encoding/ianaindex"
 	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
 	"golang.org/x/text/transform"
 )
 
+// Auto is the name of the encoding selecting the encoding of each stream from its first bytes, see Detect.
+const Auto = "auto"
+
 type Decoder struct {
 	encoding     encoding.Encoding
 	decoder      *encoding.Decoder
@@ -55,6 +61,8 @@ var encodingOverrides = map[string]encoding.Encoding{
 	"utf-8":    unicode.UTF8,
 	"utf16":    unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
 	"utf-16":   unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
+	"utf-32le": utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM),
+	"utf-32be": utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM),
 }
 
 // LookupEncoding attempts to match the string name provided with a character set encoding.
@@ -79,3 +87,72 @@ func IsNop(enc string) bool {
 	}
 	return e == encoding.Nop
 }
+
+var boms = []struct {
+	bom  []byte
+	name string
+}{
+	// UTF-32 first, since the UTF-32LE byte order mark starts with the UTF-16LE one
+	{bom: []byte{0xFF, 0xFE, 0x00, 0x00}, name: "utf-32le"},
+	{bom: []byte{0x00, 0x00, 0xFE, 0xFF}, name: "utf-32be"},
+	{bom: []byte{0xEF, 0xBB, 0xBF}, name: "utf-8"},
+	{bom: []byte{0xFF, 0xFE}, name: "utf-16le"},
+	{bom: []byte{0xFE, 0xFF}, name: "utf-16be"},
+}
+
+// DetectedNames are the names of the encodings returned by Detect.
+var DetectedNames = []string{"utf-8", "utf-16le", "utf-16be", "utf-32le", "utf-32be", "windows-1252"}
+
+// DetectMinLength is the length of the data Detect needs to tell the encodings it detects apart.
+const DetectMinLength = 16
+
+// Detect returns the name of the encoding of a stream starting with data, along with the length of
+// its byte order mark. Without a byte order mark, UTF-16 and UTF-32 are told apart from UTF-8 by the
+// zero bytes of the ASCII characters they encode, and data that is not valid UTF-8 is taken to be
+// windows-1252. The name can be looked up with LookupEncoding.
+func Detect(data []byte) (name string, bomLength int) {
+	for _, b := range boms {
+		if bytes.HasPrefix(data, b.bom) {
+			return b.name, len(b.bom)
+		}
+	}
+
+	// count the zero bytes at each position of the groups of 4 bytes
+	var zeros [4]int
+	groups := len(data) / 4
+	for i := 0; i < groups*4; i++ {
+		if data[i] == 0 {
+			zeros[i%4]++
+		}
+	}
+	mostly := func(n int) bool { return groups > 0 && n*4 > groups*3 }
+	rarely := func(n int) bool { return n*4 < groups }
+	switch {
+	case mostly(zeros[1]) && mostly(zeros[2]) && mostly(zeros[3]) && rarely(zeros[0]):
+		return "utf-32le", 0
+	case mostly(zeros[0]) && mostly(zeros[1]) && mostly(zeros[2]) && rarely(zeros[3]):
+		return "utf-32be", 0
+	case mostly(zeros[1]) && mostly(zeros[3]) && rarely(zeros[0]) && rarely(zeros[2]):
+		return "utf-16le", 0
+	case mostly(zeros[0]) && mostly(zeros[2]) && rarely(zeros[1]) && rarely(zeros[3]):
+		return "utf-16be", 0
+	}
+
+	if validUTF8(data) {
+		return "utf-8", 0
+	}
+	return "windows-1252", 0
+}
+
+// validUTF8 reports whether data is valid UTF-8, except maybe for an incomplete last character.
+func validUTF8(data []byte) bool {
+	if utf8.Valid(data) {
+		return true
+	}
+	for i := len(data) - 1; i >= 0 && i > len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			return !utf8.FullRune(data[i:]) && utf8.Valid(data[:i])
+		}
+	}
+	return false
+}
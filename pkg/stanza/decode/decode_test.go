@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package decode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+)
+
+func TestDetect(t *testing.T) {
+	text := "2024-01-02 héllo wörld\n"
+	utf16LE, err := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewEncoder().String(text)
+	require.NoError(t, err)
+	utf16BE, err := unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewEncoder().String(text)
+	require.NoError(t, err)
+	utf32LE, err := utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM).NewEncoder().String(text)
+	require.NoError(t, err)
+	utf32BE, err := utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM).NewEncoder().String(text)
+	require.NoError(t, err)
+	windows1252, err := charmap.Windows1252.NewEncoder().String(text)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		data      string
+		expected  string
+		bomLength int
+	}{
+		{name: "UTF8", data: text, expected: "utf-8"},
+		{name: "UTF8IncompleteCharacter", data: text[:len("2024-01-02 h")+1], expected: "utf-8"},
+		{name: "UTF8BOM", data: "\xEF\xBB\xBF" + text, expected: "utf-8", bomLength: 3},
+		{name: "UTF16LE", data: utf16LE, expected: "utf-16le"},
+		{name: "UTF16LEBOM", data: "\xFF\xFE" + utf16LE, expected: "utf-16le", bomLength: 2},
+		{name: "UTF16BE", data: utf16BE, expected: "utf-16be"},
+		{name: "UTF16BEBOM", data: "\xFE\xFF" + utf16BE, expected: "utf-16be", bomLength: 2},
+		{name: "UTF32LE", data: utf32LE, expected: "utf-32le"},
+		{name: "UTF32LEBOM", data: "\xFF\xFE\x00\x00" + utf32LE, expected: "utf-32le", bomLength: 4},
+		{name: "UTF32BE", data: utf32BE, expected: "utf-32be"},
+		{name: "UTF32BEBOM", data: "\x00\x00\xFE\xFF" + utf32BE, expected: "utf-32be", bomLength: 4},
+		{name: "Windows1252", data: windows1252, expected: "windows-1252"},
+		{name: "Empty", data: "", expected: "utf-8"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, bomLength := Detect([]byte(tc.data))
+			assert.Equal(t, tc.expected, name)
+			assert.Equal(t, tc.bomLength, bomLength)
+			_, err := LookupEncoding(name)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -17,6 +17,11 @@ const (
 	LogFilePathResolved   = "log.file.path_resolved"
 	LogFileOwnerName      = "log.file.owner.name"
 	LogFileOwnerGroupName = "log.file.owner.group.name"
+	LogRecordRepeatCount  = "log.record.repeat_count"
+	LogRecordOffset       = "log.record.offset"
+	LogRecordLength       = "log.record.length"
+	LogRecordLineCount    = "log.record.line_count"
+	LogTruncated          = "log.truncated"
 )
 
 type Resolver struct {
@@ -8,12 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/featuregate"
 	"go.uber.org/zap"
 	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
@@ -68,20 +70,24 @@ func NewConfig() *Config {
 
 // Config is the configuration of a file input operator
 type Config struct {
-	matcher.Criteria   `mapstructure:",squash"`
-	attrs.Resolver     `mapstructure:",squash"`
-	PollInterval       time.Duration   `mapstructure:"poll_interval,omitempty"`
-	MaxConcurrentFiles int             `mapstructure:"max_concurrent_files,omitempty"`
-	MaxBatches         int             `mapstructure:"max_batches,omitempty"`
-	StartAt            string          `mapstructure:"start_at,omitempty"`
-	FingerprintSize    helper.ByteSize `mapstructure:"fingerprint_size,omitempty"`
-	MaxLogSize         helper.ByteSize `mapstructure:"max_log_size,omitempty"`
-	Encoding           string          `mapstructure:"encoding,omitempty"`
-	SplitConfig        split.Config    `mapstructure:"multiline,omitempty"`
-	TrimConfig         trim.Config     `mapstructure:",squash,omitempty"`
-	FlushPeriod        time.Duration   `mapstructure:"force_flush_period,omitempty"`
-	Header             *HeaderConfig   `mapstructure:"header,omitempty"`
-	DeleteAfterRead    bool            `mapstructure:"delete_after_read,omitempty"`
+	matcher.Criteria     `mapstructure:",squash"`
+	attrs.Resolver       `mapstructure:",squash"`
+	PollInterval         time.Duration   `mapstructure:"poll_interval,omitempty"`
+	MaxConcurrentFiles   int             `mapstructure:"max_concurrent_files,omitempty"`
+	MaxBatches           int             `mapstructure:"max_batches,omitempty"`
+	StartAt              string          `mapstructure:"start_at,omitempty"`
+	FingerprintSize      helper.ByteSize `mapstructure:"fingerprint_size,omitempty"`
+	MaxLogSize           helper.ByteSize `mapstructure:"max_log_size,omitempty"`
+	Encoding             string          `mapstructure:"encoding,omitempty"`
+	SplitConfig          split.Config    `mapstructure:"multiline,omitempty"`
+	TrimConfig           trim.Config     `mapstructure:",squash,omitempty"`
+	FlushPeriod          time.Duration   `mapstructure:"force_flush_period,omitempty"`
+	MaxTokenAge          time.Duration   `mapstructure:"max_token_age,omitempty"`
+	Header               *HeaderConfig   `mapstructure:"header,omitempty"`
+	DeleteAfterRead      bool            `mapstructure:"delete_after_read,omitempty"`
+	CollapseRepeats      bool            `mapstructure:"collapse_repeats,omitempty"`
+	IncludeTokenMetadata bool            `mapstructure:"include_token_metadata,omitempty"`
+	OnOversize           string          `mapstructure:"on_oversize,omitempty"`
 }
 
 type HeaderConfig struct {
@@ -107,22 +113,43 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 		opt(o)
 	}
 
-	enc, err := decode.LookupEncoding(c.Encoding)
+	quarantine := newQuarantineLogger(set.Logger, quarantineLogInterval)
+	var splitFuncs map[string]bufio.SplitFunc
+	if c.Encoding == decode.Auto {
+		// the encoding of each file is detected by its reader, which uses the split func of that encoding
+		splitFuncs = make(map[string]bufio.SplitFunc, len(decode.DetectedNames))
+		for _, name := range decode.DetectedNames {
+			splitFunc := o.splitFunc
+			if splitFunc == nil {
+				enc, err := decode.LookupEncoding(name)
+				if err != nil {
+					return nil, fmt.Errorf("failed to find encoding: %w", err)
+				}
+				if splitFunc, err = c.SplitConfig.FuncWithQuarantine(enc, false, int(c.MaxLogSize), quarantine); err != nil {
+					return nil, err
+				}
+			}
+			splitFuncs[name] = splitFunc
+		}
+	}
+
+	enc, err := c.encoding()
 	if err != nil {
 		return nil, fmt.Errorf("failed to find encoding: %w", err)
 	}
 
 	splitFunc := o.splitFunc
 	if splitFunc == nil {
-		splitFunc, err = c.SplitConfig.Func(enc, false, int(c.MaxLogSize))
+		splitFunc, err = c.SplitConfig.FuncWithQuarantine(enc, false, int(c.MaxLogSize), quarantine)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	trimFunc := trim.Nop
+	trimFunc, decodedTrimFunc := trim.Nop, trim.Nop
 	if enc != encoding.Nop {
 		trimFunc = c.TrimConfig.Func()
+		decodedTrimFunc = c.TrimConfig.DecodedFunc()
 	}
 
 	var startAtBeginning bool
@@ -157,12 +184,22 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 		MaxLogSize:        int(c.MaxLogSize),
 		Encoding:          enc,
 		SplitFunc:         splitFunc,
+		SplitFuncs:        splitFuncs,
 		TrimFunc:          trimFunc,
+		DecodedTrimFunc:   decodedTrimFunc,
 		FlushTimeout:      c.FlushPeriod,
+		MaxTokenAge:       c.MaxTokenAge,
 		EmitFunc:          emit,
 		Attributes:        c.Resolver,
 		HeaderConfig:      hCfg,
 		DeleteAtEOF:       c.DeleteAfterRead,
+		CollapseRepeats:   c.CollapseRepeats,
+
+		IncludeTokenMetadata: c.IncludeTokenMetadata,
+		OnOversize:           c.OnOversize,
+	}
+	if o.splitFunc == nil {
+		readerFactory.FirstPartialPattern = c.SplitConfig.FirstPartialPattern()
 	}
 
 	var t tracker.Tracker
@@ -204,11 +241,48 @@ func (c Config) validate() error {
 		return errors.New("'max_batches' must not be negative")
 	}
 
-	enc, err := decode.LookupEncoding(c.Encoding)
+	if c.MaxTokenAge < 0 {
+		return errors.New("'max_token_age' must not be negative")
+	}
+
+	switch c.OnOversize {
+	case "", trim.OversizeSplit, trim.OversizeTruncate, trim.OversizeDrop:
+	default:
+		return fmt.Errorf("invalid 'on_oversize' value '%s', must be one of split, truncate or drop", c.OnOversize)
+	}
+
+	enc, err := c.encoding()
 	if err != nil {
 		return err
 	}
 
+	if err = c.SplitConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err = c.SplitConfig.ValidateEncoding(enc); err != nil {
+		return err
+	}
+
+	if err = c.TrimConfig.Validate(); err != nil {
+		return err
+	}
+
+	if c.Encoding == decode.Auto {
+		for _, name := range decode.DetectedNames {
+			detected, lookupErr := decode.LookupEncoding(name)
+			if lookupErr != nil {
+				return lookupErr
+			}
+			if err = c.SplitConfig.ValidateEncoding(detected); err != nil {
+				return fmt.Errorf("'encoding: auto' may detect %s: %w", name, err)
+			}
+		}
+		if c.Header != nil {
+			return errors.New("'header' cannot be used with 'encoding: auto'")
+		}
+	}
+
 	if c.DeleteAfterRead {
 		if !allowFileDeletion.IsEnabled() {
 			return fmt.Errorf("'delete_after_read' requires feature gate '%s'", allowFileDeletion.ID())
@@ -238,6 +312,44 @@ func (c Config) validate() error {
 	return nil
 }
 
+// encoding returns the configured encoding, or UTF-8 if it is detected for each file.
+func (c Config) encoding() (encoding.Encoding, error) {
+	if c.Encoding == decode.Auto {
+		return unicode.UTF8, nil
+	}
+	return decode.LookupEncoding(c.Encoding)
+}
+
+const (
+	// quarantineLogInterval is the minimum interval between two logs of quarantined tokens
+	quarantineLogInterval = time.Minute
+	// quarantineLogTokenSize is the number of bytes of a quarantined token that are logged
+	quarantineLogTokenSize = 256
+)
+
+// newQuarantineLogger returns a split.QuarantineFunc logging quarantined tokens as a warning, at most once per
+// interval and truncated to quarantineLogTokenSize bytes, along with the number of tokens quarantined since the
+// previous log. Readers share the split func, so the returned func is safe for concurrent use.
+func newQuarantineLogger(logger *zap.Logger, interval time.Duration) split.QuarantineFunc {
+	var mu sync.Mutex
+	var lastLog time.Time
+	var quarantined int
+	return func(token []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		quarantined++
+		if !lastLog.IsZero() && time.Since(lastLog) < interval {
+			return
+		}
+		lastLog = time.Now()
+		logger.Warn("Quarantined token that is not valid UTF-8",
+			zap.ByteString("token", token[:min(len(token), quarantineLogTokenSize)]),
+			zap.Int("token_size", len(token)),
+			zap.Int("quarantined", quarantined))
+		quarantined = 0
+	}
+}
+
 type options struct {
 	splitFunc  bufio.SplitFunc
 	noTracking bool
@@ -6,6 +6,7 @@ package fileconsumer
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +15,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/featuregate"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/emittest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
@@ -23,6 +27,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/regex"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
 func TestNewConfig(t *testing.T) {
@@ -402,6 +407,38 @@ func TestUnmarshal(t *testing.T) {
 					return newMockOperatorConfig(cfg)
 				}(),
 			},
+			{
+				Name: "max_token_age_5s",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.MaxTokenAge = 5 * time.Second
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "include_token_metadata",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.IncludeTokenMetadata = true
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "trim_cutset",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.TrimConfig.Trim = &trim.Options{Ends: trim.EndsTrailing, Cutset: "\x00\r\n\t "}
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "on_oversize_truncate",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.OnOversize = "truncate"
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
 			{
 				Name: "header_config",
 				Expect: func() *mockOperatorConfig {
@@ -479,8 +516,8 @@ func TestBuild(t *testing.T) {
 				cfg.SplitConfig.LineEndPattern = "Exists"
 				cfg.SplitConfig.LineStartPattern = "Exists"
 			},
-			require.Error,
-			nil,
+			require.NoError,
+			func(_ *testing.T, _ *Manager) {},
 		},
 		{
 			"MultilineConfiguredStartPattern",
@@ -512,8 +549,8 @@ func TestBuild(t *testing.T) {
 				cfg.SplitConfig.LineStartPattern = ".*"
 				cfg.SplitConfig.LineEndPattern = ".*"
 			},
-			require.Error,
-			nil,
+			require.NoError,
+			func(_ *testing.T, _ *Manager) {},
 		},
 		{
 			"NoLineStartOrEnd",
@@ -537,6 +574,15 @@ func TestBuild(t *testing.T) {
 			require.Error,
 			nil,
 		},
+		{
+			"ValidateUTF8OtherEncoding",
+			func(cfg *Config) {
+				cfg.Encoding = "utf-16le"
+				cfg.SplitConfig.ValidateUTF8 = true
+			},
+			require.Error,
+			nil,
+		},
 		{
 			"InvalidStartAtDelete",
 			func(cfg *Config) {
@@ -564,6 +610,70 @@ func TestBuild(t *testing.T) {
 				require.Equal(t, 6, m.maxBatches)
 			},
 		},
+		{
+			"InvalidMaxTokenAge",
+			func(cfg *Config) {
+				cfg.MaxTokenAge = -time.Second
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ValidMaxTokenAge",
+			func(cfg *Config) {
+				cfg.MaxTokenAge = 5 * time.Second
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, 5*time.Second, m.readerFactory.MaxTokenAge)
+			},
+		},
+		{
+			"AutoEncoding",
+			func(cfg *Config) {
+				cfg.Encoding = "auto"
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Len(t, m.readerFactory.SplitFuncs, 6)
+			},
+		},
+		{
+			"AutoEncodingModeJSON",
+			func(cfg *Config) {
+				cfg.Encoding = "auto"
+				cfg.SplitConfig.Mode = "json"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidTrimWithPreserveFlag",
+			func(cfg *Config) {
+				cfg.TrimConfig.PreserveLeading = true
+				cfg.TrimConfig.Trim = &trim.Options{}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidOnOversize",
+			func(cfg *Config) {
+				cfg.OnOversize = "ignore"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ValidOnOversize",
+			func(cfg *Config) {
+				cfg.OnOversize = "drop"
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, "drop", m.readerFactory.OnOversize)
+			},
+		},
 		{
 			"HeaderConfigNoFlag",
 			func(cfg *Config) {
@@ -849,6 +959,34 @@ func newMockOperatorConfig(cfg *Config) *mockOperatorConfig {
 	}
 }
 
+func TestQuarantineLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	quarantine := newQuarantineLogger(zap.New(core), time.Hour)
+
+	token := []byte(strings.Repeat("\xff", 1000))
+	quarantine(token)
+	quarantine(token)
+	quarantine(token)
+
+	// Only the first token is logged within the interval, and it is truncated.
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, string(token[:quarantineLogTokenSize]), fields["token"])
+	assert.Equal(t, int64(1000), fields["token_size"])
+	assert.Equal(t, int64(1), fields["quarantined"])
+
+	// The tokens quarantined in between are counted by the next log.
+	quarantine = newQuarantineLogger(zap.New(core), 10*time.Millisecond)
+	quarantine([]byte("\xff"))
+	quarantine([]byte("\xff"))
+	time.Sleep(20 * time.Millisecond)
+	quarantine([]byte("\xfe"))
+	require.Equal(t, 3, logs.Len())
+	fields = logs.All()[2].ContextMap()
+	assert.Equal(t, "\xfe", fields["token"])
+	assert.Equal(t, int64(2), fields["quarantined"])
+}
+
 // This function is impelmented for compatibility with operatortest
 // but is not meant to be used directly
 func (h *mockOperatorConfig) Build(_ component.TelemetrySettings) (operator.Operator, error) {
@@ -936,6 +936,36 @@ func TestEncodings(t *testing.T) {
 			"big5",
 			[][]byte{{230, 138, 152}},
 		},
+		{
+			"AutoUTF8",
+			[]byte("foo\nbar\n"),
+			"auto",
+			[][]byte{[]byte("foo"), []byte("bar")},
+		},
+		{
+			"AutoUTF8BOM",
+			[]byte("\xef\xbb\xbffoo\nbar\n"),
+			"auto",
+			[][]byte{[]byte("foo"), []byte("bar")},
+		},
+		{
+			"AutoUTF16LEBOM",
+			[]byte{0xff, 0xfe, 61, 216, 0, 222, 10, 0, 102, 0, 111, 0, 111, 0, 10, 0}, // 😀\nfoo\n
+			"auto",
+			[][]byte{{240, 159, 152, 128}, {102, 111, 111}},
+		},
+		{
+			"AutoUTF16BE",
+			[]byte{0, 102, 0, 111, 0, 111, 0, 10, 0, 98, 0, 97, 0, 114, 0, 10}, // foo\nbar\n
+			"auto",
+			[][]byte{[]byte("foo"), []byte("bar")},
+		},
+		{
+			"AutoWindows1252",
+			[]byte{99, 97, 102, 233, 10}, // café\n
+			"auto",
+			[][]byte{[]byte("café")},
+		},
 	}
 
 	for _, tc := range cases {
@@ -964,6 +994,54 @@ func TestEncodings(t *testing.T) {
 	}
 }
 
+func TestTrimUnicodeWhitespace(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		contents []byte
+		encoding string
+		expected [][]byte
+	}{
+		{
+			"UTF8",
+			[]byte("\u00a0foo\u3000\n\u3000\u3000bar\u00a0\n"),
+			"utf8",
+			[][]byte{[]byte("foo"), []byte("bar")},
+		},
+		{
+			"UTF16LE",
+			[]byte{160, 0, 102, 0, 111, 0, 111, 0, 0, 48, 10, 0}, // \u00a0foo\u3000\n
+			"utf-16le",
+			[][]byte{[]byte("foo")},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tempDir := t.TempDir()
+			cfg := NewConfig().includeDir(tempDir)
+			cfg.StartAt = "beginning"
+			cfg.Encoding = tc.encoding
+			cfg.TrimConfig.TrimUnicode = true
+			operator, sink := testManager(t, cfg)
+
+			temp := filetest.OpenTemp(t, tempDir)
+			_, err := temp.Write(tc.contents)
+			require.NoError(t, err)
+
+			require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+			defer func() {
+				require.NoError(t, operator.Stop())
+			}()
+
+			sink.ExpectTokens(t, tc.expected...)
+		})
+	}
+}
+
 func TestDeleteAfterRead(t *testing.T) {
 	t.Parallel()
 
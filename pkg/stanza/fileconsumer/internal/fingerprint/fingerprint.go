@@ -42,6 +42,11 @@ func (f Fingerprint) Copy() *Fingerprint {
 	return New(buf[:n])
 }
 
+// FirstBytes returns the first bytes of the file, which must not be modified.
+func (f *Fingerprint) FirstBytes() []byte {
+	return f.firstBytes
+}
+
 func (f *Fingerprint) Len() int {
 	return len(f.firstBytes)
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -20,6 +21,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/flush"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
@@ -38,10 +40,23 @@ type Factory struct {
 	Encoding          encoding.Encoding
 	SplitFunc         bufio.SplitFunc
 	TrimFunc          trim.Func
+	DecodedTrimFunc   trim.Func
 	FlushTimeout      time.Duration
+	MaxTokenAge       time.Duration
 	EmitFunc          emit.Callback
 	Attributes        attrs.Resolver
 	DeleteAtEOF       bool
+	CollapseRepeats   bool
+	// SplitFuncs, if set, are the split funcs by name of the encodings detected by decode.Detect.
+	// The encoding of each file is then detected from its first bytes, and used instead of Encoding.
+	SplitFuncs map[string]bufio.SplitFunc
+	// OnOversize is the handling of the tokens longer than MaxLogSize, one of the trim.Oversize* values.
+	OnOversize string
+	// IncludeTokenMetadata adds the offset, length and number of lines of each token to its attributes.
+	IncludeTokenMetadata bool
+	// FirstPartialPattern, if set, marks the start of the first full record of a file
+	// read from a non-zero offset. The bytes preceding its first match are skipped.
+	FirstPartialPattern *regexp.Regexp
 }
 
 func (f *Factory) NewFingerprint(file *os.File) (*fingerprint.Fingerprint, error) {
@@ -54,7 +69,7 @@ func (f *Factory) NewReader(file *os.File, fp *fingerprint.Fingerprint) (*Reader
 		return nil, err
 	}
 	m := &Metadata{Fingerprint: fp, FileAttributes: attributes}
-	if f.FlushTimeout > 0 {
+	if f.FlushTimeout > 0 || f.MaxTokenAge > 0 {
 		m.FlushState = &flush.State{LastDataChange: time.Now()}
 	}
 	return f.NewReaderFromMetadata(file, m)
@@ -72,7 +87,10 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 		maxLogSize:        f.MaxLogSize,
 		decoder:           decode.New(f.Encoding),
 		lineSplitFunc:     f.SplitFunc,
+		decodedTrimFunc:   f.DecodedTrimFunc,
 		deleteAtEOF:       f.DeleteAtEOF,
+
+		includeTokenMetadata: f.IncludeTokenMetadata,
 	}
 	r.set.Logger = r.set.Logger.With(zap.String("path", r.fileName))
 
@@ -94,10 +112,54 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 			return nil, fmt.Errorf("stat: %w", err)
 		}
 		r.Offset = info.Size()
+		m.SkipFirstPartial = f.FirstPartialPattern != nil && r.Offset > 0
 	}
 
-	flushFunc := m.FlushState.Func(f.SplitFunc, f.FlushTimeout)
-	r.lineSplitFunc = trim.WithFunc(trim.ToLength(flushFunc, f.MaxLogSize), f.TrimFunc)
+	splitFunc := f.SplitFunc
+	if f.SplitFuncs != nil {
+		name, bomLength := decode.Detect(r.Fingerprint.FirstBytes())
+		if m.Encoding != "" && m.Encoding != name {
+			// detected from fewer bytes before, which is kept so that the file is read consistently
+			name, bomLength = m.Encoding, 0
+		}
+		if r.Fingerprint.Len() >= decode.DetectMinLength {
+			m.Encoding = name
+		}
+		enc, lookupErr := decode.LookupEncoding(name)
+		if lookupErr != nil {
+			return nil, fmt.Errorf("lookup detected encoding: %w", lookupErr)
+		}
+		r.decoder = decode.New(enc)
+		splitFunc = f.SplitFuncs[name]
+		if r.Offset < int64(bomLength) {
+			// the byte order mark is not part of the first token
+			r.Offset = int64(bomLength)
+		}
+	}
+
+	flushFunc := m.FlushState.Func(splitFunc, f.FlushTimeout)
+	// aged outside of the flush func, so that the age is reset for force flushed tokens
+	flushFunc = m.FlushState.AgeFunc(flushFunc, f.MaxTokenAge)
+	lineSplitFunc := flushFunc
+	if f.OnOversize == "" || f.OnOversize == trim.OversizeSplit {
+		lineSplitFunc = trim.ToLength(lineSplitFunc, f.MaxLogSize)
+	}
+	if f.FirstPartialPattern != nil && m.SkipFirstPartial {
+		// skipped outside of the flush and length funcs, so that the partial record is never
+		// force flushed nor truncated into a token
+		lineSplitFunc = split.SkipFirstPartialFunc(lineSplitFunc, f.FirstPartialPattern, f.MaxLogSize, func() { m.SkipFirstPartial = false })
+	}
+	if f.CollapseRepeats {
+		// collapsed outside of the flush and length funcs, so that the count is set for every token,
+		// including force flushed and truncated ones
+		lineSplitFunc = split.CollapseRepeatsFunc(lineSplitFunc, func(count int) { r.repeatCount = count })
+	}
+	if f.OnOversize != "" && f.OnOversize != trim.OversizeSplit {
+		// handled outside of the func collapsing repeats, since it keeps track of the oversize token
+		// being skipped, which the tokens read ahead when looking for repeats must not change
+		lineSplitFunc = trim.ToLengthOnOversize(lineSplitFunc, f.MaxLogSize, f.OnOversize, &m.SkipOversize, func() { r.truncated = true })
+	}
+	r.lineSplitFunc = trim.WithFunc(lineSplitFunc, f.TrimFunc)
 	r.emitFunc = f.EmitFunc
 	if f.HeaderConfig == nil || m.HeaderFinalized {
 		r.splitFunc = r.lineSplitFunc
@@ -59,8 +59,15 @@ func testFactory(t *testing.T, opts ...testFactoryOpt) (*Factory, *emittest.Sink
 		SplitFunc:         splitFunc,
 		TrimFunc:          cfg.trimFunc,
 		FlushTimeout:      cfg.flushPeriod,
+		MaxTokenAge:       cfg.maxTokenAge,
 		EmitFunc:          sink.Callback,
 		Attributes:        cfg.attributes,
+		CollapseRepeats:   cfg.collapseRepeats,
+
+		IncludeTokenMetadata: cfg.includeTokenMetadata,
+		OnOversize:           cfg.onOversize,
+
+		FirstPartialPattern: cfg.splitCfg.FirstPartialPattern(),
 	}, sink
 }
 
@@ -75,8 +82,13 @@ type testFactoryCfg struct {
 	splitCfg          split.Config
 	trimFunc          trim.Func
 	flushPeriod       time.Duration
+	maxTokenAge       time.Duration
 	sinkChanSize      int
 	attributes        attrs.Resolver
+	collapseRepeats   bool
+
+	includeTokenMetadata bool
+	onOversize           string
 }
 
 func withFingerprintSize(size int) testFactoryOpt {
@@ -109,12 +121,36 @@ func withFlushPeriod(flushPeriod time.Duration) testFactoryOpt {
 	}
 }
 
+func withMaxTokenAge(maxTokenAge time.Duration) testFactoryOpt {
+	return func(c *testFactoryCfg) {
+		c.maxTokenAge = maxTokenAge
+	}
+}
+
 func withSinkChanSize(n int) testFactoryOpt {
 	return func(c *testFactoryCfg) {
 		c.sinkChanSize = n
 	}
 }
 
+func withCollapseRepeats() testFactoryOpt {
+	return func(c *testFactoryCfg) {
+		c.collapseRepeats = true
+	}
+}
+
+func withOnOversize(onOversize string) testFactoryOpt {
+	return func(c *testFactoryCfg) {
+		c.onOversize = onOversize
+	}
+}
+
+func withIncludeTokenMetadata() testFactoryOpt {
+	return func(c *testFactoryCfg) {
+		c.includeTokenMetadata = true
+	}
+}
+
 func fromEnd() testFactoryOpt {
 	return func(c *testFactoryCfg) {
 		c.fromBeginning = false
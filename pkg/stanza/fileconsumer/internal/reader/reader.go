@@ -5,6 +5,7 @@ package reader // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"os"
@@ -13,11 +14,13 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/emit"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/scanner"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/flush"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
 type Metadata struct {
@@ -26,6 +29,12 @@ type Metadata struct {
 	FileAttributes  map[string]any
 	HeaderFinalized bool
 	FlushState      *flush.State
+	// SkipFirstPartial is set while the partial record at the start offset remains to be skipped.
+	SkipFirstPartial bool
+	// SkipOversize is set while the remainder of a token longer than the max log size remains to be skipped.
+	SkipOversize bool
+	// Encoding is the name of the encoding detected for the file, once enough of its first bytes were read.
+	Encoding string
 }
 
 // Reader manages a single file
@@ -39,12 +48,16 @@ type Reader struct {
 	maxLogSize             int
 	lineSplitFunc          bufio.SplitFunc
 	splitFunc              bufio.SplitFunc
+	decodedTrimFunc        trim.Func
 	decoder                *decode.Decoder
 	headerReader           *header.Reader
 	processFunc            emit.Callback
 	emitFunc               emit.Callback
 	deleteAtEOF            bool
 	needsUpdateFingerprint bool
+	repeatCount            int
+	truncated              bool
+	includeTokenMetadata   bool
 }
 
 // ReadToEnd will read until the end of the file
@@ -86,8 +99,31 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 			r.Offset = s.Pos() // move past the bad token or we may be stuck
 			continue
 		}
+		if r.decodedTrimFunc != nil {
+			token = r.decodedTrimFunc(token)
+		}
+
+		attributes := r.FileAttributes
+		if r.repeatCount > 1 || r.truncated || r.includeTokenMetadata {
+			attributes = make(map[string]any, len(r.FileAttributes)+4)
+			for k, v := range r.FileAttributes {
+				attributes[k] = v
+			}
+			if r.repeatCount > 1 {
+				attributes[attrs.LogRecordRepeatCount] = r.repeatCount
+			}
+			if r.truncated {
+				attributes[attrs.LogTruncated] = true
+				r.truncated = false
+			}
+			if r.includeTokenMetadata {
+				attributes[attrs.LogRecordOffset] = s.TokenPos()
+				attributes[attrs.LogRecordLength] = s.Pos() - s.TokenPos()
+				attributes[attrs.LogRecordLineCount] = lineCount(token)
+			}
+		}
 
-		err = r.processFunc(ctx, token, r.FileAttributes)
+		err = r.processFunc(ctx, token, attributes)
 		if err == nil {
 			r.Offset = s.Pos() // successful emit, update offset
 			continue
@@ -121,6 +157,14 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 	}
 }
 
+// lineCount returns the number of lines in a decoded token
+func lineCount(token []byte) int {
+	if len(token) == 0 {
+		return 0
+	}
+	return bytes.Count(token, []byte{'\n'}) + 1
+}
+
 // Delete will close and delete the file
 func (r *Reader) delete() {
 	r.close()
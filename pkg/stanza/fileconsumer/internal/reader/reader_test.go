@@ -6,6 +6,7 @@ package reader
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
@@ -14,8 +15,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/filetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
 func TestFileReader_FingerprintUpdated(t *testing.T) {
@@ -212,3 +216,95 @@ func TestFlushPeriodEOF(t *testing.T) {
 	r.ReadToEnd(context.Background())
 	sink.ExpectTokens(t, content[0:aContentLength], []byte{'b'})
 }
+
+func TestCollapseRepeats(t *testing.T) {
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "first\nrepeated\nrepeated\nrepeated\nlast\n")
+
+	f, sink := testFactory(t, withCollapseRepeats())
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	r.ReadToEnd(context.Background())
+
+	fileName := filepath.Base(temp.Name())
+	sink.ExpectCall(t, []byte("first"), map[string]any{attrs.LogFileName: fileName})
+	sink.ExpectCall(t, []byte("repeated"), map[string]any{attrs.LogFileName: fileName, attrs.LogRecordRepeatCount: 3})
+	sink.ExpectCall(t, []byte("last"), map[string]any{attrs.LogFileName: fileName})
+	sink.ExpectNoCalls(t)
+}
+
+func TestIncludeTokenMetadata(t *testing.T) {
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "LOGSTART 1\nline\nLOGSTART 2\nline\nmore\nLOGSTART 3\n")
+
+	f, sink := testFactory(t,
+		withSplitConfig(split.Config{LineStartPattern: "^LOGSTART"}),
+		withIncludeTokenMetadata(),
+	)
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	r.ReadToEnd(context.Background())
+
+	fileName := filepath.Base(temp.Name())
+	sink.ExpectCall(t, []byte("LOGSTART 1\nline"), map[string]any{
+		attrs.LogFileName:        fileName,
+		attrs.LogRecordOffset:    int64(0),
+		attrs.LogRecordLength:    int64(16),
+		attrs.LogRecordLineCount: 2,
+	})
+	sink.ExpectCall(t, []byte("LOGSTART 2\nline\nmore"), map[string]any{
+		attrs.LogFileName:        fileName,
+		attrs.LogRecordOffset:    int64(16),
+		attrs.LogRecordLength:    int64(21),
+		attrs.LogRecordLineCount: 3,
+	})
+	sink.ExpectNoCalls(t)
+}
+
+func TestOnOversizeTruncate(t *testing.T) {
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "short\nthis line is much longer than the max log size\nnext\n")
+
+	f, sink := testFactory(t, withMaxLogSize(16), withOnOversize(trim.OversizeTruncate))
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	r.ReadToEnd(context.Background())
+	fileName := filepath.Base(temp.Name())
+	sink.ExpectCall(t, []byte("short"), map[string]any{attrs.LogFileName: fileName})
+	sink.ExpectCall(t, []byte("this line is muc"), map[string]any{attrs.LogFileName: fileName, attrs.LogTruncated: true})
+	sink.ExpectCall(t, []byte("next"), map[string]any{attrs.LogFileName: fileName})
+	sink.ExpectNoCalls(t)
+}
+
+func TestCollapseRepeatsFlushedPartial(t *testing.T) {
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "repeated\nrepeated\nrepeated\npartial")
+
+	// Make sure FlushPeriod is small, so it is guaranteed to expire
+	f, sink := testFactory(t, withCollapseRepeats(), withFlushPeriod(5*time.Nanosecond))
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	r.ReadToEnd(context.Background())
+
+	// the flushed partial is not a repeat of the collapsed run
+	fileName := filepath.Base(temp.Name())
+	sink.ExpectCall(t, []byte("repeated"), map[string]any{attrs.LogFileName: fileName, attrs.LogRecordRepeatCount: 3})
+	sink.ExpectCall(t, []byte("partial"), map[string]any{attrs.LogFileName: fileName})
+	sink.ExpectNoCalls(t)
+}
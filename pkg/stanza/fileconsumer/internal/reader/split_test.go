@@ -20,6 +20,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/regex"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
 func TestPersistFlusher(t *testing.T) {
@@ -55,6 +56,38 @@ func TestPersistFlusher(t *testing.T) {
 	sink.ExpectToken(t, []byte("log without newline"))
 }
 
+func TestMaxTokenAge(t *testing.T) {
+	maxTokenAge := 100 * time.Millisecond
+	f, sink := testFactory(t,
+		withSplitConfig(split.Config{LineStartPattern: "^LOGSTART"}),
+		withFlushPeriod(time.Minute),
+		withMaxTokenAge(maxTokenAge),
+	)
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	_, err = temp.WriteString("LOGSTART 1\n")
+	require.NoError(t, err)
+	r.ReadToEnd(context.Background())
+	sink.ExpectNoCalls(t)
+
+	// The token keeps growing, so the flush period never expires, but it gets too old.
+	start := time.Now()
+	for time.Since(start) < 2*maxTokenAge {
+		_, err = temp.WriteString("more\n")
+		require.NoError(t, err)
+		r.ReadToEnd(context.Background())
+		time.Sleep(10 * time.Millisecond)
+	}
+	token := sink.NextToken(t)
+	assert.Contains(t, string(token), "LOGSTART 1\nmore")
+}
+
 func TestTokenization(t *testing.T) {
 	testCases := []struct {
 		testName    string
@@ -222,3 +255,102 @@ func TestHeaderFingerprintIncluded(t *testing.T) {
 
 	require.Equal(t, fingerprint.New([]byte("#header-line\naaa\n")), r.Fingerprint)
 }
+
+func TestLengthPrefixedPartialRecord(t *testing.T) {
+	f, sink := testFactory(t,
+		withSplitConfig(split.Config{Mode: split.ModeLengthPrefixed, LengthPrefixSize: 2}),
+		withFlushPeriod(0),
+	)
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	// The record is not complete yet, so it is not emitted.
+	_, err = temp.WriteString("\x00\x03ab")
+	require.NoError(t, err)
+	r.ReadToEnd(context.Background())
+	sink.ExpectNoCalls(t)
+
+	_, err = temp.WriteString("c\x00\x01d")
+	require.NoError(t, err)
+	r.ReadToEnd(context.Background())
+	sink.ExpectTokens(t, []byte("abc"), []byte("d"))
+}
+
+func TestSkipFirstPartialOnResume(t *testing.T) {
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "START rec1\ncont1\n")
+
+	splitCfg := split.Config{LineStartPattern: "^START", SkipFirstPartialOnResume: true}
+	f, sink := testFactory(t, withSplitConfig(splitCfg), fromEnd())
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(filetest.OpenFile(t, temp.Name()), fp)
+	require.NoError(t, err)
+	assert.True(t, r.SkipFirstPartial)
+
+	// Nothing was appended yet, so the partial record remains to be skipped on the next read.
+	r.ReadToEnd(context.Background())
+	sink.ExpectNoCalls(t)
+	f.FromBeginning = true // as done by the manager after the first poll
+	r, err = f.NewReaderFromMetadata(filetest.OpenFile(t, temp.Name()), r.Close())
+	require.NoError(t, err)
+	assert.True(t, r.SkipFirstPartial)
+
+	// The tail of rec1 is dropped instead of being emitted as a record of its own.
+	filetest.WriteString(t, temp, "cont2\nSTART rec2\nSTART rec3\n")
+	r.ReadToEnd(context.Background())
+	sink.ExpectToken(t, []byte("START rec2"))
+	assert.False(t, r.SkipFirstPartial)
+}
+
+func TestSkipFirstPartialLongerThanMaxLogSize(t *testing.T) {
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "START rec1\n")
+
+	splitCfg := split.Config{LineStartPattern: "^START", SkipFirstPartialOnResume: true}
+	f, sink := testFactory(t, withSplitConfig(splitCfg), withInitialBufferSize(16), withMaxLogSize(16), fromEnd())
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(filetest.OpenFile(t, temp.Name()), fp)
+	require.NoError(t, err)
+	assert.True(t, r.SkipFirstPartial)
+
+	// The tail of rec1 is dropped rather than truncated to max_log_size and emitted.
+	filetest.WriteString(t, temp, "continuation of rec1\nanother continuation\nSTART rec2\nSTART rec3\n")
+	r.ReadToEnd(context.Background())
+	sink.ExpectToken(t, []byte("START rec2"))
+	assert.False(t, r.SkipFirstPartial)
+}
+
+func TestOnOversizeDropOnResume(t *testing.T) {
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "short\nthis line is much longer")
+
+	f, sink := testFactory(t, withMaxLogSize(16), withOnOversize(trim.OversizeDrop))
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(filetest.OpenFile(t, temp.Name()), fp)
+	require.NoError(t, err)
+
+	r.ReadToEnd(context.Background())
+	sink.ExpectToken(t, []byte("short"))
+	sink.ExpectNoCalls(t)
+	assert.True(t, r.SkipOversize)
+
+	// The remainder of the oversize line is dropped by the next reader of the file.
+	r, err = f.NewReaderFromMetadata(filetest.OpenFile(t, temp.Name()), r.Close())
+	require.NoError(t, err)
+	filetest.WriteString(t, temp, " than the max log size\nnext\n")
+	r.ReadToEnd(context.Background())
+	sink.ExpectToken(t, []byte("next"))
+	sink.ExpectNoCalls(t)
+	assert.False(t, r.SkipOversize)
+}
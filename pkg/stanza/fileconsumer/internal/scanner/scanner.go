@@ -15,7 +15,8 @@ const DefaultBufferSize = 16 * 1024
 
 // Scanner is a scanner that maintains position
 type Scanner struct {
-	pos int64
+	pos      int64
+	tokenPos int64
 	*bufio.Scanner
 }
 
@@ -25,6 +26,9 @@ func New(r io.Reader, maxLogSize int, bufferSize int, startOffset int64, splitFu
 	s.Buffer(make([]byte, 0, bufferSize), maxLogSize)
 	scanFunc := func(data []byte, atEOF bool) (advance int, token []byte, err error) {
 		advance, token, err = splitFunc(data, atEOF)
+		if token != nil {
+			s.tokenPos = s.pos
+		}
 		s.pos += int64(advance)
 		return
 	}
@@ -37,6 +41,11 @@ func (s *Scanner) Pos() int64 {
 	return s.pos
 }
 
+// TokenPos returns the position of the data consumed along with the current token
+func (s *Scanner) TokenPos() int64 {
+	return s.tokenPos
+}
+
 func (s *Scanner) Error() error {
 	err := s.Err()
 	if errors.Is(err, bufio.ErrTooLong) {
@@ -83,6 +83,7 @@ func TestScanner(t *testing.T) {
 
 				token := scanner.Bytes()
 				assert.Equal(t, tc.expected[i], token)
+				assert.Equal(t, int64(p), scanner.TokenPos())
 
 				p += len(tc.expected[i])
 				if i > 0 || !tc.skipFirstDelimiter {
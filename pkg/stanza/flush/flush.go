@@ -11,6 +11,8 @@ import (
 type State struct {
 	LastDataChange time.Time
 	LastDataLength int
+	// TokenStart is when the data of the pending token was first seen, or zero if there is none.
+	TokenStart time.Time
 }
 
 func (s *State) Copy() *State {
@@ -20,6 +22,7 @@ func (s *State) Copy() *State {
 	return &State{
 		LastDataChange: s.LastDataChange,
 		LastDataLength: s.LastDataLength,
+		TokenStart:     s.TokenStart,
 	}
 }
 
@@ -70,6 +73,51 @@ func (s *State) Func(splitFunc bufio.SplitFunc, period time.Duration) bufio.Spli
 	}
 }
 
+// AgeFunc wraps a bufio.SplitFunc with a token age limit.
+// Once the data of a pending token was first seen more than maxAge ago, it is returned
+// as an incomplete token, even if more data has been read since.
+func (s *State) AgeFunc(splitFunc bufio.SplitFunc, maxAge time.Duration) bufio.SplitFunc {
+	if s == nil || maxAge <= 0 {
+		return splitFunc
+	}
+
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := splitFunc(data, atEOF)
+		// Don't interfere with errors
+		if err != nil {
+			return advance, token, err
+		}
+
+		// If there's a token, the next one has not been seen yet
+		if token != nil || advance > 0 {
+			s.TokenStart = time.Time{}
+			return advance, token, err
+		}
+
+		// Can't flush something from nothing
+		if len(data) == 0 {
+			s.TokenStart = time.Time{}
+			return 0, nil, nil
+		}
+
+		if s.TokenStart.IsZero() {
+			s.TokenStart = time.Now()
+			return 0, nil, nil
+		}
+
+		// Token is too old
+		if time.Since(s.TokenStart) > maxAge {
+			s.TokenStart = time.Time{}
+			s.LastDataChange = time.Now()
+			s.LastDataLength = 0
+			return len(data), data, nil
+		}
+
+		// Ask for more data
+		return 0, nil, nil
+	}
+}
+
 // Deprecated: [v0.88.0] Use WithFunc instead.
 func WithPeriod(splitFunc bufio.SplitFunc, period time.Duration) bufio.SplitFunc {
 	s := &State{LastDataChange: time.Now()}
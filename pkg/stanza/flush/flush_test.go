@@ -8,6 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split/splittest"
 )
 
@@ -47,3 +50,41 @@ func TestNewlineSplitFunc(t *testing.T) {
 		t.Run(tc.name+"/Func", splittest.New(previousState.Func(tc.baseFunc, tc.flushPeriod), tc.input, tc.steps...))
 	}
 }
+
+func TestAgeFunc(t *testing.T) {
+	maxAge := 100 * time.Millisecond
+	input := []byte("complete line\nincomplete")
+	steps := []splittest.Step{
+		splittest.ExpectAdvanceToken(len("complete line\n"), "complete line"),
+		splittest.ExpectReadMore(),
+		splittest.Eventually(splittest.ExpectToken("incomplete"), 150*time.Millisecond, 10*time.Millisecond),
+	}
+
+	t.Run("NoMaxAge", splittest.New((&State{}).AgeFunc(splittest.ScanLinesStrict, 0), input, steps[0]))
+	t.Run("NilState", splittest.New((*State)(nil).AgeFunc(splittest.ScanLinesStrict, maxAge), input, steps[0]))
+	t.Run("MaxAge", splittest.New((&State{}).AgeFunc(splittest.ScanLinesStrict, maxAge), input, steps...))
+}
+
+func TestAgeFuncGrowingToken(t *testing.T) {
+	maxAge := 50 * time.Millisecond
+	s := &State{LastDataChange: time.Now()}
+	// The flush period does not expire while data keeps being read, unlike the token age.
+	splitFunc := s.AgeFunc(s.Func(splittest.ScanLinesStrict, time.Minute), maxAge)
+
+	data := []byte("incomplete")
+	start := time.Now()
+	for {
+		advance, token, err := splitFunc(data, false)
+		require.NoError(t, err)
+		if token != nil {
+			assert.Equal(t, len(data), advance)
+			assert.Equal(t, data, token)
+			break
+		}
+		require.Less(t, time.Since(start), 10*maxAge, "token was never flushed")
+		data = append(data, '.')
+		time.Sleep(5 * time.Millisecond)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), maxAge)
+	assert.True(t, s.TokenStart.IsZero())
+}
@@ -471,8 +471,8 @@ func TestBuild(t *testing.T) {
 				cfg.SplitConfig.LineEndPattern = "Exists"
 				cfg.SplitConfig.LineStartPattern = "Exists"
 			},
-			require.Error,
-			nil,
+			require.NoError,
+			func(_ *testing.T, _ *Input) {},
 		},
 		{
 			"MultilineConfiguredStartPattern",
@@ -504,8 +504,8 @@ func TestBuild(t *testing.T) {
 				cfg.SplitConfig.LineStartPattern = ".*"
 				cfg.SplitConfig.LineEndPattern = ".*"
 			},
-			require.Error,
-			nil,
+			require.NoError,
+			func(_ *testing.T, _ *Input) {},
 		},
 		{
 			"NoLineStartOrEnd",
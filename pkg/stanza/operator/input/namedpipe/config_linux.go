@@ -35,6 +35,10 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		return nil, fmt.Errorf("failed to create split function: %w", err)
 	}
 
+	if err = c.TrimConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid trim config: %w", err)
+	}
+
 	maxLogSize := c.MaxLogSize
 	if maxLogSize == 0 {
 		maxLogSize = DefaultMaxLogSize
@@ -75,10 +75,6 @@ type BaseConfig struct {
 
 type SplitFuncBuilder func(enc encoding.Encoding) (bufio.SplitFunc, error)
 
-func (c Config) defaultSplitFuncBuilder(enc encoding.Encoding) (bufio.SplitFunc, error) {
-	return c.SplitConfig.Func(enc, true, int(c.MaxLogSize))
-}
-
 // Build will build a tcp input operator.
 func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error) {
 	inputOperator, err := c.InputConfig.Build(set)
@@ -109,16 +105,33 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		return nil, err
 	}
 
-	if c.SplitFuncBuilder == nil {
-		c.SplitFuncBuilder = c.defaultSplitFuncBuilder
+	if err = c.SplitConfig.Validate(); err != nil {
+		return nil, err
 	}
 
-	// Build split func
-	splitFunc, err := c.SplitFuncBuilder(enc)
-	if err != nil {
+	if err = c.SplitConfig.ValidateEncoding(enc); err != nil {
 		return nil, err
 	}
-	splitFunc = trim.WithFunc(splitFunc, c.TrimConfig.Func())
+
+	if err = c.TrimConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Build split func, created for every connection so that it can search its data incrementally
+	var newSplitFunc func() bufio.SplitFunc
+	if c.SplitFuncBuilder == nil {
+		newSplitFunc, err = c.SplitConfig.FuncFactory(enc, true, int(c.MaxLogSize))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		splitFunc, err := c.SplitFuncBuilder(enc)
+		if err != nil {
+			return nil, err
+		}
+		newSplitFunc = func() bufio.SplitFunc { return splitFunc }
+	}
+	trimFunc := c.TrimConfig.Func()
 
 	var resolver *helper.IPResolver
 	if c.AddAttributes {
@@ -132,7 +145,8 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		addAttributes:   c.AddAttributes,
 		OneLogPerPacket: c.OneLogPerPacket,
 		encoding:        enc,
-		splitFunc:       splitFunc,
+		newSplitFunc:    func() bufio.SplitFunc { return trim.WithFunc(newSplitFunc(), trimFunc) },
+		decodedTrimFunc: c.TrimConfig.DecodedFunc(),
 		backoff: backoff.Backoff{
 			Max: 3 * time.Second,
 		},
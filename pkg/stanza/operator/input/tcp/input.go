@@ -23,6 +23,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
 // Input is an operator that listens for log entries over tcp.
@@ -39,9 +40,10 @@ type Input struct {
 	tls      *tls.Config
 	backoff  backoff.Backoff
 
-	encoding  encoding.Encoding
-	splitFunc bufio.SplitFunc
-	resolver  *helper.IPResolver
+	encoding        encoding.Encoding
+	newSplitFunc    func() bufio.SplitFunc
+	decodedTrimFunc trim.Func
+	resolver        *helper.IPResolver
 }
 
 // Start will start listening for log entries over tcp.
@@ -146,7 +148,7 @@ func (i *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 		scanner := bufio.NewScanner(conn)
 		scanner.Buffer(buf, i.MaxLogSize)
 
-		scanner.Split(i.splitFunc)
+		scanner.Split(i.newSplitFunc())
 
 		for scanner.Scan() {
 			i.handleMessage(ctx, conn, dec, scanner.Bytes())
@@ -164,6 +166,7 @@ func (i *Input) handleMessage(ctx context.Context, conn net.Conn, dec *decode.De
 		i.Logger().Error("Failed to decode data", zap.Error(err))
 		return
 	}
+	decoded = i.decodedTrimFunc(decoded)
 
 	entry, err := i.NewEntry(string(decoded))
 	if err != nil {
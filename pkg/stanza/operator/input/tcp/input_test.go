@@ -4,6 +4,7 @@
 package tcp
 
 import (
+	"bufio"
 	"crypto/tls"
 	"math/rand"
 	"net"
@@ -16,9 +17,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configtls"
+	"golang.org/x/text/encoding"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
 )
 
@@ -323,6 +326,48 @@ func TestBuild(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid-line-start-pattern",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress: "10.0.0.1:9000",
+					SplitConfig:   split.Config{LineStartPattern: "("},
+				},
+			},
+			true,
+		},
+		{
+			"invalid-split-config-with-split-func-builder",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress:    "10.0.0.1:9000",
+					SplitConfig:      split.Config{SkipFirstPartialOnResume: true},
+					SplitFuncBuilder: func(encoding.Encoding) (bufio.SplitFunc, error) { return bufio.ScanLines, nil },
+				},
+			},
+			true,
+		},
+		{
+			"validate-utf8-other-encoding",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress: "10.0.0.1:9000",
+					Encoding:      "utf-16le",
+					SplitConfig:   split.Config{ValidateUTF8: true},
+				},
+			},
+			true,
+		},
+		{
+			"validate-utf8",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress: "10.0.0.1:9000",
+					SplitConfig:   split.Config{ValidateUTF8: true},
+				},
+			},
+			false,
+		},
 		{
 			"tls-enabled-with-no-such-file-error",
 			Config{
@@ -347,6 +392,9 @@ func TestBuild(t *testing.T) {
 			cfg.ListenAddress = tc.inputBody.ListenAddress
 			cfg.MaxLogSize = tc.inputBody.MaxLogSize
 			cfg.TLS = tc.inputBody.TLS
+			cfg.Encoding = tc.inputBody.Encoding
+			cfg.SplitConfig = tc.inputBody.SplitConfig
+			cfg.SplitFuncBuilder = tc.inputBody.SplitFuncBuilder
 			set := componenttest.NewNopTelemetrySettings()
 			_, err := cfg.Build(set)
 			if tc.expectErr {
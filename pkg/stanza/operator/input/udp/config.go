@@ -95,6 +95,18 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		return nil, err
 	}
 
+	if err = c.SplitConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = c.SplitConfig.ValidateEncoding(enc); err != nil {
+		return nil, err
+	}
+
+	if err = c.TrimConfig.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Build split func
 	splitFunc, err := c.SplitConfig.Func(enc, true, MaxUDPSize)
 	if err != nil {
@@ -126,6 +138,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		addAttributes:   c.AddAttributes,
 		encoding:        enc,
 		splitFunc:       splitFunc,
+		decodedTrimFunc: c.TrimConfig.DecodedFunc(),
 		resolver:        resolver,
 		OneLogPerPacket: c.OneLogPerPacket,
 		AsyncConfig:     c.AsyncConfig,
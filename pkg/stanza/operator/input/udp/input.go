@@ -18,6 +18,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
 // Input is an operator that listens to a socket for log entries.
@@ -34,9 +35,10 @@ type Input struct {
 	wg         sync.WaitGroup
 	wgReader   sync.WaitGroup
 
-	encoding  encoding.Encoding
-	splitFunc bufio.SplitFunc
-	resolver  *helper.IPResolver
+	encoding        encoding.Encoding
+	splitFunc       bufio.SplitFunc
+	decodedTrimFunc trim.Func
+	resolver        *helper.IPResolver
 
 	messageQueue   chan messageAndAddress
 	readBufferPool sync.Pool
@@ -195,6 +197,7 @@ func (i *Input) handleMessage(ctx context.Context, remoteAddr net.Addr, dec *dec
 			i.Logger().Error("Failed to decode data", zap.Error(err))
 			return
 		}
+		decoded = i.decodedTrimFunc(decoded)
 	}
 
 	entry, err := i.NewEntry(string(decoded))
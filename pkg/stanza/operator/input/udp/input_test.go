@@ -16,6 +16,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
 )
 
@@ -137,6 +138,62 @@ func udpInputAttributesTest(input []byte, expected []string) func(t *testing.T)
 	}
 }
 
+func TestBuild(t *testing.T) {
+	cases := []struct {
+		name        string
+		encoding    string
+		splitConfig split.Config
+		expectErr   bool
+	}{
+		{
+			"default",
+			"",
+			split.Config{},
+			false,
+		},
+		{
+			"invalid-line-start-pattern",
+			"",
+			split.Config{LineStartPattern: "("},
+			true,
+		},
+		{
+			"skip-first-partial-without-line-start-pattern",
+			"",
+			split.Config{SkipFirstPartialOnResume: true},
+			true,
+		},
+		{
+			"validate-utf8",
+			"",
+			split.Config{ValidateUTF8: true},
+			false,
+		},
+		{
+			"validate-utf8-other-encoding",
+			"utf-16le",
+			split.Config{ValidateUTF8: true},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test_id")
+			cfg.ListenAddress = ":0"
+			cfg.Encoding = tc.encoding
+			cfg.SplitConfig = tc.splitConfig
+			set := componenttest.NewNopTelemetrySettings()
+			_, err := cfg.Build(set)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestInput(t *testing.T) {
 	cfg := NewConfigWithID("test_input")
 	cfg.ListenAddress = ":0"
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package split // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// aliasRegex matches a grok-style pattern alias, such as %{TIMESTAMP_ISO8601}.
+var aliasRegex = regexp.MustCompile(`%\{([A-Z0-9_]+)\}`)
+
+// aliases are the regexes of the pattern aliases, which may refer to other aliases.
+var aliases = map[string]string{
+	"INT":        `[+-]?\d+`,
+	"NUMBER":     `[+-]?(?:\d+(?:\.\d*)?|\.\d+)`,
+	"WORD":       `\b\w+\b`,
+	"NOTSPACE":   `\S+`,
+	"SPACE":      `\s*`,
+	"DATA":       `.*?`,
+	"GREEDYDATA": `.*`,
+	"UUID":       `[A-Fa-f0-9]{8}-(?:[A-Fa-f0-9]{4}-){3}[A-Fa-f0-9]{12}`,
+	"IPV4":       `(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)`,
+	"LOGLEVEL":   `(?i:trace|debug|info(?:rmation)?|notice|warn(?:ing)?|err(?:or)?|crit(?:ical)?|fatal|severe|emerg(?:ency)?|alert)`,
+
+	"YEAR":     `\d{4}`,
+	"MONTHNUM": `0?[1-9]|1[0-2]`,
+	"MONTHDAY": `0?[1-9]|[12]\d|3[01]`,
+	"MONTH":    `\b(?:Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|June?|July?|Aug(?:ust)?|Sep(?:t(?:ember)?)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\b`,
+	"DAY":      `\b(?:Mon(?:day)?|Tue(?:sday)?|Wed(?:nesday)?|Thu(?:rsday)?|Fri(?:day)?|Sat(?:urday)?|Sun(?:day)?)\b`,
+	"HOUR":     `2[0-3]|[01]?\d`,
+	"MINUTE":   `[0-5]\d`,
+	"SECOND":   `(?:60|[0-5]?\d)(?:[:.,]\d+)?`,
+	"TIME":     `%{HOUR}:%{MINUTE}(?::%{SECOND})?`,
+
+	"ISO8601_TIMEZONE":  `Z|[+-]%{HOUR}(?::?%{MINUTE})?`,
+	"TIMESTAMP_ISO8601": `%{YEAR}-%{MONTHNUM}-%{MONTHDAY}[T ]%{HOUR}:?%{MINUTE}(?::?%{SECOND})?%{ISO8601_TIMEZONE}?`,
+	"SYSLOGTIMESTAMP":   `%{MONTH} +%{MONTHDAY} %{TIME}`,
+	"HTTPDATE":          `%{MONTHDAY}/%{MONTH}/%{YEAR}:%{TIME} %{INT}`,
+	"DATESTAMP_RFC2822": `%{DAY}, %{MONTHDAY} %{MONTH} %{YEAR} %{TIME} (?:%{ISO8601_TIMEZONE}|[A-Z]{3})`,
+}
+
+// expandAliases replaces the pattern aliases found in pattern with their regexes, in non-capturing groups.
+func expandAliases(pattern string) (string, error) {
+	var err error
+	expanded := aliasRegex.ReplaceAllStringFunc(pattern, func(alias string) string {
+		name := aliasRegex.FindStringSubmatch(alias)[1]
+		regex, ok := aliases[name]
+		if !ok {
+			if err == nil {
+				err = fmt.Errorf("unknown pattern alias '%s'", alias)
+			}
+			return alias
+		}
+		// the regexes of the aliases refer to other aliases, but never to themselves
+		regex, expandErr := expandAliases(regex)
+		if expandErr != nil && err == nil {
+			err = expandErr
+		}
+		return "(?:" + regex + ")"
+	})
+	return expanded, err
+}
+
+// withAliasesExpanded returns a copy of the config with the pattern aliases expanded in its patterns.
+func (c Config) withAliasesExpanded() (Config, error) {
+	var err error
+	expand := func(pattern string) string {
+		expanded, expandErr := expandAliases(pattern)
+		if expandErr != nil && err == nil {
+			err = expandErr
+		}
+		return expanded
+	}
+	expandAll := func(patterns []string) []string {
+		if patterns == nil {
+			return nil
+		}
+		expanded := make([]string, len(patterns))
+		for i, pattern := range patterns {
+			expanded[i] = expand(pattern)
+		}
+		return expanded
+	}
+
+	c.LineStartPattern = expand(c.LineStartPattern)
+	c.LineStartPatterns = expandAll(c.LineStartPatterns)
+	c.LineEndPattern = expand(c.LineEndPattern)
+	c.LineEndPatterns = expandAll(c.LineEndPatterns)
+	c.LineContinuationPattern = expand(c.LineContinuationPattern)
+	return c, err
+}
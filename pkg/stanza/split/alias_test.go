@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package split
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split/splittest"
+)
+
+func TestAliases(t *testing.T) {
+	for name := range aliases {
+		expanded, err := expandAliases("%{" + name + "}")
+		require.NoError(t, err, name)
+		_, err = regexp.Compile(expanded)
+		require.NoError(t, err, name)
+		assert.NotContains(t, expanded, "%{", name)
+	}
+}
+
+func TestExpandAliases(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		matches  []string
+		rejected []string
+	}{
+		{
+			pattern:  `^%{TIMESTAMP_ISO8601} `,
+			matches:  []string{"2024-01-02T03:04:05Z ", "2024-01-02 03:04:05,123 ", "2024-01-02T03:04:05.123+02:00 "},
+			rejected: []string{"2024-13-02T03:04:05Z ", "02/01/2024 03:04:05 "},
+		},
+		{
+			pattern:  `^%{SYSLOGTIMESTAMP} `,
+			matches:  []string{"Jan  2 03:04:05 ", "Dec 31 23:59:59 "},
+			rejected: []string{"2024-01-02 03:04:05 "},
+		},
+		{
+			pattern:  `^\[%{HTTPDATE}\]`,
+			matches:  []string{"[02/Jan/2024:03:04:05 +0100]"},
+			rejected: []string{"[02/01/2024:03:04:05 +0100]"},
+		},
+		{
+			pattern:  `^%{LOGLEVEL}:`,
+			matches:  []string{"ERROR:", "warn:", "Info:"},
+			rejected: []string{"message:"},
+		},
+		{
+			// escaped, so that it is not an alias
+			pattern: `^%\{INT\}`,
+			matches: []string{"%{INT}"},
+		},
+	}
+	for _, tc := range testCases {
+		expanded, err := expandAliases(tc.pattern)
+		require.NoError(t, err)
+		re := regexp.MustCompile(expanded)
+		for _, s := range tc.matches {
+			assert.True(t, re.MatchString(s), "%s should match %q", tc.pattern, s)
+		}
+		for _, s := range tc.rejected {
+			assert.False(t, re.MatchString(s), "%s should not match %q", tc.pattern, s)
+		}
+	}
+
+	_, err := expandAliases(`^%{TIMESTAMP} %{LOGLEVEL}`)
+	assert.EqualError(t, err, "unknown pattern alias '%{TIMESTAMP}'")
+}
+
+func TestConfigAliases(t *testing.T) {
+	cfg := Config{LineStartPattern: `^%{TIMESTAMP_ISO8601}`}
+	require.NoError(t, cfg.Validate())
+	splitFunc, err := cfg.Func(unicode.UTF8, false, 0)
+	require.NoError(t, err)
+	t.Run("LineStart", splittest.New(splitFunc,
+		[]byte("2024-01-02T03:04:05Z log1\n\tat frame\n2024-01-02T03:04:06Z log2\n"),
+		splittest.ExpectToken("2024-01-02T03:04:05Z log1\n\tat frame\n"),
+	))
+
+	assert.EqualError(t, Config{LineEndPattern: `%{NOPE}$`}.Validate(), "unknown pattern alias '%{NOPE}'")
+	_, err = Config{LineStartPatterns: []string{`^%{NOPE}`}}.Func(unicode.UTF8, false, 0)
+	assert.EqualError(t, err, "unknown pattern alias '%{NOPE}'")
+}
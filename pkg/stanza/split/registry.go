@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package split // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
+
+import (
+	"bufio"
+
+	"golang.org/x/text/encoding"
+)
+
+// FuncBuilder builds a bufio.SplitFunc for the given encoding. Its arguments are those of Config.Func.
+type FuncBuilder func(enc encoding.Encoding, flushAtEOF bool, maxLogSize int) (bufio.SplitFunc, error)
+
+// DefaultRegistry is a global registry of split modes to split func builders.
+var DefaultRegistry = NewRegistry()
+
+// Registry is used to track and retrieve the split funcs that external modules provide
+type Registry struct {
+	builders map[string]FuncBuilder
+}
+
+// NewRegistry creates a new registry
+func NewRegistry() *Registry {
+	return &Registry{
+		builders: make(map[string]FuncBuilder),
+	}
+}
+
+// Register will register a split func builder to a split mode, so that it is used
+// by configs setting that mode. The built-in modes cannot be overridden.
+func (r *Registry) Register(mode string, build FuncBuilder) {
+	r.builders[mode] = build
+}
+
+// Lookup looks up a given split mode. Its second return value will
+// be false if no builder is registered for that mode.
+func (r *Registry) Lookup(mode string) (FuncBuilder, bool) {
+	b, ok := r.builders[mode]
+	return b, ok
+}
+
+// Register will register a split func builder in the default registry
+func Register(mode string, build FuncBuilder) {
+	DefaultRegistry.Register(mode, build)
+}
+
+// Lookup looks up a given split mode in the default registry. Its second return value will
+// be false if no builder is registered for that mode.
+func Lookup(mode string) (FuncBuilder, bool) {
+	return DefaultRegistry.Lookup(mode)
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package split
+
+import (
+	"bufio"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	_, ok := r.Lookup("custom")
+	assert.False(t, ok)
+
+	r.Register("custom", func(encoding.Encoding, bool, int) (bufio.SplitFunc, error) {
+		return bufio.ScanWords, nil
+	})
+	build, ok := r.Lookup("custom")
+	require.True(t, ok)
+	splitFunc, err := build(unicode.UTF8, false, 0)
+	require.NoError(t, err)
+	assert.NotNil(t, splitFunc)
+}
+
+func TestConfigRegisteredMode(t *testing.T) {
+	mode := "test_registered_words"
+	assert.EqualError(t, Config{Mode: mode}.Validate(), "invalid mode 'test_registered_words'")
+
+	var gotMaxLogSize int
+	Register(mode, func(_ encoding.Encoding, _ bool, maxLogSize int) (bufio.SplitFunc, error) {
+		gotMaxLogSize = maxLogSize
+		return bufio.ScanWords, nil
+	})
+	t.Cleanup(func() { delete(DefaultRegistry.builders, mode) })
+
+	require.NoError(t, Config{Mode: mode}.Validate())
+	assert.EqualError(t, Config{Mode: mode, LineStartPattern: "^start"}.Validate(),
+		"mode test_registered_words cannot be used with line_start_pattern or line_end_pattern")
+
+	for _, enc := range []encoding.Encoding{unicode.UTF8, encoding.Nop} {
+		splitFunc, err := Config{Mode: mode}.Func(enc, false, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 100, gotMaxLogSize)
+
+		advance, token, err := splitFunc([]byte("one two"), false)
+		require.NoError(t, err)
+		assert.Equal(t, 4, advance)
+		assert.Equal(t, []byte("one"), token)
+	}
+}
@@ -6,10 +6,47 @@ package split // import "github.com/open-telemetry/opentelemetry-collector-contr
 import (
 	"bufio"
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/maphash"
 	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode/utf8"
 
 	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+)
+
+const (
+	// ModeJSON is the split mode returning complete JSON objects and arrays as tokens.
+	ModeJSON = "json"
+	// ModeIndent is the split mode returning lines along with the indented lines following them as tokens.
+	ModeIndent = "indent"
+	// ModeLengthPrefixed is the split mode returning the payloads of length-prefixed records as tokens.
+	ModeLengthPrefixed = "length_prefixed"
+	// ModeDelimiter is the split mode returning the records separated by a delimiter as tokens.
+	ModeDelimiter = "delimiter"
+)
+
+const (
+	// ByteOrderBigEndian is the byte order of a length prefix with its most significant byte first.
+	ByteOrderBigEndian = "big_endian"
+	// ByteOrderLittleEndian is the byte order of a length prefix with its least significant byte first.
+	ByteOrderLittleEndian = "little_endian"
+
+	defaultLengthPrefixSize = 4
+)
+
+const (
+	// NewlineLF is the newline made of a line feed, which a token does not end with.
+	NewlineLF = "lf"
+	// NewlineCRLF is the newline made of a carriage return followed by a line feed.
+	NewlineCRLF = "crlf"
+	// NewlineCR is the newline made of a carriage return.
+	NewlineCR = "cr"
+	// NewlineCustom is the newline made of the sequence set in the config.
+	NewlineCustom = "custom"
 )
 
 // Config is the configuration for a split func
@@ -17,103 +54,644 @@ type Config struct {
 	LineStartPattern string `mapstructure:"line_start_pattern"`
 	LineEndPattern   string `mapstructure:"line_end_pattern"`
 	OmitPattern      bool   `mapstructure:"omit_pattern"`
+	ValidateUTF8     bool   `mapstructure:"validate_utf8"`
+
+	// LineStartPatterns are additional line start patterns. A token starts at a match
+	// of any of them or of LineStartPattern.
+	LineStartPatterns []string `mapstructure:"line_start_patterns"`
+
+	// LineEndPatterns are additional line end patterns. A token ends at the earliest match
+	// of any of them or of LineEndPattern.
+	LineEndPatterns []string `mapstructure:"line_end_patterns"`
+
+	// SkipFirstPartialOnResume discards the bytes preceding the first match of LineStartPattern
+	// when reading starts from a non-zero offset, since they are the tail of a record that was not read.
+	SkipFirstPartialOnResume bool `mapstructure:"skip_first_partial_on_resume"`
+
+	// DropUnmatched discards the bytes found outside of any token when both a line start
+	// and a line end pattern are set, instead of emitting them as tokens of their own.
+	DropUnmatched bool `mapstructure:"drop_unmatched"`
+
+	// MaxLines is the maximum number of lines in a token. A token that does not end
+	// before then is flushed at the end of its MaxLines-th line. Zero means no limit.
+	MaxLines int `mapstructure:"max_lines"`
+
+	// Mode selects a split func that does not use patterns, such as ModeJSON,
+	// or a split func registered under that name.
+	Mode string `mapstructure:"mode"`
+
+	// Negate inverts LineStartPattern or LineEndPattern, so that they match the lines
+	// that do not match the pattern.
+	Negate bool `mapstructure:"negate"`
+
+	// LineContinuationPattern matches the lines that are continued by the next line,
+	// such as the lines ending with a backslash.
+	LineContinuationPattern string `mapstructure:"line_continuation_pattern"`
+
+	// IndentPrefix is the prefix of the continuation lines in ModeIndent.
+	// By default, lines starting with a space or a tab are continuation lines.
+	IndentPrefix string `mapstructure:"indent_prefix"`
+
+	// LengthPrefixSize is the size in bytes of the length prefix in ModeLengthPrefixed,
+	// one of 1, 2, 4 or 8. Zero means 4.
+	LengthPrefixSize int `mapstructure:"length_prefix_size"`
+	// LengthPrefixByteOrder is the byte order of the length prefix in ModeLengthPrefixed,
+	// ByteOrderBigEndian or ByteOrderLittleEndian. Empty means ByteOrderBigEndian.
+	LengthPrefixByteOrder string `mapstructure:"length_prefix_byte_order"`
+	// LengthIncludesPrefix is set if the length prefix counts its own size in ModeLengthPrefixed.
+	LengthIncludesPrefix bool `mapstructure:"length_includes_prefix"`
+
+	// Delimiter is the string separating the records in ModeDelimiter.
+	Delimiter string `mapstructure:"delimiter"`
+	// KeepDelimiter is set if the delimiter ending a token is part of it in ModeDelimiter.
+	KeepDelimiter bool `mapstructure:"keep_delimiter"`
+
+	// Newline is the sequence ending the lines when no multiline pattern or mode is set,
+	// one of NewlineLF, NewlineCRLF, NewlineCR or NewlineCustom. By default, lines end with
+	// a line feed, and the carriage return preceding it is trimmed.
+	Newline string `mapstructure:"newline"`
+	// NewlineSequence is the sequence ending the lines with NewlineCustom.
+	NewlineSequence string `mapstructure:"newline_sequence"`
 }
 
-// Func will return a bufio.SplitFunc based on the config
+// QuarantineFunc is called with the tokens that are diverted by a split func,
+// such as tokens that are not valid UTF-8.
+type QuarantineFunc func(token []byte)
+
+// Validate checks the config for invalid or conflicting options
+func (c Config) Validate() error {
+	c, err := c.withAliasesExpanded()
+	if err != nil {
+		return err
+	}
+	if c.LineStartPattern != "" {
+		if _, err := regexp.Compile("(?m)" + c.LineStartPattern); err != nil {
+			return fmt.Errorf("compile line start regex: %w", err)
+		}
+	}
+	for _, pattern := range c.LineStartPatterns {
+		if _, err := regexp.Compile("(?m)" + pattern); err != nil {
+			return fmt.Errorf("compile line start regex: %w", err)
+		}
+	}
+	if c.LineEndPattern != "" {
+		if _, err := regexp.Compile("(?m)" + c.LineEndPattern); err != nil {
+			return fmt.Errorf("compile line end regex: %w", err)
+		}
+	}
+	for _, pattern := range c.LineEndPatterns {
+		if _, err := regexp.Compile("(?m)" + pattern); err != nil {
+			return fmt.Errorf("compile line end regex: %w", err)
+		}
+	}
+	if c.Negate {
+		if (c.startPattern() == "") == (c.LineEndPattern == "") || len(c.LineEndPatterns) != 0 {
+			return fmt.Errorf("negate requires either line_start_pattern or line_end_pattern")
+		}
+		if c.OmitPattern || c.SkipFirstPartialOnResume {
+			return fmt.Errorf("negate cannot be used with omit_pattern or skip_first_partial_on_resume")
+		}
+	}
+	if c.LineContinuationPattern != "" {
+		if _, err := regexp.Compile("(?m)" + c.LineContinuationPattern); err != nil {
+			return fmt.Errorf("compile line continuation regex: %w", err)
+		}
+		if c.hasStartOrEndPattern() || c.Mode != "" {
+			return fmt.Errorf("line_continuation_pattern cannot be used with line_start_pattern, line_end_pattern or mode")
+		}
+	}
+	if c.SkipFirstPartialOnResume && c.startPattern() == "" {
+		return fmt.Errorf("skip_first_partial_on_resume requires line_start_pattern")
+	}
+	if c.DropUnmatched && (c.startPattern() == "" || c.LineEndPattern == "" && len(c.LineEndPatterns) == 0) {
+		return fmt.Errorf("drop_unmatched requires both line_start_pattern and line_end_pattern")
+	}
+	if c.MaxLines < 0 {
+		return fmt.Errorf("invalid max_lines %d, must not be negative", c.MaxLines)
+	}
+	if c.MaxLines > 0 && !c.hasStartOrEndPattern() && c.LineContinuationPattern == "" && c.Mode == "" {
+		return fmt.Errorf("max_lines requires a multiline pattern or mode")
+	}
+	if c.IndentPrefix != "" && c.Mode != ModeIndent {
+		return fmt.Errorf("indent_prefix requires mode %s", ModeIndent)
+	}
+	if (c.LengthPrefixSize != 0 || c.LengthPrefixByteOrder != "" || c.LengthIncludesPrefix) && c.Mode != ModeLengthPrefixed {
+		return fmt.Errorf("length_prefix_size, length_prefix_byte_order and length_includes_prefix require mode %s", ModeLengthPrefixed)
+	}
+	if (c.Delimiter != "" || c.KeepDelimiter) && c.Mode != ModeDelimiter {
+		return fmt.Errorf("delimiter and keep_delimiter require mode %s", ModeDelimiter)
+	}
+	if c.Mode == ModeDelimiter && c.Delimiter == "" {
+		return fmt.Errorf("mode %s requires delimiter", ModeDelimiter)
+	}
+	switch c.Newline {
+	case "", NewlineLF, NewlineCRLF, NewlineCR:
+		if c.NewlineSequence != "" {
+			return fmt.Errorf("newline_sequence requires newline %s", NewlineCustom)
+		}
+	case NewlineCustom:
+		if c.NewlineSequence == "" {
+			return fmt.Errorf("newline %s requires newline_sequence", NewlineCustom)
+		}
+	default:
+		return fmt.Errorf("invalid newline '%s', must be %s, %s, %s or %s", c.Newline, NewlineLF, NewlineCRLF, NewlineCR, NewlineCustom)
+	}
+	if c.Newline != "" && (c.hasStartOrEndPattern() || c.LineContinuationPattern != "" || c.Mode != "") {
+		return fmt.Errorf("newline cannot be used with a multiline pattern or mode")
+	}
+	switch c.Mode {
+	case "":
+	case ModeJSON, ModeIndent, ModeLengthPrefixed, ModeDelimiter:
+		if c.hasStartOrEndPattern() {
+			return fmt.Errorf("mode %s cannot be used with line_start_pattern or line_end_pattern", c.Mode)
+		}
+		if c.Mode != ModeLengthPrefixed {
+			break
+		}
+		if c.MaxLines != 0 {
+			return fmt.Errorf("max_lines cannot be used with mode %s", c.Mode)
+		}
+		switch c.LengthPrefixSize {
+		case 0, 1, 2, 4, 8:
+		default:
+			return fmt.Errorf("invalid length_prefix_size %d, must be 1, 2, 4 or 8", c.LengthPrefixSize)
+		}
+		switch c.LengthPrefixByteOrder {
+		case "", ByteOrderBigEndian, ByteOrderLittleEndian:
+		default:
+			return fmt.Errorf("invalid length_prefix_byte_order '%s', must be %s or %s", c.LengthPrefixByteOrder, ByteOrderBigEndian, ByteOrderLittleEndian)
+		}
+	default:
+		if _, ok := Lookup(c.Mode); !ok {
+			return fmt.Errorf("invalid mode '%s'", c.Mode)
+		}
+		if c.hasStartOrEndPattern() {
+			return fmt.Errorf("mode %s cannot be used with line_start_pattern or line_end_pattern", c.Mode)
+		}
+	}
+	return nil
+}
+
+func (c Config) lengthPrefixedFunc(maxLogSize int, flushAtEOF bool) bufio.SplitFunc {
+	size := c.LengthPrefixSize
+	if size == 0 {
+		size = defaultLengthPrefixSize
+	}
+	var byteOrder binary.ByteOrder = binary.BigEndian
+	if c.LengthPrefixByteOrder == ByteOrderLittleEndian {
+		byteOrder = binary.LittleEndian
+	}
+	return LengthPrefixedSplitFunc(size, byteOrder, c.LengthIncludesPrefix, maxLogSize, flushAtEOF)
+}
+
+// startPattern returns the alternation of LineStartPattern and LineStartPatterns,
+// or an empty string if there are none.
+func (c Config) startPattern() string {
+	var patterns []string
+	if c.LineStartPattern != "" {
+		patterns = append(patterns, c.LineStartPattern)
+	}
+	patterns = append(patterns, c.LineStartPatterns...)
+	if len(patterns) <= 1 {
+		return strings.Join(patterns, "")
+	}
+	return "(?:" + strings.Join(patterns, ")|(?:") + ")"
+}
+
+func (c Config) hasStartOrEndPattern() bool {
+	return c.startPattern() != "" || c.LineEndPattern != "" || len(c.LineEndPatterns) != 0
+}
+
+// ValidateEncoding checks that the config can be used to split entries in the given encoding
+func (c Config) ValidateEncoding(enc encoding.Encoding) error {
+	if c.ValidateUTF8 && enc != unicode.UTF8 {
+		return fmt.Errorf("validate_utf8 can only be set when using utf-8 encoding")
+	}
+	if c.Mode == ModeJSON && enc != unicode.UTF8 {
+		return fmt.Errorf("mode %s can only be set when using utf-8 encoding", c.Mode)
+	}
+	return nil
+}
+
+// FirstPartialPattern returns the pattern marking the end of the first partial record
+// to skip when resuming, or nil if skip_first_partial_on_resume is not enabled.
+func (c Config) FirstPartialPattern() *regexp.Regexp {
+	c, err := c.withAliasesExpanded()
+	if err != nil || !c.SkipFirstPartialOnResume || c.startPattern() == "" {
+		return nil
+	}
+	return regexp.MustCompile("(?m)" + c.startPattern())
+}
+
+// Func will return a bufio.SplitFunc based on the config.
+// When validate_utf8 is enabled, tokens that are not valid UTF-8 are dropped.
 func (c Config) Func(enc encoding.Encoding, flushAtEOF bool, maxLogSize int) (bufio.SplitFunc, error) {
+	return c.FuncWithQuarantine(enc, flushAtEOF, maxLogSize, nil)
+}
+
+// FuncWithQuarantine will return a bufio.SplitFunc based on the config.
+// When validate_utf8 is enabled, tokens that are not valid UTF-8 are passed to
+// quarantine instead of being returned. A nil quarantine drops them.
+func (c Config) FuncWithQuarantine(enc encoding.Encoding, flushAtEOF bool, maxLogSize int, quarantine QuarantineFunc) (bufio.SplitFunc, error) {
+	if err := c.ValidateEncoding(enc); err != nil {
+		return nil, err
+	}
+
+	splitFunc, err := c.buildFunc(enc, flushAtEOF, maxLogSize)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrapFunc(splitFunc, enc, quarantine)
+}
+
+// FuncFactory will return a func creating a bufio.SplitFunc based on the config for every
+// bufio.Scanner it is used by. Unlike the split func returned by Func, the split funcs it creates
+// may keep track of the data already searched for a line_start_pattern, so that it is only
+// searched once however slowly a token grows. They must not be shared between scanners.
+func (c Config) FuncFactory(enc encoding.Encoding, flushAtEOF bool, maxLogSize int) (func() bufio.SplitFunc, error) {
+	splitFunc, err := c.Func(enc, flushAtEOF, maxLogSize)
+	if err != nil {
+		return nil, err
+	}
+	if c, err = c.withAliasesExpanded(); err != nil {
+		return nil, err
+	}
+	if enc != unicode.UTF8 || c.Mode != "" || c.Negate || c.LineContinuationPattern != "" ||
+		c.LineEndPattern != "" || len(c.LineEndPatterns) != 0 || c.startPattern() == "" {
+		return func() bufio.SplitFunc { return splitFunc }, nil
+	}
+	re := regexp.MustCompile("(?m)" + c.startPattern())
+	return func() bufio.SplitFunc {
+		// cannot fail, since the same wrappers were already built above
+		splitFunc, _ := c.wrapFunc(IncrementalLineStartSplitFunc(re, c.OmitPattern, flushAtEOF), enc, nil)
+		return splitFunc
+	}, nil
+}
+
+// wrapFunc wraps a split func built from the config with the funcs enforcing max_lines and validate_utf8.
+func (c Config) wrapFunc(splitFunc bufio.SplitFunc, enc encoding.Encoding, quarantine QuarantineFunc) (bufio.SplitFunc, error) {
+	if c.MaxLines > 0 {
+		newline, err := encodedNewline(enc)
+		if err != nil {
+			return nil, err
+		}
+		splitFunc = MaxLinesFunc(splitFunc, newline, c.MaxLines)
+	}
+	if !c.ValidateUTF8 {
+		return splitFunc, nil
+	}
+	return ValidUTF8Func(splitFunc, quarantine), nil
+}
+
+func (c Config) buildFunc(enc encoding.Encoding, flushAtEOF bool, maxLogSize int) (bufio.SplitFunc, error) {
+	c, err := c.withAliasesExpanded()
+	if err != nil {
+		return nil, err
+	}
+	switch c.Mode {
+	case ModeLengthPrefixed:
+		// binary framing, which does not depend on the encoding
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+		return c.lengthPrefixedFunc(maxLogSize, flushAtEOF), nil
+	case ModeDelimiter:
+		// the delimiter is encoded, and used as is with the nop encoding
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+		return DelimiterSplitFunc(enc, c.Delimiter, c.KeepDelimiter, flushAtEOF)
+	case "", ModeJSON, ModeIndent:
+	default:
+		// registered by an external module, which handles the encoding
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+		build, _ := Lookup(c.Mode)
+		return build(enc, flushAtEOF, maxLogSize)
+	}
+
 	if enc == encoding.Nop {
 		if c.LineEndPattern != "" {
 			return nil, fmt.Errorf("line_end_pattern should not be set when using nop encoding")
 		}
-		if c.LineStartPattern != "" {
+		if len(c.LineEndPatterns) != 0 {
+			return nil, fmt.Errorf("line_end_patterns should not be set when using nop encoding")
+		}
+		if c.startPattern() != "" {
 			return nil, fmt.Errorf("line_start_pattern should not be set when using nop encoding")
 		}
+		if c.LineContinuationPattern != "" {
+			return nil, fmt.Errorf("line_continuation_pattern should not be set when using nop encoding")
+		}
+		if c.Mode != "" {
+			return nil, fmt.Errorf("mode should not be set when using nop encoding")
+		}
+		if c.Newline != "" {
+			return nil, fmt.Errorf("newline should not be set when using nop encoding")
+		}
+		if maxLogSize <= 0 {
+			return nil, fmt.Errorf("max_log_size must be positive when using nop encoding")
+		}
 		return NoSplitFunc(maxLogSize), nil
 	}
 
-	if c.LineEndPattern == "" && c.LineStartPattern == "" {
-		return NewlineSplitFunc(enc, flushAtEOF)
+	if err := c.Validate(); err != nil {
+		return nil, err
 	}
 
-	if c.LineEndPattern != "" && c.LineStartPattern == "" {
-		re, err := regexp.Compile("(?m)" + c.LineEndPattern)
-		if err != nil {
-			return nil, fmt.Errorf("compile line end regex: %w", err)
+	switch c.Mode {
+	case ModeJSON:
+		return JSONSplitFunc(flushAtEOF), nil
+	case ModeIndent:
+		return IndentSplitFunc(enc, c.IndentPrefix, flushAtEOF)
+	}
+
+	if c.LineContinuationPattern != "" {
+		return LineContinuationSplitFunc(enc, regexp.MustCompile("(?m)"+c.LineContinuationPattern), flushAtEOF)
+	}
+
+	if c.Negate {
+		if c.startPattern() != "" {
+			return LineStartNegateSplitFunc(enc, regexp.MustCompile("(?m)"+c.startPattern()), flushAtEOF)
 		}
-		return LineEndSplitFunc(re, c.OmitPattern, flushAtEOF), nil
+		return LineEndNegateSplitFunc(enc, regexp.MustCompile("(?m)"+c.LineEndPattern), flushAtEOF)
 	}
 
-	if c.LineEndPattern == "" && c.LineStartPattern != "" {
-		re, err := regexp.Compile("(?m)" + c.LineStartPattern)
-		if err != nil {
-			return nil, fmt.Errorf("compile line start regex: %w", err)
+	if len(c.LineEndPatterns) != 0 {
+		var endRes []*regexp.Regexp
+		if c.LineEndPattern != "" {
+			endRes = append(endRes, regexp.MustCompile("(?m)"+c.LineEndPattern))
+		}
+		for _, pattern := range c.LineEndPatterns {
+			endRes = append(endRes, regexp.MustCompile("(?m)"+pattern))
 		}
-		return LineStartSplitFunc(re, c.OmitPattern, flushAtEOF), nil
+		if c.startPattern() != "" {
+			return lineStartEndSplitFunc(regexp.MustCompile("(?m)"+c.startPattern()), earliestMatch(endRes), c.OmitPattern, c.DropUnmatched, flushAtEOF), nil
+		}
+		return LineEndPatternsSplitFunc(endRes, c.OmitPattern, flushAtEOF), nil
 	}
 
-	return nil, fmt.Errorf("only one of line_start_pattern or line_end_pattern can be set")
+	switch {
+	case c.startPattern() != "" && c.LineEndPattern != "":
+		return lineStartEndSplitFunc(regexp.MustCompile("(?m)"+c.startPattern()), regexp.MustCompile("(?m)"+c.LineEndPattern).FindIndex, c.OmitPattern, c.DropUnmatched, flushAtEOF), nil
+	case c.LineEndPattern != "":
+		return LineEndSplitFunc(regexp.MustCompile("(?m)"+c.LineEndPattern), c.OmitPattern, flushAtEOF), nil
+	case c.startPattern() != "":
+		return LineStartSplitFunc(regexp.MustCompile("(?m)"+c.startPattern()), c.OmitPattern, flushAtEOF), nil
+	case c.Newline != "":
+		return NewlineSequenceSplitFunc(enc, c.newlineSequence(), flushAtEOF)
+	default:
+		return NewlineSplitFunc(enc, flushAtEOF)
+	}
+}
+
+// newlineSequence returns the sequence ending the lines, which Newline is set to.
+func (c Config) newlineSequence() string {
+	switch c.Newline {
+	case NewlineCRLF:
+		return "\r\n"
+	case NewlineCR:
+		return "\r"
+	case NewlineCustom:
+		return c.NewlineSequence
+	default:
+		return "\n"
+	}
 }
 
 // LineStartSplitFunc creates a bufio.SplitFunc that splits an incoming stream into
 // tokens that start with a match to the regex pattern provided
 func LineStartSplitFunc(re *regexp.Regexp, omitPattern bool, flushAtEOF bool) bufio.SplitFunc {
 	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
-		firstLoc := re.FindIndex(data)
-		if firstLoc == nil {
-			// Flush if no more data is expected
-			if len(data) != 0 && atEOF && flushAtEOF {
-				return len(data), data, nil
-			}
-			return 0, nil, nil // read more data and try again.
+		advance, token, _, _ = lineStartSplit(re, data, atEOF, 0, false, omitPattern, flushAtEOF)
+		return advance, token, nil
+	}
+}
+
+// IncrementalLineStartSplitFunc creates a bufio.SplitFunc like LineStartSplitFunc, which keeps
+// track of the data it already searched while waiting for more, so that every byte of a token
+// is searched once however many times the split func is called before the token is complete.
+// The split func must not be shared between scanners. Patterns that can match a newline or
+// the beginning of the text cannot be searched incrementally, and are searched as a whole.
+func IncrementalLineStartSplitFunc(re *regexp.Regexp, omitPattern bool, flushAtEOF bool) bufio.SplitFunc {
+	if !searchableByLine(re) {
+		return LineStartSplitFunc(re, omitPattern, flushAtEOF)
+	}
+
+	// The search resumes from the start of the last line searched, provided the data before it
+	// is unchanged. The data is compared by its hash, since a wrapping split func may have
+	// returned a token of its own, such as a force flushed one, since the previous call.
+	seed := maphash.MakeSeed()
+	var resume int
+	var matched bool
+	var sum uint64
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if resume > len(data) || maphash.Bytes(seed, data[:resume]) != sum {
+			resume, matched = 0, false
+		}
+		advance, token, resume, matched = lineStartSplit(re, data, atEOF, resume, matched, omitPattern, flushAtEOF)
+		sum = maphash.Bytes(seed, data[:resume])
+		return advance, token, nil
+	}
+}
+
+// lineStartSplit splits data into tokens that start with a match to re. No match starts before
+// resume, except for the first match if matched is set. When more data is needed, it returns
+// the position a search of the grown data can resume from, which is the start of the last line
+// searched or 0 if the search must start over, and whether the first match was found.
+func lineStartSplit(re *regexp.Regexp, data []byte, atEOF bool, resume int, matched bool, omitPattern bool, flushAtEOF bool) (advance int, token []byte, next int, nextMatched bool) {
+	firstLocOffset := 0
+	if !matched {
+		firstLocOffset = resume
+	}
+	firstLoc := re.FindIndex(data[firstLocOffset:])
+	if firstLoc == nil {
+		// Flush if no more data is expected
+		if len(data) != 0 && atEOF && flushAtEOF {
+			return len(data), data, 0, false
+		}
+		return 0, nil, lastLineStart(data, firstLocOffset), false // read more data and try again.
+	}
+	firstMatchStart, firstMatchEnd := firstLoc[0]+firstLocOffset, firstLoc[1]+firstLocOffset
+
+	if firstMatchStart != 0 {
+		// the beginning of the file does not match the start pattern, so return a token up to the first match so we don't lose data
+		return firstMatchStart, data[0:firstMatchStart], 0, false
+	}
+
+	if firstMatchEnd == len(data) {
+		// the first match goes to the end of the bufer, so don't look for a second match
+		return 0, nil, 0, false
+	}
+
+	// Flush if no more data is expected
+	if atEOF && flushAtEOF {
+		if omitPattern {
+			return len(data), data[firstMatchEnd:], 0, false
 		}
-		firstMatchStart, firstMatchEnd := firstLoc[0], firstLoc[1]
 
-		if firstMatchStart != 0 {
-			// the beginning of the file does not match the start pattern, so return a token up to the first match so we don't lose data
-			advance = firstMatchStart
-			token = data[0:firstMatchStart]
+		return len(data), data, 0, false
+	}
+
+	secondLocOfset := firstMatchEnd + 1
+	if matched && resume > secondLocOfset {
+		secondLocOfset = resume
+	}
+	secondLoc := re.FindIndex(data[secondLocOfset:])
+	if secondLoc == nil {
+		return 0, nil, lastLineStart(data, secondLocOfset), true // read more data and try again
+	}
+	secondMatchStart := secondLoc[0] + secondLocOfset
+	if omitPattern {
+		return secondMatchStart, data[firstMatchEnd:secondMatchStart], 0, false
+	}
+
+	// start scanning at the beginning of the second match
+	// the token begins at the first match, and ends at the beginning of the second match
+	return secondMatchStart, data[firstMatchStart:secondMatchStart], 0, false
+}
+
+// lastLineStart returns the position following the last newline in data[from:], or from if there is none.
+func lastLineStart(data []byte, from int) int {
+	return from + bytes.LastIndexByte(data[from:], '\n') + 1
+}
 
-			// return if non-matching pattern is not only whitespaces
-			if token != nil {
-				return
+// searchableByLine reports whether every match to re is found by searching the lines it is
+// part of, that is whether re cannot match a newline nor the beginning of the text.
+func searchableByLine(re *regexp.Regexp) bool {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return false
+	}
+	var searchable func(re *syntax.Regexp) bool
+	searchable = func(re *syntax.Regexp) bool {
+		switch re.Op {
+		case syntax.OpAnyChar, syntax.OpBeginText:
+			return false
+		case syntax.OpLiteral:
+			for _, r := range re.Rune {
+				if r == '\n' {
+					return false
+				}
+			}
+		case syntax.OpCharClass:
+			for i := 0; i+1 < len(re.Rune); i += 2 {
+				if re.Rune[i] <= '\n' && '\n' <= re.Rune[i+1] {
+					return false
+				}
+			}
+		}
+		for _, sub := range re.Sub {
+			if !searchable(sub) {
+				return false
 			}
 		}
+		return true
+	}
+	return searchable(parsed)
+}
 
-		if firstMatchEnd == len(data) {
-			// the first match goes to the end of the bufer, so don't look for a second match
+// LineStartNegateSplitFunc creates a bufio.SplitFunc that splits an incoming stream into
+// tokens that start with a line that does not match the regex pattern provided.
+func LineStartNegateSplitFunc(enc encoding.Encoding, re *regexp.Regexp, flushAtEOF bool) (bufio.SplitFunc, error) {
+	newline, err := encodedNewline(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
 			return 0, nil, nil
 		}
 
-		// Flush if no more data is expected
-		if atEOF && flushAtEOF {
-			if omitPattern {
-				return len(data), data[firstMatchEnd:], nil
+		// the first line starts the token, whether it matches or not
+		i := bytes.Index(data, newline)
+		for i >= 0 {
+			start := i + len(newline)
+			j := bytes.Index(data[start:], newline)
+			if j < 0 {
+				break
+			}
+			if !re.Match(data[start : start+j]) {
+				return start, data[:start], nil
 			}
+			i = start + j
+		}
 
+		// Flush if no more data is expected
+		if atEOF && flushAtEOF {
 			return len(data), data, nil
 		}
+		return 0, nil, nil // read more data and try again
+	}, nil
+}
 
-		secondLocOfset := firstMatchEnd + 1
-		secondLoc := re.FindIndex(data[secondLocOfset:])
-		if secondLoc == nil {
-			return 0, nil, nil // read more data and try again
+// LineEndNegateSplitFunc creates a bufio.SplitFunc that splits an incoming stream into
+// tokens that end with a line that does not match the regex pattern provided.
+func LineEndNegateSplitFunc(enc encoding.Encoding, re *regexp.Regexp, flushAtEOF bool) (bufio.SplitFunc, error) {
+	newline, err := encodedNewline(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
 		}
-		secondMatchStart := secondLoc[0] + secondLocOfset
-		if omitPattern {
-			return secondMatchStart, data[firstMatchEnd:secondMatchStart], nil
+
+		var start int
+		for {
+			i := bytes.Index(data[start:], newline)
+			if i < 0 {
+				break
+			}
+			end := start + i + len(newline)
+			if !re.Match(data[start : start+i]) {
+				return end, data[:end], nil
+			}
+			start = end
 		}
 
-		// start scanning at the beginning of the second match
-		// the token begins at the first match, and ends at the beginning of the second match
-		return secondMatchStart, data[firstMatchStart:secondMatchStart], nil
-	}
+		// Flush if no more data is expected
+		if atEOF && flushAtEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil // read more data and try again
+	}, nil
 }
 
 // LineEndSplitFunc creates a bufio.SplitFunc that splits an incoming stream into
 // tokens that end with a match to the regex pattern provided
 func LineEndSplitFunc(re *regexp.Regexp, omitPattern bool, flushAtEOF bool) bufio.SplitFunc {
+	return lineEndSplitFunc(re.FindIndex, omitPattern, flushAtEOF)
+}
+
+// LineEndPatternsSplitFunc creates a bufio.SplitFunc that splits an incoming stream into
+// tokens that end with the earliest match to any of the regex patterns provided.
+// When several patterns match at the same position, the first one in res wins.
+func LineEndPatternsSplitFunc(res []*regexp.Regexp, omitPattern bool, flushAtEOF bool) bufio.SplitFunc {
+	return lineEndSplitFunc(earliestMatch(res), omitPattern, flushAtEOF)
+}
+
+// earliestMatch returns a func finding the leftmost match to any of res,
+// in the format of regexp.Regexp.FindIndex.
+func earliestMatch(res []*regexp.Regexp) func(data []byte) []int {
+	return func(data []byte) []int {
+		var earliest []int
+		for _, re := range res {
+			loc := re.FindIndex(data)
+			if loc != nil && (earliest == nil || loc[0] < earliest[0]) {
+				earliest = loc
+			}
+		}
+		return earliest
+	}
+}
+
+func lineEndSplitFunc(findEnd func(data []byte) []int, omitPattern bool, flushAtEOF bool) bufio.SplitFunc {
 	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
-		loc := re.FindIndex(data)
+		loc := findEnd(data)
 		if loc == nil {
 			// Flush if no more data is expected
 			if len(data) != 0 && atEOF && flushAtEOF {
@@ -136,6 +714,363 @@ func LineEndSplitFunc(re *regexp.Regexp, omitPattern bool, flushAtEOF bool) bufi
 	}
 }
 
+// LineStartEndSplitFunc creates a bufio.SplitFunc that splits an incoming stream into
+// tokens that start with a match to startRe and end with the next match to endRe.
+// Matches to startRe between the start and the end of a token are part of the token.
+func LineStartEndSplitFunc(startRe, endRe *regexp.Regexp, omitPattern bool, flushAtEOF bool) bufio.SplitFunc {
+	return lineStartEndSplitFunc(startRe, endRe.FindIndex, omitPattern, false, flushAtEOF)
+}
+
+func lineStartEndSplitFunc(startRe *regexp.Regexp, findEnd func(data []byte) []int, omitPattern bool, dropUnmatched bool, flushAtEOF bool) bufio.SplitFunc {
+	var splitFunc bufio.SplitFunc
+	splitFunc = func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		startLoc := startRe.FindIndex(data)
+		if startLoc == nil {
+			// Flush if no more data is expected
+			if len(data) != 0 && atEOF && flushAtEOF {
+				if dropUnmatched {
+					return len(data), nil, nil
+				}
+				return len(data), data, nil
+			}
+			return 0, nil, nil // read more data and try again.
+		}
+		startMatchStart, startMatchEnd := startLoc[0], startLoc[1]
+
+		if startMatchStart != 0 {
+			if !dropUnmatched {
+				// the beginning of the data does not match the start pattern, so return a token up to the start match so we don't lose data
+				return startMatchStart, data[:startMatchStart], nil
+			}
+
+			// Discard the bytes up to the start match, and look up the token following them right away,
+			// since a bufio.Scanner stops at EOF as soon as a split returns no token.
+			advance, token, err = splitFunc(data[startMatchStart:], atEOF)
+			if token == nil && err == nil {
+				return startMatchStart, nil, nil
+			}
+			return startMatchStart + advance, token, err
+		}
+
+		endLoc := findEnd(data[startMatchEnd:])
+		if endLoc == nil {
+			// Flush if no more data is expected
+			if atEOF && flushAtEOF {
+				if omitPattern {
+					return len(data), data[startMatchEnd:], nil
+				}
+				return len(data), data, nil
+			}
+			return 0, nil, nil // read more data and try again
+		}
+		endMatchStart, endMatchEnd := endLoc[0]+startMatchEnd, endLoc[1]+startMatchEnd
+
+		// If the end match goes up to the end of the current buffer, do another
+		// read until we can capture the entire match
+		if endMatchEnd == len(data) && !atEOF {
+			return 0, nil, nil
+		}
+
+		if omitPattern {
+			return endMatchEnd, data[startMatchEnd:endMatchStart], nil
+		}
+		return endMatchEnd, data[:endMatchEnd], nil
+	}
+	return splitFunc
+}
+
+// MaxLinesFunc wraps a bufio.SplitFunc so that a token never spans more than maxLines lines.
+// When the token returned by splitFunc, or the data it is waiting on, goes past the end of
+// the maxLines-th line, the data up to there is returned as a token instead.
+func MaxLinesFunc(splitFunc bufio.SplitFunc, newline []byte, maxLines int) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		advance, token, err = splitFunc(data, atEOF)
+		if err != nil {
+			return advance, token, err
+		}
+		if token != nil && advance == 0 {
+			return advance, token, err
+		}
+		if token == nil && advance > 0 {
+			return advance, token, err
+		}
+
+		end := 0
+		for i := 0; i < maxLines; i++ {
+			j := bytes.Index(data[end:], newline)
+			if j < 0 {
+				return advance, token, err
+			}
+			end += j + len(newline)
+		}
+		if token != nil && advance <= end {
+			return advance, token, err
+		}
+		return end, data[:end], nil
+	}
+}
+
+// DelimiterSplitFunc creates a bufio.SplitFunc that splits an incoming stream into
+// tokens separated by the delimiter provided. If keepDelimiter is set, the delimiter
+// ending a token is part of it.
+func DelimiterSplitFunc(enc encoding.Encoding, delimiter string, keepDelimiter bool, flushAtEOF bool) (bufio.SplitFunc, error) {
+	encoded, err := encodedString(enc, delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if i := bytes.Index(data, encoded); i >= 0 {
+			end := i + len(encoded)
+			if keepDelimiter {
+				return end, data[:end], nil
+			}
+			return end, data[:i], nil
+		}
+
+		// Flush if no more data is expected
+		if atEOF && flushAtEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil // read more data and try again
+	}, nil
+}
+
+// LengthPrefixedSplitFunc creates a bufio.SplitFunc that splits an incoming stream of records,
+// each made of a length prefix of size bytes followed by a payload, into tokens made of the payloads.
+// If includesPrefix is set, the length counts the size of the prefix along with the payload.
+// A record longer than a positive maxLogSize is an error, since it can only be skipped by losing
+// track of the records following it.
+func LengthPrefixedSplitFunc(size int, byteOrder binary.ByteOrder, includesPrefix bool, maxLogSize int, flushAtEOF bool) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if len(data) < size {
+			// Flush if no more data is expected
+			if len(data) != 0 && atEOF && flushAtEOF {
+				return len(data), data, nil
+			}
+			return 0, nil, nil // read more data and try again
+		}
+
+		var length uint64
+		switch size {
+		case 1:
+			length = uint64(data[0])
+		case 2:
+			length = uint64(byteOrder.Uint16(data))
+		case 4:
+			length = uint64(byteOrder.Uint32(data))
+		default:
+			length = byteOrder.Uint64(data)
+		}
+		if !includesPrefix {
+			length += uint64(size)
+		} else if length < uint64(size) {
+			return 0, nil, fmt.Errorf("invalid record length %d, smaller than its length prefix", length)
+		}
+		if maxLogSize > 0 && length > uint64(maxLogSize) {
+			return 0, nil, fmt.Errorf("record length %d exceeds max_log_size %d", length, maxLogSize)
+		}
+
+		if uint64(len(data)) < length {
+			// Flush if no more data is expected
+			if atEOF && flushAtEOF {
+				return len(data), data[size:], nil
+			}
+			return 0, nil, nil // read more data and try again
+		}
+		return int(length), data[size:length], nil
+	}
+}
+
+// JSONSplitFunc creates a bufio.SplitFunc that splits an incoming stream into complete
+// JSON objects and arrays, by tracking the depth of braces and brackets outside of strings.
+// The whitespace between values is skipped, and lines that do not start a JSON object or
+// array are returned as tokens of their own, so that no data is lost.
+func JSONSplitFunc(flushAtEOF bool) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		start := bytes.IndexFunc(data, func(r rune) bool {
+			return r != ' ' && r != '\t' && r != '\r' && r != '\n'
+		})
+		if start < 0 {
+			// only whitespace, which is not part of any token
+			return len(data), nil, nil
+		}
+
+		if data[start] != '{' && data[start] != '[' {
+			// not JSON, so return a token up to the end of the line so we don't lose data
+			if i := bytes.IndexByte(data[start:], '\n'); i >= 0 {
+				return start + i + 1, data[start : start+i], nil
+			}
+			if atEOF && flushAtEOF {
+				return len(data), data[start:], nil
+			}
+			return 0, nil, nil // read more data and try again
+		}
+
+		var depth int
+		var inString, escaped bool
+		for i := start; i < len(data); i++ {
+			c := data[i]
+			switch {
+			case escaped:
+				escaped = false
+			case inString:
+				switch c {
+				case '\\':
+					escaped = true
+				case '"':
+					inString = false
+				}
+			case c == '"':
+				inString = true
+			case c == '{' || c == '[':
+				depth++
+			case c == '}' || c == ']':
+				depth--
+				if depth == 0 {
+					return i + 1, data[start : i+1], nil
+				}
+			}
+		}
+
+		// Flush if no more data is expected
+		if atEOF && flushAtEOF {
+			return len(data), data[start:], nil
+		}
+		return 0, nil, nil // read more data and try again
+	}
+}
+
+// LineContinuationSplitFunc creates a bufio.SplitFunc that splits an incoming stream into tokens
+// made of consecutive lines, where each line matching the regex pattern provided is continued by
+// the next one. The newline ending a token is not part of it.
+func LineContinuationSplitFunc(enc encoding.Encoding, re *regexp.Regexp, flushAtEOF bool) (bufio.SplitFunc, error) {
+	newline, err := encodedNewline(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	carriageReturn, err := encodedCarriageReturn(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		var start int
+		for {
+			i := bytes.Index(data[start:], newline)
+			if i < 0 {
+				break
+			}
+			end := start + i
+			if !re.Match(bytes.TrimSuffix(data[start:end], carriageReturn)) {
+				return end + len(newline), bytes.TrimSuffix(data[:end], carriageReturn), nil
+			}
+			start = end + len(newline)
+		}
+
+		// Flush if no more data is expected
+		if atEOF && flushAtEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil // read more data and try again
+	}, nil
+}
+
+// IndentSplitFunc creates a bufio.SplitFunc that splits an incoming stream into tokens made
+// of a line and the continuation lines following it, which are the lines starting with prefix,
+// or with a space or a tab if prefix is empty. The newline ending a token is not part of it.
+func IndentSplitFunc(enc encoding.Encoding, prefix string, flushAtEOF bool) (bufio.SplitFunc, error) {
+	newline, err := encodedNewline(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	carriageReturn, err := encodedCarriageReturn(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefixes [][]byte
+	for _, p := range []string{" ", "\t"} {
+		if prefix != "" {
+			p = prefix
+		}
+		encoded, err := encodedString(enc, p)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, encoded)
+		if prefix != "" {
+			break
+		}
+	}
+
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		end := bytes.Index(data, newline)
+		for end >= 0 {
+			next := end + len(newline)
+			continued, ok := hasPrefix(data[next:], prefixes)
+			if !ok {
+				if atEOF {
+					if next < len(data) {
+						// the last line is too short to tell, so keep it
+						end = -1
+					}
+					break
+				}
+				return 0, nil, nil // read more data and try again
+			}
+			if !continued {
+				return next, bytes.TrimSuffix(data[:end], carriageReturn), nil
+			}
+
+			i := bytes.Index(data[next:], newline)
+			if i < 0 {
+				end = -1
+				break
+			}
+			end = next + i
+		}
+
+		// Flush if no more data is expected
+		if atEOF && flushAtEOF {
+			if end >= 0 {
+				return len(data), bytes.TrimSuffix(data[:end], carriageReturn), nil
+			}
+			return len(data), data, nil
+		}
+		return 0, nil, nil // read more data and try again
+	}, nil
+}
+
+// hasPrefix reports whether data starts with any of prefixes.
+// If data is too short to tell, ok is false.
+func hasPrefix(data []byte, prefixes [][]byte) (found bool, ok bool) {
+	ok = true
+	for _, prefix := range prefixes {
+		if bytes.HasPrefix(data, prefix) {
+			return true, true
+		}
+		if len(data) < len(prefix) && bytes.HasPrefix(prefix, data) {
+			ok = false
+		}
+	}
+	return false, ok
+}
+
 // NewlineSplitFunc splits log lines by newline, just as bufio.ScanLines, but
 // never returning an token using EOF as a terminator
 func NewlineSplitFunc(enc encoding.Encoding, flushAtEOF bool) (bufio.SplitFunc, error) {
@@ -149,19 +1084,47 @@ func NewlineSplitFunc(enc encoding.Encoding, flushAtEOF bool) (bufio.SplitFunc,
 		return nil, err
 	}
 
+	return sequenceSplitFunc(newline, carriageReturn, len(newline), flushAtEOF), nil
+}
+
+// NewlineSequenceSplitFunc splits log lines ending with the newline sequence provided,
+// which is not part of the tokens.
+func NewlineSequenceSplitFunc(enc encoding.Encoding, newline string, flushAtEOF bool) (bufio.SplitFunc, error) {
+	encoded, err := encodedString(enc, newline)
+	if err != nil {
+		return nil, err
+	}
+	if len(encoded) == 0 {
+		return nil, fmt.Errorf("newline sequence must not be empty")
+	}
+
+	// the newline sequence is searched at the boundaries of the code units of the encoding,
+	// which are as long as an encoded line feed
+	lineFeed, err := encodedNewline(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return sequenceSplitFunc(encoded, nil, len(lineFeed), flushAtEOF), nil
+}
+
+// sequenceSplitFunc splits tokens ending with sep, trimming the suffix provided from them.
+// Since a code unit of a multi-byte encoding such as UTF-16 may hold the bytes of sep, sep is
+// only searched at the multiples of unitSize, so that a token never ends in the middle of a code unit.
+func sequenceSplitFunc(sep []byte, suffix []byte, unitSize int, flushAtEOF bool) bufio.SplitFunc {
 	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
 		if atEOF && len(data) == 0 {
 			return 0, nil, nil
 		}
 
-		i := bytes.Index(data, newline)
+		i := indexAligned(data, sep, unitSize)
 		if i == 0 {
-			return len(newline), []byte{}, nil
+			return len(sep), []byte{}, nil
 		}
 		if i >= 0 {
 			// We have a full newline-terminated line.
-			token = bytes.TrimSuffix(data[:i], carriageReturn)
-			return i + len(newline), token, nil
+			token = bytes.TrimSuffix(data[:i], suffix)
+			return i + len(sep), token, nil
 		}
 
 		// Flush if no more data is expected
@@ -171,13 +1134,30 @@ func NewlineSplitFunc(enc encoding.Encoding, flushAtEOF bool) (bufio.SplitFunc,
 
 		// Request more data.
 		return 0, nil, nil
-	}, nil
+	}
+}
+
+// indexAligned returns the index of the first instance of sep in data at a multiple of unitSize,
+// or -1 if there is none.
+func indexAligned(data []byte, sep []byte, unitSize int) int {
+	for from := 0; from < len(data); {
+		i := bytes.Index(data[from:], sep)
+		if i < 0 {
+			return -1
+		}
+		if i += from; unitSize <= 1 || i%unitSize == 0 {
+			return i
+		}
+		from = i + 1
+	}
+	return -1
 }
 
 // NoSplitFunc doesn't split any of the bytes, it reads in all of the bytes and returns it all at once. This is for when the encoding is nop
+// A maxLogSize of zero or less disables the size limit, so that an empty token is never returned.
 func NoSplitFunc(maxLogSize int) bufio.SplitFunc {
 	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
-		if len(data) >= maxLogSize {
+		if maxLogSize > 0 && len(data) >= maxLogSize {
 			return maxLogSize, data[:maxLogSize], nil
 		}
 
@@ -192,12 +1172,101 @@ func NoSplitFunc(maxLogSize int) bufio.SplitFunc {
 	}
 }
 
+// CollapseRepeatsFunc wraps a bufio.SplitFunc so that consecutive identical tokens
+// are returned as a single token. onToken is called with the number of tokens that
+// were collapsed right before each token is returned.
+//
+// Only tokens that have already been read are collapsed. A token is never held back
+// while waiting for a possible repeat, so collapsing adds no latency, but a run of
+// repeats that spans more than one read is returned as more than one token.
+func CollapseRepeatsFunc(splitFunc bufio.SplitFunc, onToken func(count int)) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		advance, token, err = splitFunc(data, atEOF)
+		if token == nil {
+			return advance, token, err
+		}
+
+		count := 1
+		for err == nil && advance < len(data) {
+			nextAdvance, nextToken, nextErr := splitFunc(data[advance:], atEOF)
+			if nextErr != nil || nextToken == nil || !bytes.Equal(token, nextToken) {
+				break
+			}
+			advance += nextAdvance
+			count++
+		}
+		onToken(count)
+		return advance, token, err
+	}
+}
+
+// SkipFirstPartialFunc wraps a bufio.SplitFunc so that the bytes preceding the first match
+// of re are discarded rather than returned as a token. It is meant for streams read from an
+// arbitrary offset, where those bytes are the tail of a record that was not read.
+// No token is returned until a match is found, at which point onSkipped is called.
+//
+// If no match is found in maxLength bytes, the bytes up to the last line break are discarded,
+// so that a partial record longer than maxLength is not returned as a token of its own.
+// A maxLength of 0 or less means no limit.
+func SkipFirstPartialFunc(splitFunc bufio.SplitFunc, re *regexp.Regexp, maxLength int, onSkipped func()) bufio.SplitFunc {
+	skipped := false
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if skipped {
+			return splitFunc(data, atEOF)
+		}
+		loc := re.FindIndex(data)
+		if loc == nil {
+			if maxLength > 0 && len(data) >= maxLength {
+				// keep the last line, which may hold the start of a match
+				if i := bytes.LastIndexByte(data, '\n'); i > 0 {
+					return i + 1, nil, nil
+				}
+				return len(data), nil, nil
+			}
+			return 0, nil, nil // read more data and try again.
+		}
+		skipped = true
+		onSkipped()
+		advance, token, err = splitFunc(data[loc[0]:], atEOF)
+		return loc[0] + advance, token, err
+	}
+}
+
+// ValidUTF8Func wraps a bufio.SplitFunc so that only tokens that are valid UTF-8 are
+// returned. Other tokens are consumed and passed to quarantine, if not nil.
+//
+// After diverting a token, the next token already read is looked up right away, since
+// a bufio.Scanner stops at EOF as soon as a split returns no token.
+func ValidUTF8Func(splitFunc bufio.SplitFunc, quarantine QuarantineFunc) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		advance, token, err = splitFunc(data, atEOF)
+		for err == nil && token != nil && !utf8.Valid(token) {
+			if quarantine != nil {
+				quarantine(token)
+			}
+			if advance >= len(data) {
+				return advance, nil, nil
+			}
+			nextAdvance, nextToken, nextErr := splitFunc(data[advance:], atEOF)
+			if nextToken == nil && nextErr == nil {
+				return advance, nil, nil
+			}
+			advance, token, err = advance+nextAdvance, nextToken, nextErr
+		}
+		return advance, token, err
+	}
+}
+
 func encodedNewline(enc encoding.Encoding) ([]byte, error) {
 	out := make([]byte, 10)
 	nDst, _, err := enc.NewEncoder().Transform(out, []byte{'\n'}, true)
 	return out[:nDst], err
 }
 
+func encodedString(enc encoding.Encoding, s string) ([]byte, error) {
+	return enc.NewEncoder().Bytes([]byte(s))
+}
+
 func encodedCarriageReturn(enc encoding.Encoding) ([]byte, error) {
 	out := make([]byte, 10)
 	nDst, _, err := enc.NewEncoder().Transform(out, []byte{'\r'}, true)
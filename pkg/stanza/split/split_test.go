@@ -4,7 +4,11 @@
 package split
 
 import (
+	"bufio"
+	"encoding/binary"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,15 +18,270 @@ import (
 	"golang.org/x/text/encoding/unicode"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split/splittest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
+func TestConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         Config
+		expectedErr string
+	}{
+		{
+			name: "Default",
+			cfg:  Config{},
+		},
+		{
+			name: "LineStart",
+			cfg:  Config{LineStartPattern: "^start", OmitPattern: true},
+		},
+		{
+			name: "LineEnd",
+			cfg:  Config{LineEndPattern: "end$", OmitPattern: true},
+		},
+		{
+			name: "BothStartAndEnd",
+			cfg:  Config{LineStartPattern: "foo", LineEndPattern: "bar"},
+		},
+		{
+			name:        "InvalidStartRegex",
+			cfg:         Config{LineStartPattern: "["},
+			expectedErr: "compile line start regex: error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name:        "InvalidEndRegex",
+			cfg:         Config{LineEndPattern: "["},
+			expectedErr: "compile line end regex: error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name: "LineEndPatterns",
+			cfg:  Config{LineEndPattern: "end$", LineEndPatterns: []string{"stop$", "halt$"}},
+		},
+		{
+			name: "LineStartPatterns",
+			cfg:  Config{LineStartPattern: "^start", LineStartPatterns: []string{"^begin", "^head"}},
+		},
+		{
+			name:        "InvalidStartPatternsRegex",
+			cfg:         Config{LineStartPatterns: []string{"^begin", "["}},
+			expectedErr: "compile line start regex: error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name: "SkipFirstPartialOnResumeStartPatterns",
+			cfg:  Config{LineStartPatterns: []string{"^begin"}, SkipFirstPartialOnResume: true},
+		},
+		{
+			name:        "InvalidEndPatternsRegex",
+			cfg:         Config{LineEndPatterns: []string{"stop$", "["}},
+			expectedErr: "compile line end regex: error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name: "SkipFirstPartialOnResume",
+			cfg:  Config{LineStartPattern: "^start", SkipFirstPartialOnResume: true},
+		},
+		{
+			name:        "SkipFirstPartialOnResumeWithoutStart",
+			cfg:         Config{LineEndPattern: "end$", SkipFirstPartialOnResume: true},
+			expectedErr: "skip_first_partial_on_resume requires line_start_pattern",
+		},
+		{
+			name: "DropUnmatched",
+			cfg:  Config{LineStartPattern: "^BEGIN", LineEndPattern: "^END", DropUnmatched: true},
+		},
+		{
+			name: "DropUnmatchedEndPatterns",
+			cfg:  Config{LineStartPattern: "^BEGIN", LineEndPatterns: []string{"^END"}, DropUnmatched: true},
+		},
+		{
+			name:        "DropUnmatchedWithoutEnd",
+			cfg:         Config{LineStartPattern: "^BEGIN", DropUnmatched: true},
+			expectedErr: "drop_unmatched requires both line_start_pattern and line_end_pattern",
+		},
+		{
+			name:        "DropUnmatchedWithoutStart",
+			cfg:         Config{LineEndPattern: "^END", DropUnmatched: true},
+			expectedErr: "drop_unmatched requires both line_start_pattern and line_end_pattern",
+		},
+		{
+			name: "MaxLines",
+			cfg:  Config{LineStartPattern: "^start", MaxLines: 10},
+		},
+		{
+			name:        "NegativeMaxLines",
+			cfg:         Config{LineStartPattern: "^start", MaxLines: -1},
+			expectedErr: "invalid max_lines -1, must not be negative",
+		},
+		{
+			name:        "MaxLinesWithoutPattern",
+			cfg:         Config{MaxLines: 10},
+			expectedErr: "max_lines requires a multiline pattern or mode",
+		},
+		{
+			name: "ModeJSON",
+			cfg:  Config{Mode: ModeJSON, MaxLines: 100},
+		},
+		{
+			name:        "ModeJSONWithPattern",
+			cfg:         Config{Mode: ModeJSON, LineStartPattern: "^{"},
+			expectedErr: "mode json cannot be used with line_start_pattern or line_end_pattern",
+		},
+		{
+			name: "ModeIndent",
+			cfg:  Config{Mode: ModeIndent, IndentPrefix: "\t"},
+		},
+		{
+			name:        "IndentPrefixWithoutMode",
+			cfg:         Config{IndentPrefix: "\t"},
+			expectedErr: "indent_prefix requires mode indent",
+		},
+		{
+			name: "NegateStart",
+			cfg:  Config{LineStartPattern: `^\s`, Negate: true},
+		},
+		{
+			name: "NegateEnd",
+			cfg:  Config{LineEndPattern: `\\$`, Negate: true},
+		},
+		{
+			name:        "NegateWithoutPattern",
+			cfg:         Config{Negate: true},
+			expectedErr: "negate requires either line_start_pattern or line_end_pattern",
+		},
+		{
+			name:        "NegateBothPatterns",
+			cfg:         Config{LineStartPattern: "^start", LineEndPattern: "end$", Negate: true},
+			expectedErr: "negate requires either line_start_pattern or line_end_pattern",
+		},
+		{
+			name:        "NegateOmitPattern",
+			cfg:         Config{LineStartPattern: "^start", OmitPattern: true, Negate: true},
+			expectedErr: "negate cannot be used with omit_pattern or skip_first_partial_on_resume",
+		},
+		{
+			name: "LineContinuation",
+			cfg:  Config{LineContinuationPattern: `\\$`, MaxLines: 10},
+		},
+		{
+			name:        "InvalidLineContinuationRegex",
+			cfg:         Config{LineContinuationPattern: "["},
+			expectedErr: "compile line continuation regex: error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name:        "LineContinuationWithStart",
+			cfg:         Config{LineContinuationPattern: `\\$`, LineStartPattern: "^start"},
+			expectedErr: "line_continuation_pattern cannot be used with line_start_pattern, line_end_pattern or mode",
+		},
+		{
+			name:        "LineContinuationWithMode",
+			cfg:         Config{LineContinuationPattern: `\\$`, Mode: ModeIndent},
+			expectedErr: "line_continuation_pattern cannot be used with line_start_pattern, line_end_pattern or mode",
+		},
+		{
+			name: "ModeLengthPrefixed",
+			cfg:  Config{Mode: ModeLengthPrefixed, LengthPrefixSize: 2, LengthPrefixByteOrder: ByteOrderLittleEndian, LengthIncludesPrefix: true},
+		},
+		{
+			name:        "LengthPrefixSizeWithoutMode",
+			cfg:         Config{LengthPrefixSize: 2},
+			expectedErr: "length_prefix_size, length_prefix_byte_order and length_includes_prefix require mode length_prefixed",
+		},
+		{
+			name:        "InvalidLengthPrefixSize",
+			cfg:         Config{Mode: ModeLengthPrefixed, LengthPrefixSize: 3},
+			expectedErr: "invalid length_prefix_size 3, must be 1, 2, 4 or 8",
+		},
+		{
+			name:        "InvalidLengthPrefixByteOrder",
+			cfg:         Config{Mode: ModeLengthPrefixed, LengthPrefixByteOrder: "middle_endian"},
+			expectedErr: "invalid length_prefix_byte_order 'middle_endian', must be big_endian or little_endian",
+		},
+		{
+			name:        "LengthPrefixedMaxLines",
+			cfg:         Config{Mode: ModeLengthPrefixed, MaxLines: 10},
+			expectedErr: "max_lines cannot be used with mode length_prefixed",
+		},
+		{
+			name: "ModeDelimiter",
+			cfg:  Config{Mode: ModeDelimiter, Delimiter: "|~|", KeepDelimiter: true},
+		},
+		{
+			name:        "ModeDelimiterWithoutDelimiter",
+			cfg:         Config{Mode: ModeDelimiter},
+			expectedErr: "mode delimiter requires delimiter",
+		},
+		{
+			name:        "DelimiterWithoutMode",
+			cfg:         Config{Delimiter: "|~|"},
+			expectedErr: "delimiter and keep_delimiter require mode delimiter",
+		},
+		{
+			name: "NewlineCRLF",
+			cfg:  Config{Newline: NewlineCRLF},
+		},
+		{
+			name: "NewlineCustom",
+			cfg:  Config{Newline: NewlineCustom, NewlineSequence: "\x1e"},
+		},
+		{
+			name:        "InvalidNewline",
+			cfg:         Config{Newline: "crcr"},
+			expectedErr: "invalid newline 'crcr', must be lf, crlf, cr or custom",
+		},
+		{
+			name:        "NewlineCustomWithoutSequence",
+			cfg:         Config{Newline: NewlineCustom},
+			expectedErr: "newline custom requires newline_sequence",
+		},
+		{
+			name:        "NewlineSequenceWithoutCustom",
+			cfg:         Config{Newline: NewlineLF, NewlineSequence: "\x1e"},
+			expectedErr: "newline_sequence requires newline custom",
+		},
+		{
+			name:        "NewlineWithPattern",
+			cfg:         Config{Newline: NewlineCRLF, LineStartPattern: "^start"},
+			expectedErr: "newline cannot be used with a multiline pattern or mode",
+		},
+		{
+			name:        "InvalidMode",
+			cfg:         Config{Mode: "xml"},
+			expectedErr: "invalid mode 'xml'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestConfigValidateEncoding(t *testing.T) {
+	utf16 := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	assert.NoError(t, Config{}.ValidateEncoding(utf16))
+	assert.NoError(t, Config{ValidateUTF8: true}.ValidateEncoding(unicode.UTF8))
+	assert.EqualError(t, Config{ValidateUTF8: true}.ValidateEncoding(utf16), "validate_utf8 can only be set when using utf-8 encoding")
+	assert.NoError(t, Config{Mode: ModeJSON}.ValidateEncoding(unicode.UTF8))
+	assert.EqualError(t, Config{Mode: ModeJSON}.ValidateEncoding(utf16), "mode json can only be set when using utf-8 encoding")
+}
+
 func TestConfigFunc(t *testing.T) {
 	maxLogSize := 100
 
 	t.Run("BothStartAndEnd", func(t *testing.T) {
 		cfg := Config{LineStartPattern: "foo", LineEndPattern: "bar"}
-		_, err := cfg.Func(unicode.UTF8, false, maxLogSize)
-		assert.EqualError(t, err, "only one of line_start_pattern or line_end_pattern can be set")
+		f, err := cfg.Func(unicode.UTF8, false, maxLogSize)
+		assert.NoError(t, err)
+
+		advance, token, err := f([]byte("foo1bar foo2bar"), false)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, advance)
+		assert.Equal(t, []byte("foo1bar"), token)
 	})
 
 	t.Run("NopEncoding", func(t *testing.T) {
@@ -45,6 +304,26 @@ func TestConfigFunc(t *testing.T) {
 		startCfg := Config{LineStartPattern: "\n"}
 		_, err = startCfg.Func(encoding.Nop, false, 0)
 		require.Equal(t, err, fmt.Errorf("line_start_pattern should not be set when using nop encoding"))
+
+		endsCfg := Config{LineEndPatterns: []string{"\n"}}
+		_, err = endsCfg.Func(encoding.Nop, false, 0)
+		require.Equal(t, err, fmt.Errorf("line_end_patterns should not be set when using nop encoding"))
+
+		modeCfg := Config{Mode: ModeJSON}
+		_, err = modeCfg.Func(encoding.Nop, false, 0)
+		require.Equal(t, err, fmt.Errorf("mode json can only be set when using utf-8 encoding"))
+
+		continuationCfg := Config{LineContinuationPattern: `\\$`}
+		_, err = continuationCfg.Func(encoding.Nop, false, 0)
+		require.Equal(t, err, fmt.Errorf("line_continuation_pattern should not be set when using nop encoding"))
+
+		indentCfg := Config{Mode: ModeIndent}
+		_, err = indentCfg.Func(encoding.Nop, false, 0)
+		require.Equal(t, err, fmt.Errorf("mode should not be set when using nop encoding"))
+
+		newlineCfg := Config{Newline: NewlineCRLF}
+		_, err = newlineCfg.Func(encoding.Nop, false, 0)
+		require.Equal(t, err, fmt.Errorf("newline should not be set when using nop encoding"))
 	})
 
 	t.Run("Newline", func(t *testing.T) {
@@ -69,6 +348,77 @@ func TestConfigFunc(t *testing.T) {
 		_, err := cfg.Func(unicode.UTF8, false, maxLogSize)
 		assert.EqualError(t, err, "compile line end regex: error parsing regexp: missing closing ]: `[`")
 	})
+
+	t.Run("ValidateUTF8", func(t *testing.T) {
+		cfg := Config{ValidateUTF8: true}
+		var quarantined [][]byte
+		f, err := cfg.FuncWithQuarantine(unicode.UTF8, true, maxLogSize, func(token []byte) {
+			quarantined = append(quarantined, append([]byte(nil), token...))
+		})
+		require.NoError(t, err)
+
+		scanner := bufio.NewScanner(strings.NewReader("valid\ninv\xffalid\nh\u00e9llo\n\xc3\x28\nlast"))
+		scanner.Split(f)
+		var emitted []string
+		for scanner.Scan() {
+			emitted = append(emitted, scanner.Text())
+		}
+		require.NoError(t, scanner.Err())
+		assert.Equal(t, []string{"valid", "h\u00e9llo", "last"}, emitted)
+		assert.Equal(t, [][]byte{[]byte("inv\xffalid"), []byte("\xc3\x28")}, quarantined)
+	})
+
+	t.Run("ValidateUTF8Drop", func(t *testing.T) {
+		cfg := Config{ValidateUTF8: true}
+		f, err := cfg.Func(unicode.UTF8, false, maxLogSize)
+		require.NoError(t, err)
+
+		advance, token, err := f([]byte("inv\xffalid\nvalid\n"), false)
+		assert.NoError(t, err)
+		assert.Equal(t, 15, advance)
+		assert.Equal(t, []byte("valid"), token)
+
+		advance, token, err = f([]byte("inv\xffalid\nincomplete"), false)
+		assert.NoError(t, err)
+		assert.Equal(t, 9, advance)
+		assert.Nil(t, token)
+	})
+
+	t.Run("DropUnmatched", func(t *testing.T) {
+		cfg := Config{LineStartPattern: `^BEGIN`, LineEndPattern: `^END\n`, DropUnmatched: true}
+		f, err := cfg.Func(unicode.UTF8, true, maxLogSize)
+		require.NoError(t, err)
+
+		scanner := bufio.NewScanner(strings.NewReader("noise\nBEGIN\n1\nEND\nnoise\nBEGIN\n2\nEND\ntrailing"))
+		scanner.Split(f)
+		var emitted []string
+		for scanner.Scan() {
+			emitted = append(emitted, scanner.Text())
+		}
+		require.NoError(t, scanner.Err())
+		assert.Equal(t, []string{"BEGIN\n1\nEND\n", "BEGIN\n2\nEND\n"}, emitted)
+	})
+
+	t.Run("ModeJSON", func(t *testing.T) {
+		cfg := Config{Mode: ModeJSON}
+		f, err := cfg.Func(unicode.UTF8, true, maxLogSize)
+		require.NoError(t, err)
+
+		scanner := bufio.NewScanner(strings.NewReader("{\"a\": 1} {\"b\": 2}\n[\n  3\n]\n"))
+		scanner.Split(f)
+		var emitted []string
+		for scanner.Scan() {
+			emitted = append(emitted, scanner.Text())
+		}
+		require.NoError(t, scanner.Err())
+		assert.Equal(t, []string{"{\"a\": 1}", "{\"b\": 2}", "[\n  3\n]"}, emitted)
+	})
+
+	t.Run("ValidateUTF8OtherEncoding", func(t *testing.T) {
+		cfg := Config{ValidateUTF8: true}
+		_, err := cfg.Func(unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), false, maxLogSize)
+		assert.EqualError(t, err, "validate_utf8 can only be set when using utf-8 encoding")
+	})
 }
 
 func TestLineStartSplitFunc(t *testing.T) {
@@ -271,6 +621,255 @@ func TestLineStartSplitFunc(t *testing.T) {
 		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
 		require.NoError(t, err)
 		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+
+		newSplitFunc, err := cfg.FuncFactory(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name+"Incremental", splittest.New(newSplitFunc(), tc.input, tc.steps...))
+	}
+}
+
+func TestIncrementalLineStartSplitFunc(t *testing.T) {
+	re := regexp.MustCompile(`(?m)^LOGSTART \d+ `)
+	splitFunc := IncrementalLineStartSplitFunc(re, false, false)
+
+	data := []byte("LOGSTART 1 log1\nLOGPART log1\nLOGP")
+	advance, token, err := splitFunc(data, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, advance)
+	assert.Nil(t, token)
+
+	data = append(data, []byte("ART log1\nLOGSTART 2 log2\n")...)
+	advance, token, err = splitFunc(data, false)
+	require.NoError(t, err)
+	assert.Equal(t, len("LOGSTART 1 log1\nLOGPART log1\nLOGPART log1\n"), advance)
+	assert.Equal(t, []byte("LOGSTART 1 log1\nLOGPART log1\nLOGPART log1\n"), token)
+
+	// the data searched before is replaced, as when a wrapping split func returned a token of its own
+	advance, token, err = splitFunc([]byte("LOGSTART 2 log2\nLOGP"), false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, advance)
+	assert.Nil(t, token)
+	advance, token, err = splitFunc([]byte("LOGPART log2\nLOGSTART 3 log3\nLOGSTART 4 "), false)
+	require.NoError(t, err)
+	assert.Equal(t, len("LOGPART log2\n"), advance)
+	assert.Equal(t, []byte("LOGPART log2\n"), token)
+}
+
+func TestSearchableByLine(t *testing.T) {
+	testCases := []struct {
+		pattern    string
+		searchable bool
+	}{
+		{pattern: `(?m)^LOGSTART \d+ `, searchable: true},
+		{pattern: `(?m)^\[\w+\]$`, searchable: true},
+		{pattern: `(?m)^a.b`, searchable: true},
+		{pattern: `(?m)^a\nb`, searchable: false},
+		{pattern: `(?m)^a\sb`, searchable: false},
+		{pattern: `(?m)^a[^x]b`, searchable: false},
+		{pattern: `(?ms)^a.b`, searchable: false},
+		{pattern: `\Aa`, searchable: false},
+		{pattern: `^a`, searchable: false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.searchable, searchableByLine(regexp.MustCompile(tc.pattern)), tc.pattern)
+	}
+}
+
+func BenchmarkLineStartSplitFunc(b *testing.B) {
+	re := regexp.MustCompile(`(?m)^LOGSTART \d+ `)
+	line := []byte("LOGPART some multiline log content which is long enough to be realistic\n")
+	data := []byte("LOGSTART 1 ")
+	for len(data) < 1<<20 {
+		data = append(data, line...)
+	}
+	data = append(data, []byte("LOGSTART 2 ")...)
+
+	for _, bc := range []struct {
+		name         string
+		newSplitFunc func() bufio.SplitFunc
+		chunkSize    int
+	}{
+		{name: "1MB/4KBChunks", newSplitFunc: func() bufio.SplitFunc { return LineStartSplitFunc(re, false, false) }, chunkSize: 4 << 10},
+		{name: "1MB/4KBChunks/Incremental", newSplitFunc: func() bufio.SplitFunc { return IncrementalLineStartSplitFunc(re, false, false) }, chunkSize: 4 << 10},
+		{name: "1MB/64KBChunks", newSplitFunc: func() bufio.SplitFunc { return LineStartSplitFunc(re, false, false) }, chunkSize: 64 << 10},
+		{name: "1MB/64KBChunks/Incremental", newSplitFunc: func() bufio.SplitFunc { return IncrementalLineStartSplitFunc(re, false, false) }, chunkSize: 64 << 10},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				// the token grows by a chunk at a time, as when tailing a slowly written stream
+				splitFunc := bc.newSplitFunc()
+				for end := bc.chunkSize; ; end += bc.chunkSize {
+					if end > len(data) {
+						end = len(data)
+					}
+					advance, _, err := splitFunc(data[:end], false)
+					require.NoError(b, err)
+					if advance > 0 {
+						break
+					}
+					require.Less(b, end, len(data))
+				}
+			}
+		})
+	}
+}
+
+func TestLineStartEndSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name          string
+		startPattern  string
+		endPattern    string
+		omitPattern   bool
+		dropUnmatched bool
+		flushAtEOF    bool
+		maxLogSize    int
+		input         []byte
+		steps         []splittest.Step
+	}{
+		{
+			name:         "OneRecord",
+			startPattern: `^BEGIN \d+`,
+			endPattern:   `^END \d+\n`,
+			input:        []byte("BEGIN 1\nline\nEND 1\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("BEGIN 1\nline\nEND 1\n"),
+			},
+		},
+		{
+			name:         "OneRecordOmitPattern",
+			startPattern: `^BEGIN \d+\n`,
+			endPattern:   `^END \d+\n`,
+			omitPattern:  true,
+			input:        []byte("BEGIN 1\nline\nEND 1\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("BEGIN 1\nline\nEND 1\n"), "line\n"),
+			},
+		},
+		{
+			name:         "BackToBackRecords",
+			startPattern: `^BEGIN \d+`,
+			endPattern:   `^END \d+\n`,
+			input:        []byte("BEGIN 1\nline1\nEND 1\nBEGIN 2\nline2\nEND 2\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("BEGIN 1\nline1\nEND 1\n"),
+				splittest.ExpectToken("BEGIN 2\nline2\nEND 2\n"),
+			},
+		},
+		{
+			name:         "StartPatternInsideRecord",
+			startPattern: `^BEGIN \d+`,
+			endPattern:   `^END \d+\n`,
+			input:        []byte("BEGIN 1\nBEGIN nested\nEND 1\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("BEGIN 1\nBEGIN nested\nEND 1\n"),
+			},
+		},
+		{
+			name:         "LeadingBytesBeforeStart",
+			startPattern: `^BEGIN \d+`,
+			endPattern:   `^END \d+\n`,
+			input:        []byte("garbage\nBEGIN 1\nline\nEND 1\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("garbage\n"),
+				splittest.ExpectToken("BEGIN 1\nline\nEND 1\n"),
+			},
+		},
+		{
+			name:         "StartWithoutEnd",
+			startPattern: `^BEGIN \d+`,
+			endPattern:   `^END \d+\n`,
+			input:        []byte("BEGIN 1\nline\nmore lines\n"),
+		},
+		{
+			name:         "StartWithoutEndFlushAtEOF",
+			startPattern: `^BEGIN \d+`,
+			endPattern:   `^END \d+\n`,
+			flushAtEOF:   true,
+			input:        []byte("BEGIN 1\nline\nEND 1\nBEGIN 2\nunterminated"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("BEGIN 1\nline\nEND 1\n"),
+				splittest.ExpectToken("BEGIN 2\nunterminated"),
+			},
+		},
+		{
+			name:         "StartWithoutEndFlushAtEOFOmitPattern",
+			startPattern: `^BEGIN \d+\n`,
+			endPattern:   `^END \d+\n`,
+			omitPattern:  true,
+			flushAtEOF:   true,
+			input:        []byte("BEGIN 2\nunterminated"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("BEGIN 2\nunterminated"), "unterminated"),
+			},
+		},
+		{
+			name:         "HugeUnterminatedRecord",
+			startPattern: `^BEGIN \d+`,
+			endPattern:   `^END \d+\n`,
+			maxLogSize:   20,
+			input:        []byte("BEGIN 1\n" + string(splittest.GenerateBytes(30))),
+			steps: []splittest.Step{
+				splittest.ExpectToken("BEGIN 1\n" + string(splittest.GenerateBytes(12))),
+			},
+		},
+		{
+			name:          "DropUnmatchedLeadingBytes",
+			startPattern:  `^BEGIN \d+`,
+			endPattern:    `^END \d+\n`,
+			dropUnmatched: true,
+			input:         []byte("garbage\nBEGIN 1\nline\nEND 1\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceNil(len("garbage\n")),
+				splittest.ExpectToken("BEGIN 1\nline\nEND 1\n"),
+			},
+		},
+		{
+			name:          "DropUnmatchedBetweenRecords",
+			startPattern:  `^BEGIN \d+`,
+			endPattern:    `^END \d+\n`,
+			dropUnmatched: true,
+			input:         []byte("BEGIN 1\nline1\nEND 1\nnoise\nBEGIN 2\nline2\nEND 2\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("BEGIN 1\nline1\nEND 1\n"),
+				splittest.ExpectAdvanceNil(len("noise\n")),
+				splittest.ExpectToken("BEGIN 2\nline2\nEND 2\n"),
+			},
+		},
+		{
+			name:          "DropUnmatchedIncompleteRecord",
+			startPattern:  `^BEGIN \d+`,
+			endPattern:    `^END \d+\n`,
+			dropUnmatched: true,
+			input:         []byte("noise\nBEGIN 1\nline"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceNil(len("noise\n")),
+			},
+		},
+		{
+			name:          "DropUnmatchedTrailingBytesFlushAtEOF",
+			startPattern:  `^BEGIN \d+`,
+			endPattern:    `^END \d+\n`,
+			dropUnmatched: true,
+			flushAtEOF:    true,
+			input:         []byte("BEGIN 1\nline\nEND 1\ntrailing noise"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("BEGIN 1\nline\nEND 1\n"),
+				splittest.ExpectAdvanceNil(len("trailing noise")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{
+			LineStartPattern: tc.startPattern,
+			LineEndPattern:   tc.endPattern,
+			OmitPattern:      tc.omitPattern,
+			DropUnmatched:    tc.dropUnmatched,
+		}
+		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(trim.ToLength(splitFunc, tc.maxLogSize), tc.input, tc.steps...))
 	}
 }
 
@@ -442,51 +1041,635 @@ func TestLineEndSplitFunc_Detailed(t *testing.T) {
 	}
 }
 
-func TestNewlineSplitFunc(t *testing.T) {
+func TestLineStartPatternsSplitFunc(t *testing.T) {
 	testCases := []struct {
-		name       string
-		encoding   encoding.Encoding
-		flushAtEOF bool
-		input      []byte
-		steps      []splittest.Step
+		name          string
+		startPattern  string
+		startPatterns []string
+		endPattern    string
+		input         []byte
+		steps         []splittest.Step
 	}{
 		{
-			name:  "EmptyFile",
-			input: []byte(""),
-		},
-		{
-			name:  "OneLogSimple",
-			input: []byte("my log\n"),
-			steps: []splittest.Step{
-				splittest.ExpectAdvanceToken(len("my log")+1, "my log"),
-			},
-		},
-		{
-			name:  "OneLogCarriageReturn",
-			input: []byte("my log\r\n"),
+			name:          "OnlyPatterns",
+			startPatterns: []string{`^\d{4}-\d{2}-\d{2}`, `^\[\w+\]`},
+			input:         []byte("2024-01-01 one\nmore\n[INFO] two\n2024-01-02 three\n"),
 			steps: []splittest.Step{
-				splittest.ExpectAdvanceToken(len("my log")+2, "my log"),
+				splittest.ExpectToken("2024-01-01 one\nmore\n"),
+				splittest.ExpectToken("[INFO] two\n"),
 			},
 		},
 		{
-			name:  "TwoLogsSimple",
-			input: []byte("log1\nlog2\n"),
+			name:          "PatternAndPatterns",
+			startPattern:  `^START`,
+			startPatterns: []string{`^BEGIN`},
+			input:         []byte("START one\nBEGIN two\nmore\nSTART three\n"),
 			steps: []splittest.Step{
-				splittest.ExpectAdvanceToken(len("log1")+1, "log1"),
-				splittest.ExpectAdvanceToken(len("log2")+1, "log2"),
+				splittest.ExpectToken("START one\n"),
+				splittest.ExpectToken("BEGIN two\nmore\n"),
 			},
 		},
 		{
-			name:  "TwoLogsCarriageReturn",
-			input: []byte("log1\r\nlog2\r\n"),
+			name:          "WithEndPattern",
+			startPatterns: []string{`^START`, `^BEGIN`},
+			endPattern:    `^END\n`,
+			input:         []byte("START one\nEND\nBEGIN two\nEND\n"),
 			steps: []splittest.Step{
-				splittest.ExpectAdvanceToken(len("log1")+2, "log1"),
-				splittest.ExpectAdvanceToken(len("log2")+2, "log2"),
+				splittest.ExpectToken("START one\nEND\n"),
+				splittest.ExpectToken("BEGIN two\nEND\n"),
 			},
 		},
-		{
-			name:  "NoTailingNewline",
-			input: []byte("foo"),
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{
+			LineStartPattern:  tc.startPattern,
+			LineStartPatterns: tc.startPatterns,
+			LineEndPattern:    tc.endPattern,
+		}
+		splitFunc, err := cfg.Func(unicode.UTF8, false, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestLineEndPatternsSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name         string
+		startPattern string
+		endPattern   string
+		endPatterns  []string
+		omitPattern  bool
+		flushAtEOF   bool
+		input        []byte
+		steps        []splittest.Step
+	}{
+		{
+			name:        "EarliestWins",
+			endPatterns: []string{`STOP `, `LOGEND \d+ `},
+			input:       []byte("log1 LOGEND 123 log2 STOP log3 LOGEND 234 "),
+			steps: []splittest.Step{
+				splittest.ExpectToken("log1 LOGEND 123 "),
+				splittest.ExpectToken("log2 STOP "),
+				splittest.ExpectToken("log3 LOGEND 234 "),
+			},
+		},
+		{
+			name:        "EarliestWinsOmitPattern",
+			endPatterns: []string{`STOP `, `LOGEND \d+ `},
+			omitPattern: true,
+			input:       []byte("log1 LOGEND 123 log2 STOP "),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("log1 LOGEND 123 "), "log1 "),
+				splittest.ExpectAdvanceToken(len("log2 STOP "), "log2 "),
+			},
+		},
+		{
+			name:        "SamePositionFirstPatternWins",
+			endPatterns: []string{`END `, `END \d+ `},
+			input:       []byte("log1 END 123 log2 END 234 "),
+			steps: []splittest.Step{
+				splittest.ExpectToken("log1 END "),
+				splittest.ExpectToken("123 log2 END "),
+			},
+		},
+		{
+			name:        "WithLineEndPattern",
+			endPattern:  `LOGEND \d+ `,
+			endPatterns: []string{`STOP `},
+			input:       []byte("log1 STOP log2 LOGEND 123 "),
+			steps: []splittest.Step{
+				splittest.ExpectToken("log1 STOP "),
+				splittest.ExpectToken("log2 LOGEND 123 "),
+			},
+		},
+		{
+			name:         "WithLineStartPattern",
+			startPattern: `LOGSTART `,
+			endPatterns:  []string{`STOP `, `LOGEND \d+ `},
+			input:        []byte("LOGSTART log1 LOGEND 123 LOGSTART log2 STOP "),
+			steps: []splittest.Step{
+				splittest.ExpectToken("LOGSTART log1 LOGEND 123 "),
+				splittest.ExpectToken("LOGSTART log2 STOP "),
+			},
+		},
+		{
+			name:        "NoMatchFlushAtEOF",
+			endPatterns: []string{`STOP `, `LOGEND \d+ `},
+			flushAtEOF:  true,
+			input:       []byte("log1 no end"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("log1 no end"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{
+			LineStartPattern: tc.startPattern,
+			LineEndPattern:   tc.endPattern,
+			LineEndPatterns:  tc.endPatterns,
+			OmitPattern:      tc.omitPattern,
+		}
+		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestDelimiterSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name          string
+		delimiter     string
+		keepDelimiter bool
+		flushAtEOF    bool
+		input         []byte
+		steps         []splittest.Step
+	}{
+		{
+			name:      "NullByte",
+			delimiter: "\x00",
+			input:     []byte("one\x00two\x00\x00three"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("one\x00"), "one"),
+				splittest.ExpectAdvanceToken(len("two\x00"), "two"),
+				splittest.ExpectAdvanceToken(1, ""),
+			},
+		},
+		{
+			name:      "MultiByte",
+			delimiter: "|~|",
+			input:     []byte("one|two|~|three|~|"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("one|two|~|"), "one|two"),
+				splittest.ExpectAdvanceToken(len("three|~|"), "three"),
+			},
+		},
+		{
+			name:          "KeepDelimiter",
+			delimiter:     "\n---\n",
+			keepDelimiter: true,
+			input:         []byte("a: 1\n---\nb: 2\n---\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("a: 1\n---\n"),
+				splittest.ExpectToken("b: 2\n---\n"),
+			},
+		},
+		{
+			name:       "FlushAtEOF",
+			delimiter:  "|~|",
+			flushAtEOF: true,
+			input:      []byte("one|~|two|~"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("one|~|"), "one"),
+				splittest.ExpectToken("two|~"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{Mode: ModeDelimiter, Delimiter: tc.delimiter, KeepDelimiter: tc.keepDelimiter}
+		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+
+		nopSplitFunc, err := cfg.Func(encoding.Nop, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name+"/NopEncoding", splittest.New(nopSplitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestDelimiterSplitFuncUTF16(t *testing.T) {
+	enc := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	encode := func(s string) []byte {
+		b, err := enc.NewEncoder().Bytes([]byte(s))
+		require.NoError(t, err)
+		return b
+	}
+
+	splitFunc, err := DelimiterSplitFunc(enc, "|~|", false, false)
+	require.NoError(t, err)
+
+	advance, token, err := splitFunc(encode("one|~|two"), false)
+	require.NoError(t, err)
+	assert.Equal(t, len(encode("one|~|")), advance)
+	assert.Equal(t, encode("one"), token)
+}
+
+func TestLengthPrefixedSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        Config
+		flushAtEOF bool
+		maxLogSize int
+		input      []byte
+		steps      []splittest.Step
+	}{
+		{
+			name:  "Default",
+			cfg:   Config{Mode: ModeLengthPrefixed},
+			input: []byte("\x00\x00\x00\x05hello\x00\x00\x00\x00\x00\x00\x00\x02hi\x00\x00"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(9, "hello"),
+				splittest.ExpectAdvanceToken(4, ""),
+				splittest.ExpectAdvanceToken(6, "hi"),
+			},
+		},
+		{
+			name:  "OneByteLittleEndian",
+			cfg:   Config{Mode: ModeLengthPrefixed, LengthPrefixSize: 1, LengthPrefixByteOrder: ByteOrderLittleEndian},
+			input: []byte("\x03abc\x01d"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(4, "abc"),
+				splittest.ExpectAdvanceToken(2, "d"),
+			},
+		},
+		{
+			name:  "TwoBytesLittleEndian",
+			cfg:   Config{Mode: ModeLengthPrefixed, LengthPrefixSize: 2, LengthPrefixByteOrder: ByteOrderLittleEndian},
+			input: []byte("\x03\x00abc"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(5, "abc"),
+			},
+		},
+		{
+			name:  "EightBytesIncludesPrefix",
+			cfg:   Config{Mode: ModeLengthPrefixed, LengthPrefixSize: 8, LengthIncludesPrefix: true},
+			input: []byte("\x00\x00\x00\x00\x00\x00\x00\x0babc"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(11, "abc"),
+			},
+		},
+		{
+			name:  "Incomplete",
+			cfg:   Config{Mode: ModeLengthPrefixed, LengthPrefixSize: 1},
+			input: []byte("\x05abc"),
+		},
+		{
+			name:       "IncompleteFlushAtEOF",
+			cfg:        Config{Mode: ModeLengthPrefixed, LengthPrefixSize: 1},
+			flushAtEOF: true,
+			input:      []byte("\x05abc"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(4, "abc"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		splitFunc, err := tc.cfg.Func(encoding.Nop, tc.flushAtEOF, tc.maxLogSize)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestLengthPrefixedSplitFuncErrors(t *testing.T) {
+	splitFunc := LengthPrefixedSplitFunc(1, binary.BigEndian, true, 4, false)
+
+	_, _, err := splitFunc([]byte("\x00abc"), false)
+	assert.EqualError(t, err, "invalid record length 0, smaller than its length prefix")
+
+	advance, token, err := splitFunc([]byte("\x04abc\x05abcd"), false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, advance)
+	assert.Equal(t, []byte("abc"), token)
+
+	_, _, err = splitFunc([]byte("\x05abcd"), false)
+	assert.EqualError(t, err, "record length 5 exceeds max_log_size 4")
+}
+
+func TestJSONSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name       string
+		flushAtEOF bool
+		input      []byte
+		steps      []splittest.Step
+	}{
+		{
+			name:  "OneObject",
+			input: []byte(`{"a":1}`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`{"a":1}`),
+			},
+		},
+		{
+			name:  "MultilineObject",
+			input: []byte("{\n  \"a\": {\n    \"b\": [1, 2]\n  }\n}\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("{\n  \"a\": {\n    \"b\": [1, 2]\n  }\n}"),
+				splittest.ExpectAdvanceNil(1),
+			},
+		},
+		{
+			name:  "ObjectsSharingLine",
+			input: []byte(`{"a":1} {"b":2}{"c":3}`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`{"a":1}`),
+				splittest.ExpectAdvanceNil(1),
+				splittest.ExpectToken(`{"b":2}`),
+				splittest.ExpectToken(`{"c":3}`),
+			},
+		},
+		{
+			name:  "Array",
+			input: []byte(`[{"a":1},{"b":2}]`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`[{"a":1},{"b":2}]`),
+			},
+		},
+		{
+			name:  "BracesInStrings",
+			input: []byte(`{"a":"}{]\"}"}{"b":"\\"}`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`{"a":"}{]\"}"}`),
+				splittest.ExpectToken(`{"b":"\\"}`),
+			},
+		},
+		{
+			name:  "NotJSON",
+			input: []byte("plain text\n{\"a\":1}"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("plain text\n"), "plain text"),
+				splittest.ExpectToken(`{"a":1}`),
+			},
+		},
+		{
+			name:  "Incomplete",
+			input: []byte(`{"a":{"b":1}`),
+		},
+		{
+			name:       "IncompleteFlushAtEOF",
+			flushAtEOF: true,
+			input:      []byte(`{"a":1} {"a":{"b":1}`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`{"a":1}`),
+				splittest.ExpectAdvanceNil(1),
+				splittest.ExpectToken(`{"a":{"b":1}`),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{Mode: ModeJSON}
+		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestNegateSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name         string
+		startPattern string
+		endPattern   string
+		flushAtEOF   bool
+		input        []byte
+		steps        []splittest.Step
+	}{
+		{
+			name:         "StartNotTimestamp",
+			startPattern: `^\s`,
+			input:        []byte("2024-01-01 error\n  at a\n  at b\n2024-01-02 info\n2024-01-03 info\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("2024-01-01 error\n  at a\n  at b\n"),
+				splittest.ExpectToken("2024-01-02 info\n"),
+			},
+		},
+		{
+			name:         "StartFirstLineMatches",
+			startPattern: `^\s`,
+			input:        []byte("  orphan\nline\nnext\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("  orphan\n"),
+				splittest.ExpectToken("line\n"),
+			},
+		},
+		{
+			name:         "StartFlushAtEOF",
+			startPattern: `^\s`,
+			flushAtEOF:   true,
+			input:        []byte("line\n  more\nnext\n  more"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("line\n  more\n"),
+				splittest.ExpectToken("next\n  more"),
+			},
+		},
+		{
+			name:       "EndNotContinued",
+			endPattern: `\\$`,
+			input:      []byte("a \\\nb \\\nc\nd\ne \\\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("a \\\nb \\\nc\n"),
+				splittest.ExpectToken("d\n"),
+			},
+		},
+		{
+			name:       "EndFlushAtEOF",
+			endPattern: `\\$`,
+			flushAtEOF: true,
+			input:      []byte("a \\\nb"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("a \\\nb"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{LineStartPattern: tc.startPattern, LineEndPattern: tc.endPattern, Negate: true}
+		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestLineContinuationSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name       string
+		pattern    string
+		flushAtEOF bool
+		input      []byte
+		steps      []splittest.Step
+	}{
+		{
+			name:    "NoContinuation",
+			pattern: `\\$`,
+			input:   []byte("line1\nline2\nline3"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("line1\n"), "line1"),
+				splittest.ExpectAdvanceToken(len("line2\n"), "line2"),
+			},
+		},
+		{
+			name:    "TrailingBackslash",
+			pattern: `\\$`,
+			input:   []byte("a \\\nb \\\nc\nd\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("a \\\nb \\\nc\n"), "a \\\nb \\\nc"),
+				splittest.ExpectAdvanceToken(len("d\n"), "d"),
+			},
+		},
+		{
+			name:    "TrailingCommaCarriageReturn",
+			pattern: `,$`,
+			input:   []byte("a,\r\nb\r\nc\r\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("a,\r\nb\r\n"), "a,\r\nb"),
+				splittest.ExpectAdvanceToken(len("c\r\n"), "c"),
+			},
+		},
+		{
+			name:    "UnterminatedContinuation",
+			pattern: `\\$`,
+			input:   []byte("a \\\nb"),
+		},
+		{
+			name:       "UnterminatedContinuationFlushAtEOF",
+			pattern:    `\\$`,
+			flushAtEOF: true,
+			input:      []byte("a \\\nb"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("a \\\nb"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{LineContinuationPattern: tc.pattern}
+		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestIndentSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name       string
+		prefix     string
+		flushAtEOF bool
+		input      []byte
+		steps      []splittest.Step
+	}{
+		{
+			name:  "NoContinuation",
+			input: []byte("line1\nline2\nline3"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("line1\n"), "line1"),
+				splittest.ExpectAdvanceToken(len("line2\n"), "line2"),
+			},
+		},
+		{
+			name:  "StackTrace",
+			input: []byte("Exception in thread main\n\tat a.b(C.java:1)\n    at d.e(F.java:2)\nnext line\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("Exception in thread main\n\tat a.b(C.java:1)\n    at d.e(F.java:2)\n"),
+					"Exception in thread main\n\tat a.b(C.java:1)\n    at d.e(F.java:2)"),
+			},
+		},
+		{
+			name:  "CarriageReturn",
+			input: []byte("line1\r\n  more\r\nline2\r\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("line1\r\n  more\r\n"), "line1\r\n  more"),
+			},
+		},
+		{
+			name:   "Prefix",
+			prefix: "| ",
+			input:  []byte("line1\n| more\n  line2\nline3\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("line1\n| more\n"), "line1\n| more"),
+				splittest.ExpectAdvanceToken(len("  line2\n"), "  line2"),
+			},
+		},
+		{
+			name:       "FlushAtEOF",
+			flushAtEOF: true,
+			input:      []byte("line1\n  more\nline2\n  more"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("line1\n  more\n"), "line1\n  more"),
+				splittest.ExpectToken("line2\n  more"),
+			},
+		},
+		{
+			name:       "FlushAtEOFTrailingNewline",
+			flushAtEOF: true,
+			input:      []byte("line1\n  more\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("line1\n  more\n"), "line1\n  more"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{Mode: ModeIndent, IndentPrefix: tc.prefix}
+		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestIndentSplitFuncUTF16(t *testing.T) {
+	enc := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	encode := func(s string) []byte {
+		b, err := enc.NewEncoder().Bytes([]byte(s))
+		require.NoError(t, err)
+		return b
+	}
+
+	splitFunc, err := IndentSplitFunc(enc, "", false)
+	require.NoError(t, err)
+
+	advance, token, err := splitFunc(encode("line1\n  more\nline2\n"), false)
+	require.NoError(t, err)
+	assert.Equal(t, len(encode("line1\n  more\n")), advance)
+	assert.Equal(t, encode("line1\n  more"), token)
+}
+
+func TestNewlineSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name       string
+		encoding   encoding.Encoding
+		flushAtEOF bool
+		input      []byte
+		steps      []splittest.Step
+	}{
+		{
+			name:  "EmptyFile",
+			input: []byte(""),
+		},
+		{
+			name:  "OneLogSimple",
+			input: []byte("my log\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("my log")+1, "my log"),
+			},
+		},
+		{
+			name:  "OneLogCarriageReturn",
+			input: []byte("my log\r\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("my log")+2, "my log"),
+			},
+		},
+		{
+			name:  "TwoLogsSimple",
+			input: []byte("log1\nlog2\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("log1")+1, "log1"),
+				splittest.ExpectAdvanceToken(len("log2")+1, "log2"),
+			},
+		},
+		{
+			name:  "TwoLogsCarriageReturn",
+			input: []byte("log1\r\nlog2\r\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("log1")+2, "log1"),
+				splittest.ExpectAdvanceToken(len("log2")+2, "log2"),
+			},
+		},
+		{
+			name:  "NoTailingNewline",
+			input: []byte("foo"),
 		},
 		{
 			name: "HugeLog10000",
@@ -581,6 +1764,14 @@ func TestNewlineSplitFunc(t *testing.T) {
 				),
 			},
 		},
+		{
+			name:     "MisalignedNewlineUTF16",
+			encoding: unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
+			input:    []byte{0x41, 0x0A, 0x00, 0x01, 0x0A, 0x00}, // \u0A41\u0100\n, holding the bytes of a newline at an odd index
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(6, string([]byte{0x41, 0x0A, 0x00, 0x01})),
+			},
+		},
 		{
 			name:       "AlternateEncoding",
 			input:      []byte("折\n"),
@@ -602,6 +1793,83 @@ func TestNewlineSplitFunc(t *testing.T) {
 	}
 }
 
+func TestNewlineSequenceSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name            string
+		newline         string
+		newlineSequence string
+		encoding        encoding.Encoding
+		input           []byte
+		steps           []splittest.Step
+	}{
+		{
+			name:    "LFKeepsCarriageReturn",
+			newline: NewlineLF,
+			input:   []byte("log1\r\nlog2\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("log1\r\n"), "log1\r"),
+				splittest.ExpectAdvanceToken(len("log2\n"), "log2"),
+			},
+		},
+		{
+			name:    "CRLF",
+			newline: NewlineCRLF,
+			input:   []byte("log1\nmore\r\nlog2\r\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("log1\nmore\r\n"), "log1\nmore"),
+				splittest.ExpectAdvanceToken(len("log2\r\n"), "log2"),
+			},
+		},
+		{
+			name:    "CR",
+			newline: NewlineCR,
+			input:   []byte("log1\rlog2\r"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("log1\r"), "log1"),
+				splittest.ExpectAdvanceToken(len("log2\r"), "log2"),
+			},
+		},
+		{
+			name:            "Custom",
+			newline:         NewlineCustom,
+			newlineSequence: "\x1e",
+			input:           []byte("log1\nmore\x1elog2\x1e"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("log1\nmore\x1e"), "log1\nmore"),
+				splittest.ExpectAdvanceToken(len("log2\x1e"), "log2"),
+			},
+		},
+		{
+			name:     "CRLFUTF16",
+			newline:  NewlineCRLF,
+			encoding: unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM),
+			input:    []byte{0, 108, 0, 49, 0, 10, 0, 50, 0, 13, 0, 10}, // l1\n2\r\n
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(12, string([]byte{0, 108, 0, 49, 0, 10, 0, 50})),
+			},
+		},
+		{
+			name:     "CRMisalignedUTF16",
+			newline:  NewlineCR,
+			encoding: unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
+			input:    []byte{0x41, 0x0D, 0x00, 0x01, 0x0D, 0x00}, // \u0D41\u0100\r, holding the bytes of a carriage return at an odd index
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(6, string([]byte{0x41, 0x0D, 0x00, 0x01})),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.encoding == nil {
+			tc.encoding = unicode.UTF8
+		}
+		cfg := Config{Newline: tc.newline, NewlineSequence: tc.newlineSequence}
+		splitFunc, err := cfg.Func(tc.encoding, false, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
 func TestNoSplitFunc(t *testing.T) {
 	const largeLogSize = 100
 	testCases := []struct {
@@ -681,3 +1949,236 @@ func TestNoSplitFunc(t *testing.T) {
 		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
 	}
 }
+
+func TestNoSplitFuncSmallMaxLogSize(t *testing.T) {
+	t.Run("ConfigZero", func(t *testing.T) {
+		_, err := Config{}.Func(encoding.Nop, false, 0)
+		assert.EqualError(t, err, "max_log_size must be positive when using nop encoding")
+	})
+
+	t.Run("Zero", splittest.New(NoSplitFunc(0), []byte("foo"),
+		splittest.ExpectToken("foo"),
+	))
+
+	t.Run("One", splittest.New(NoSplitFunc(1), []byte("foo"),
+		splittest.ExpectToken("f"),
+		splittest.ExpectToken("o"),
+		splittest.ExpectToken("o"),
+	))
+}
+
+func TestCollapseRepeatsFunc(t *testing.T) {
+	testCases := []struct {
+		name         string
+		input        string
+		expectTokens []string
+		expectCounts []int
+	}{
+		{
+			name:         "NoRepeats",
+			input:        "log1\nlog2\nlog3\n",
+			expectTokens: []string{"log1", "log2", "log3"},
+			expectCounts: []int{1, 1, 1},
+		},
+		{
+			name:         "RunOfIdenticalLines",
+			input:        "same\nsame\nsame\nsame\nsame\n",
+			expectTokens: []string{"same"},
+			expectCounts: []int{5},
+		},
+		{
+			name:         "RunsBetweenOtherLines",
+			input:        "start\nsame\nsame\nsame\nend\nend\n",
+			expectTokens: []string{"start", "same", "end"},
+			expectCounts: []int{1, 3, 2},
+		},
+		{
+			name:         "NonConsecutiveRepeats",
+			input:        "same\nother\nsame\n",
+			expectTokens: []string{"same", "other", "same"},
+			expectCounts: []int{1, 1, 1},
+		},
+		{
+			name:         "EmptyLines",
+			input:        "\n\n\nlog\n",
+			expectTokens: []string{"", "log"},
+			expectCounts: []int{3, 1},
+		},
+		{
+			name:         "UnterminatedRepeat",
+			input:        "same\nsame\nsame",
+			expectTokens: []string{"same"},
+			expectCounts: []int{2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newlineFunc, err := NewlineSplitFunc(unicode.UTF8, false)
+			require.NoError(t, err)
+
+			var counts []int
+			splitFunc := CollapseRepeatsFunc(newlineFunc, func(count int) {
+				counts = append(counts, count)
+			})
+
+			var tokens []string
+			scanner := bufio.NewScanner(strings.NewReader(tc.input))
+			scanner.Split(splitFunc)
+			for scanner.Scan() {
+				tokens = append(tokens, scanner.Text())
+			}
+			require.NoError(t, scanner.Err())
+			assert.Equal(t, tc.expectTokens, tokens)
+			assert.Equal(t, tc.expectCounts, counts)
+		})
+	}
+}
+
+func TestCollapseRepeatsFuncReadMore(t *testing.T) {
+	newlineFunc, err := NewlineSplitFunc(unicode.UTF8, false)
+	require.NoError(t, err)
+
+	var counts []int
+	splitFunc := CollapseRepeatsFunc(newlineFunc, func(count int) {
+		counts = append(counts, count)
+	})
+
+	advance, token, err := splitFunc([]byte("same"), false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, advance)
+	assert.Nil(t, token)
+	assert.Empty(t, counts)
+}
+
+func TestMaxLinesFunc(t *testing.T) {
+	testCases := []struct {
+		name         string
+		startPattern string
+		endPattern   string
+		flushAtEOF   bool
+		input        []byte
+		steps        []splittest.Step
+	}{
+		{
+			name:         "StartPatternShortRecords",
+			startPattern: `^LOGSTART`,
+			input:        []byte("LOGSTART 1\nline\nLOGSTART 2\nline\nLOGSTART 3"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("LOGSTART 1\nline\n"),
+				splittest.ExpectToken("LOGSTART 2\nline\n"),
+			},
+		},
+		{
+			name:         "StartPatternLongRecord",
+			startPattern: `^LOGSTART`,
+			input:        []byte("LOGSTART 1\na\nb\nc\nd\nLOGSTART 2\nline\nLOGSTART 3"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("LOGSTART 1\na\nb\n"),
+				splittest.ExpectToken("c\nd\n"),
+				splittest.ExpectToken("LOGSTART 2\nline\n"),
+			},
+		},
+		{
+			name:       "EndPatternLongRecord",
+			endPattern: `END\n`,
+			input:      []byte("a\nb\nc\nd\nEND\ne\nEND\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("a\nb\nc\n"),
+				splittest.ExpectToken("d\nEND\n"),
+				splittest.ExpectToken("e\nEND\n"),
+			},
+		},
+		{
+			name:         "FlushAtEOF",
+			startPattern: `^LOGSTART`,
+			flushAtEOF:   true,
+			input:        []byte("LOGSTART 1\na"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("LOGSTART 1\na"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := Config{
+			LineStartPattern: tc.startPattern,
+			LineEndPattern:   tc.endPattern,
+			MaxLines:         3,
+		}
+		splitFunc, err := cfg.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestMaxLinesFuncUTF16(t *testing.T) {
+	enc := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	encode := func(s string) []byte {
+		b, err := enc.NewEncoder().Bytes([]byte(s))
+		require.NoError(t, err)
+		return b
+	}
+
+	cfg := Config{LineStartPattern: "^LOGSTART", MaxLines: 1}
+	splitFunc, err := cfg.Func(enc, false, 0)
+	require.NoError(t, err)
+
+	advance, token, err := splitFunc(encode("a\nb\n"), false)
+	require.NoError(t, err)
+	assert.Equal(t, len(encode("a\n")), advance)
+	assert.Equal(t, encode("a\n"), token)
+}
+
+func TestSkipFirstPartialFunc(t *testing.T) {
+	re := regexp.MustCompile("(?m)^LOGSTART")
+	var skipped int
+	splitFunc := SkipFirstPartialFunc(LineStartSplitFunc(re, false, true), re, 0, func() { skipped++ })
+
+	// No token is returned before the first start match.
+	advance, token, err := splitFunc([]byte("tail of a previous record"), false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, advance)
+	assert.Nil(t, token)
+	assert.Equal(t, 0, skipped)
+
+	scanner := bufio.NewScanner(strings.NewReader("tail of a previous record\nLOGSTART 1\nLOGSTART 2\n"))
+	scanner.Split(splitFunc)
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []string{"LOGSTART 1\n", "LOGSTART 2\n"}, tokens)
+	assert.Equal(t, 1, skipped)
+}
+
+func TestSkipFirstPartialFuncMaxLength(t *testing.T) {
+	re := regexp.MustCompile("(?m)^LOGSTART")
+	var skipped int
+	splitFunc := SkipFirstPartialFunc(LineStartSplitFunc(re, false, true), re, 16, func() { skipped++ })
+
+	// The bytes up to the last line break are discarded once max length is reached.
+	advance, token, err := splitFunc([]byte("tail of a\nprevious record"), false)
+	require.NoError(t, err)
+	assert.Equal(t, 10, advance)
+	assert.Nil(t, token)
+
+	// Without a line break, all of them are discarded.
+	advance, token, err = splitFunc([]byte("tail of a previous record"), false)
+	require.NoError(t, err)
+	assert.Equal(t, 25, advance)
+	assert.Nil(t, token)
+	assert.Equal(t, 0, skipped)
+
+	scanner := bufio.NewScanner(strings.NewReader(strings.Repeat("tail of a previous record\n", 4) + "LOGSTART 1\nLOGSTART 2\n"))
+	scanner.Buffer(make([]byte, 0, 16), 32)
+	scanner.Split(splitFunc)
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []string{"LOGSTART 1\n", "LOGSTART 2\n"}, tokens)
+	assert.Equal(t, 1, skipped)
+}
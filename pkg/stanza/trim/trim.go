@@ -6,6 +6,8 @@ package trim // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"bufio"
 	"bytes"
+	"fmt"
+	"unicode"
 )
 
 type Func func([]byte) []byte
@@ -23,22 +25,128 @@ func WithFunc(splitFunc bufio.SplitFunc, trimFunc Func) bufio.SplitFunc {
 	}
 }
 
+const (
+	// EndsBoth trims both ends of the tokens.
+	EndsBoth = "both"
+	// EndsLeading trims the start of the tokens.
+	EndsLeading = "leading"
+	// EndsTrailing trims the end of the tokens.
+	EndsTrailing = "trailing"
+	// EndsNone does not trim the tokens.
+	EndsNone = "none"
+
+	// whitespace is the default cutset.
+	whitespace = "\r\n\t "
+)
+
 type Config struct {
 	PreserveLeading  bool `mapstructure:"preserve_leading_whitespaces,omitempty"`
 	PreserveTrailing bool `mapstructure:"preserve_trailing_whitespaces,omitempty"`
+	TrimUnicode      bool `mapstructure:"trim_unicode_whitespace,omitempty"`
+
+	// Trim configures the trimming of the tokens in place of the flags above, which are
+	// mapped to the equivalent Options when it is not set.
+	Trim *Options `mapstructure:"trim,omitempty"`
+}
+
+// Options configures which bytes are trimmed from which ends of the tokens.
+type Options struct {
+	// Ends are the ends of the tokens that are trimmed, one of the Ends* values. Empty means EndsBoth.
+	Ends string `mapstructure:"ends,omitempty"`
+	// Cutset are the characters trimmed from the tokens, such as "\x00\r\n\t ".
+	// Empty means the whitespace characters "\r\n\t ".
+	Cutset string `mapstructure:"cutset,omitempty"`
+	// Unicode also trims all whitespace as defined by unicode.IsSpace after the tokens are decoded.
+	Unicode bool `mapstructure:"unicode,omitempty"`
+}
+
+// Validate checks the config for invalid or conflicting options
+func (c Config) Validate() error {
+	if c.Trim == nil {
+		return nil
+	}
+	if c.PreserveLeading || c.PreserveTrailing || c.TrimUnicode {
+		return fmt.Errorf("trim cannot be used with preserve_leading_whitespaces, preserve_trailing_whitespaces or trim_unicode_whitespace")
+	}
+	switch c.Trim.Ends {
+	case "", EndsBoth, EndsLeading, EndsTrailing, EndsNone:
+	default:
+		return fmt.Errorf("invalid trim ends '%s', must be %s, %s, %s or %s", c.Trim.Ends, EndsBoth, EndsLeading, EndsTrailing, EndsNone)
+	}
+	return nil
+}
+
+// Options returns the options of the config, which are mapped from the preserve and
+// trim_unicode_whitespace flags unless Trim is set.
+func (c Config) Options() Options {
+	if c.Trim != nil {
+		o := *c.Trim
+		if o.Ends == "" {
+			o.Ends = EndsBoth
+		}
+		if o.Cutset == "" {
+			o.Cutset = whitespace
+		}
+		return o
+	}
+
+	o := Options{Ends: EndsBoth, Cutset: whitespace, Unicode: c.TrimUnicode}
+	switch {
+	case c.PreserveLeading && c.PreserveTrailing:
+		o.Ends = EndsNone
+	case c.PreserveLeading:
+		o.Ends = EndsTrailing
+	case c.PreserveTrailing:
+		o.Ends = EndsLeading
+	}
+	return o
 }
 
 func (c Config) Func() Func {
-	if c.PreserveLeading && c.PreserveTrailing {
+	o := c.Options()
+	if o.Cutset == whitespace {
+		switch o.Ends {
+		case EndsNone:
+			return Nop
+		case EndsTrailing:
+			return Trailing
+		case EndsLeading:
+			return Leading
+		}
+		return Whitespace
+	}
+
+	switch o.Ends {
+	case EndsNone:
 		return Nop
+	case EndsTrailing:
+		return TrailingCutset(o.Cutset)
+	case EndsLeading:
+		return LeadingCutset(o.Cutset)
 	}
-	if c.PreserveLeading {
-		return Trailing
+	leading, trailing := LeadingCutset(o.Cutset), TrailingCutset(o.Cutset)
+	return func(data []byte) []byte {
+		return leading(trailing(data))
 	}
-	if c.PreserveTrailing {
-		return Leading
+}
+
+// DecodedFunc returns a Func to apply to tokens after they have been decoded to UTF-8.
+// When TrimUnicode or Trim.Unicode is set, it trims all whitespace as defined by unicode.IsSpace,
+// such as no-break and ideographic spaces, from the ends of the tokens that are trimmed.
+func (c Config) DecodedFunc() Func {
+	o := c.Options()
+	if !o.Unicode {
+		return Nop
 	}
-	return Whitespace
+	switch o.Ends {
+	case EndsNone:
+		return Nop
+	case EndsTrailing:
+		return UnicodeTrailing
+	case EndsLeading:
+		return UnicodeLeading
+	}
+	return UnicodeWhitespace
 }
 
 func Nop(token []byte) []byte {
@@ -46,7 +154,7 @@ func Nop(token []byte) []byte {
 }
 
 func Leading(data []byte) []byte {
-	token := bytes.TrimLeft(data, "\r\n\t ")
+	token := bytes.TrimLeft(data, whitespace)
 	if token == nil {
 		// TrimLeft sometimes overwrites something with nothing.
 		// We need to override this behavior in order to preserve empty tokens.
@@ -56,13 +164,49 @@ func Leading(data []byte) []byte {
 }
 
 func Trailing(data []byte) []byte {
-	return bytes.TrimRight(data, "\r\n\t ")
+	return bytes.TrimRight(data, whitespace)
+}
+
+// LeadingCutset returns a Func trimming the characters in cutset from the start of the tokens.
+func LeadingCutset(cutset string) Func {
+	return func(data []byte) []byte {
+		token := bytes.TrimLeft(data, cutset)
+		if token == nil {
+			// Preserve empty tokens, see Leading.
+			return data
+		}
+		return token
+	}
+}
+
+// TrailingCutset returns a Func trimming the characters in cutset from the end of the tokens.
+func TrailingCutset(cutset string) Func {
+	return func(data []byte) []byte {
+		return bytes.TrimRight(data, cutset)
+	}
 }
 
 func Whitespace(data []byte) []byte {
 	return Leading(Trailing(data))
 }
 
+func UnicodeLeading(data []byte) []byte {
+	token := bytes.TrimLeftFunc(data, unicode.IsSpace)
+	if token == nil {
+		// Preserve empty tokens, see Leading.
+		return data
+	}
+	return token
+}
+
+func UnicodeTrailing(data []byte) []byte {
+	return bytes.TrimRightFunc(data, unicode.IsSpace)
+}
+
+func UnicodeWhitespace(data []byte) []byte {
+	return UnicodeLeading(UnicodeTrailing(data))
+}
+
 func ToLength(splitFunc bufio.SplitFunc, maxLength int) bufio.SplitFunc {
 	if maxLength <= 0 {
 		return splitFunc
@@ -80,3 +224,69 @@ func ToLength(splitFunc bufio.SplitFunc, maxLength int) bufio.SplitFunc {
 		return advance, token, err
 	}
 }
+
+const (
+	// OversizeSplit returns a token longer than the max length as several tokens of at most the max length.
+	OversizeSplit = "split"
+	// OversizeTruncate returns the start of a token longer than the max length, and skips its remainder.
+	OversizeTruncate = "truncate"
+	// OversizeDrop skips a token longer than the max length.
+	OversizeDrop = "drop"
+)
+
+// ToLengthOnOversize wraps a bufio.SplitFunc like ToLength, handling the tokens longer than maxLength
+// according to onOversize. Unless it is OversizeSplit, the remainder of such a token is skipped up to the
+// end of the next token returned by splitFunc, while skipping is set. onTruncated is called before
+// returning a truncated token.
+func ToLengthOnOversize(splitFunc bufio.SplitFunc, maxLength int, onOversize string, skipping *bool, onTruncated func()) bufio.SplitFunc {
+	if maxLength <= 0 || onOversize == "" || onOversize == OversizeSplit {
+		return ToLength(splitFunc, maxLength)
+	}
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := splitFunc(data, atEOF)
+		if err != nil {
+			return advance, token, err
+		}
+		if *skipping {
+			if token != nil {
+				// the token is the remainder of the oversize one
+				*skipping = false
+				return advance, nil, nil
+			}
+			if advance == 0 && len(data) >= maxLength {
+				return skipLength(data), nil, nil
+			}
+			return advance, nil, nil
+		}
+		if token == nil {
+			if advance == 0 && len(data) >= maxLength {
+				// No token was found, but it is already longer than the max length.
+				*skipping = true
+				if onOversize == OversizeDrop {
+					return skipLength(data), nil, nil
+				}
+				onTruncated()
+				return maxLength, data[:maxLength], nil
+			}
+			return advance, token, err
+		}
+		if len(token) > maxLength {
+			// A token was found but it is longer than the max length.
+			if onOversize == OversizeDrop {
+				return advance, nil, nil
+			}
+			onTruncated()
+			return advance, token[:maxLength], nil
+		}
+		return advance, token, err
+	}
+}
+
+// skipLength returns the length of data to skip while looking for the end of an oversize token,
+// which keeps the last line, since it may hold the start of the next token.
+func skipLength(data []byte) int {
+	if i := bytes.LastIndexByte(data, '\n'); i > 0 {
+		return i + 1
+	}
+	return len(data)
+}
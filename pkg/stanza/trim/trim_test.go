@@ -5,9 +5,12 @@ package trim
 
 import (
 	"bufio"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split/splittest"
 )
@@ -76,6 +79,198 @@ func TestTrim(t *testing.T) {
 	}
 }
 
+func TestTrimOptions(t *testing.T) {
+	testCases := []struct {
+		name    string
+		options Options
+		input   []byte
+		expect  []byte
+		decoded []byte
+	}{
+		{
+			name:    "default",
+			input:   []byte(" \x00hello world\x00 "),
+			expect:  []byte("\x00hello world\x00"),
+			decoded: []byte("\x00hello world\x00"),
+		},
+		{
+			name:    "cutset",
+			options: Options{Cutset: "\x00\r\n\t "},
+			input:   []byte(" \x00hello world\x00 "),
+			expect:  []byte("hello world"),
+			decoded: []byte("hello world"),
+		},
+		{
+			name:    "cutset leading",
+			options: Options{Ends: EndsLeading, Cutset: "\x00 "},
+			input:   []byte(" \x00hello world\x00 "),
+			expect:  []byte("hello world\x00 "),
+			decoded: []byte("hello world\x00 "),
+		},
+		{
+			name:    "cutset trailing",
+			options: Options{Ends: EndsTrailing, Cutset: "\x00 "},
+			input:   []byte(" \x00hello world\x00 "),
+			expect:  []byte(" \x00hello world"),
+			decoded: []byte(" \x00hello world"),
+		},
+		{
+			name:    "none",
+			options: Options{Ends: EndsNone, Cutset: "\x00 ", Unicode: true},
+			input:   []byte(" \x00hello world\x00 "),
+			expect:  []byte(" \x00hello world\x00 "),
+			decoded: []byte(" \x00hello world\x00 "),
+		},
+		{
+			name:    "cutset keeps empty tokens",
+			options: Options{Cutset: "\x00"},
+			input:   []byte{},
+			expect:  []byte{},
+			decoded: []byte{},
+		},
+		{
+			name:    "unicode",
+			options: Options{Unicode: true},
+			input:   []byte("\u00a0hello world\u3000 "),
+			expect:  []byte("\u00a0hello world\u3000"),
+			decoded: []byte("hello world"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := tc.options
+			cfg := Config{Trim: &options}
+			require.NoError(t, cfg.Validate())
+			token := cfg.Func()(tc.input)
+			assert.Equal(t, tc.expect, token)
+			assert.Equal(t, tc.decoded, cfg.DecodedFunc()(token))
+		})
+	}
+}
+
+func TestConfigOptions(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfg    Config
+		expect Options
+	}{
+		{
+			name:   "default",
+			cfg:    Config{},
+			expect: Options{Ends: EndsBoth, Cutset: "\r\n\t "},
+		},
+		{
+			name:   "preserve leading",
+			cfg:    Config{PreserveLeading: true, TrimUnicode: true},
+			expect: Options{Ends: EndsTrailing, Cutset: "\r\n\t ", Unicode: true},
+		},
+		{
+			name:   "preserve trailing",
+			cfg:    Config{PreserveTrailing: true},
+			expect: Options{Ends: EndsLeading, Cutset: "\r\n\t "},
+		},
+		{
+			name:   "preserve both",
+			cfg:    Config{PreserveLeading: true, PreserveTrailing: true},
+			expect: Options{Ends: EndsNone, Cutset: "\r\n\t "},
+		},
+		{
+			name:   "trim",
+			cfg:    Config{Trim: &Options{Ends: EndsLeading, Cutset: "\x00"}},
+			expect: Options{Ends: EndsLeading, Cutset: "\x00"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.cfg.Options())
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, Config{PreserveLeading: true}.Validate())
+	assert.NoError(t, Config{Trim: &Options{Ends: EndsTrailing}}.Validate())
+	assert.EqualError(t, Config{Trim: &Options{Ends: "middle"}}.Validate(), "invalid trim ends 'middle', must be both, leading, trailing or none")
+	assert.EqualError(t, Config{PreserveLeading: true, Trim: &Options{}}.Validate(),
+		"trim cannot be used with preserve_leading_whitespaces, preserve_trailing_whitespaces or trim_unicode_whitespace")
+}
+
+func TestTrimUnicode(t *testing.T) {
+	testCases := []struct {
+		name             string
+		trimUnicode      bool
+		preserveLeading  bool
+		preserveTrailing bool
+		input            []byte
+		expect           []byte
+	}{
+		{
+			name:   "disabled",
+			input:  []byte("\u00a0\u3000 hello world \u3000\u00a0"),
+			expect: []byte("\u00a0\u3000 hello world \u3000\u00a0"),
+		},
+		{
+			name:        "no-break spaces",
+			trimUnicode: true,
+			input:       []byte("\u00a0\u00a0hello world\u00a0"),
+			expect:      []byte("hello world"),
+		},
+		{
+			name:        "ideographic spaces",
+			trimUnicode: true,
+			input:       []byte("\u3000hello\u3000world\u3000\u3000"),
+			expect:      []byte("hello\u3000world"),
+		},
+		{
+			name:        "mixed with ascii whitespace",
+			trimUnicode: true,
+			input:       []byte(" \t\u00a0\u3000hello world\u3000\u00a0\r\n"),
+			expect:      []byte("hello world"),
+		},
+		{
+			name:            "preserve leading",
+			trimUnicode:     true,
+			preserveLeading: true,
+			input:           []byte("\u00a0hello world\u3000"),
+			expect:          []byte("\u00a0hello world"),
+		},
+		{
+			name:             "preserve trailing",
+			trimUnicode:      true,
+			preserveTrailing: true,
+			input:            []byte("\u00a0hello world\u3000"),
+			expect:           []byte("hello world\u3000"),
+		},
+		{
+			name:             "preserve both",
+			trimUnicode:      true,
+			preserveLeading:  true,
+			preserveTrailing: true,
+			input:            []byte("\u00a0hello world\u3000"),
+			expect:           []byte("\u00a0hello world\u3000"),
+		},
+		{
+			name:        "only whitespace",
+			trimUnicode: true,
+			input:       []byte("\u00a0\u3000"),
+			expect:      []byte{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trimFunc := Config{
+				PreserveLeading:  tc.preserveLeading,
+				PreserveTrailing: tc.preserveTrailing,
+				TrimUnicode:      tc.trimUnicode,
+			}.DecodedFunc()
+			assert.Equal(t, tc.expect, trimFunc(tc.input))
+		})
+	}
+}
+
 func TestWithFunc(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -174,3 +369,75 @@ func TestToLength(t *testing.T) {
 		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
 	}
 }
+
+func TestToLengthOnOversize(t *testing.T) {
+	testCases := []struct {
+		name          string
+		onOversize    string
+		input         string
+		expected      []string
+		truncateCount int
+	}{
+		{
+			name:       "Split",
+			onOversize: OversizeSplit,
+			input:      "short\nThis is a very long line\nnext\n",
+			expected:   []string{"short", "This is a ", "very long ", "line", "next"},
+		},
+		{
+			name:          "Truncate",
+			onOversize:    OversizeTruncate,
+			input:         "short\nThis is a very long line\nnext\n",
+			expected:      []string{"short", "This is a ", "next"},
+			truncateCount: 1,
+		},
+		{
+			name:          "TruncateAtEOF",
+			onOversize:    OversizeTruncate,
+			input:         "short\nThis is a very long line",
+			expected:      []string{"short", "This is a "},
+			truncateCount: 1,
+		},
+		{
+			name:          "TruncateSeveral",
+			onOversize:    OversizeTruncate,
+			input:         "This is a very long line\nThis is another very long line\nnext\n",
+			expected:      []string{"This is a ", "This is an", "next"},
+			truncateCount: 2,
+		},
+		{
+			name:       "Drop",
+			onOversize: OversizeDrop,
+			input:      "short\nThis is a very long line\nnext\n",
+			expected:   []string{"short", "next"},
+		},
+		{
+			name:       "DropAtEOF",
+			onOversize: OversizeDrop,
+			input:      "short\nThis is a very long line",
+			expected:   []string{"short"},
+		},
+	}
+
+	for _, tc := range testCases {
+		// a small buffer grows along with the data, so that oversize tokens are also found incomplete
+		for _, bufferSize := range []int{4, 64} {
+			t.Run(fmt.Sprintf("%s/Buffer%d", tc.name, bufferSize), func(t *testing.T) {
+				var skipping bool
+				var truncateCount int
+				splitFunc := ToLengthOnOversize(bufio.ScanLines, 10, tc.onOversize, &skipping, func() { truncateCount++ })
+
+				scanner := bufio.NewScanner(strings.NewReader(tc.input))
+				scanner.Buffer(make([]byte, 0, bufferSize), 64)
+				scanner.Split(splitFunc)
+				var tokens []string
+				for scanner.Scan() {
+					tokens = append(tokens, scanner.Text())
+				}
+				require.NoError(t, scanner.Err())
+				assert.Equal(t, tc.expected, tokens)
+				assert.Equal(t, tc.truncateCount, truncateCount)
+			})
+		}
+	}
+}
@@ -6,8 +6,10 @@ package hostmetricsreceiver // import "github.com/open-telemetry/opentelemetry-c
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
@@ -16,7 +18,11 @@ import (
 )
 
 const (
-	scrapersKey = "scrapers"
+	scrapersKey           = "scrapers"
+	rootPathKey           = "root_path"
+	collectionIntervalKey = "collection_interval"
+	muteErrorsKey         = "mute_errors"
+	debugErrorsKey        = "debug_errors"
 )
 
 // Config defines configuration for HostMetrics receiver.
@@ -25,6 +31,60 @@ type Config struct {
 	Scrapers                       map[string]internal.Config `mapstructure:"-"`
 	// RootPath is the host's root directory (linux only).
 	RootPath string `mapstructure:"root_path"`
+	// MaxConcurrency is the maximum number of scrapers scraped concurrently. The scrapers are scraped sequentially when
+	// it is 0 or 1 (default).
+	MaxConcurrency int `mapstructure:"max_concurrency"`
+	// ScraperTimeout is the deadline of the scrape of each scraper, disabled when 0 (default).
+	ScraperTimeout time.Duration `mapstructure:"scraper_timeout"`
+	// ResourceDetection configures the detection of the resource attributes identifying the host.
+	ResourceDetection ResourceDetectionConfig `mapstructure:"resource_detection"`
+	// Trigger configures an endpoint triggering an immediate scrape of the scrapers, disabled by default.
+	Trigger *TriggerConfig `mapstructure:"trigger"`
+
+	// scraperOverrides holds the settings of the scrapers overriding those of the receiver.
+	scraperOverrides map[string]scraperOverrides
+}
+
+// scraperOverrides holds the settings of a scraper block which override those of the receiver. They are handled by
+// the receiver rather than being part of the scraper config itself.
+type scraperOverrides struct {
+	RootPath           *string       `mapstructure:"root_path"`
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+	// MuteErrors lists regular expressions matching the scrape errors of the scraper which are dropped.
+	MuteErrors []string `mapstructure:"mute_errors"`
+	// DebugErrors lists regular expressions matching the scrape errors of the scraper which are logged at the debug
+	// level rather than reported.
+	DebugErrors []string `mapstructure:"debug_errors"`
+}
+
+// ResourceDetectionConfig configures the detection of the resource attributes identifying the host, which are added
+// to the resources of the metrics of the receiver.
+type ResourceDetectionConfig struct {
+	// Enabled enables the detection of the host.id, host.arch, os.type, os.description and cloud.provider resource
+	// attributes, disabled by default.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// TriggerConfig configures the endpoint triggering an immediate scrape of the scrapers of the receiver.
+type TriggerConfig struct {
+	// Endpoint is the address the endpoint listens on, such as `localhost:8080` for the `tcp` transport, or
+	// `/run/otelcol/hostmetrics.sock` for the `unix` transport.
+	Endpoint string `mapstructure:"endpoint"`
+	// Transport is either `tcp` (default) or `unix`.
+	Transport confignet.TransportType `mapstructure:"transport"`
+}
+
+// Validate checks the trigger configuration is valid
+func (cfg *TriggerConfig) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("trigger endpoint must be specified")
+	}
+	switch cfg.Transport {
+	case "", confignet.TransportTypeTCP, confignet.TransportTypeTCP4, confignet.TransportTypeTCP6, confignet.TransportTypeUnix:
+		return nil
+	default:
+		return fmt.Errorf("invalid trigger transport %q, must be tcp or unix", cfg.Transport)
+	}
 }
 
 var _ component.Config = (*Config)(nil)
@@ -37,9 +97,36 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, errors.New("must specify at least one scraper when using hostmetrics receiver"))
 	}
 	err = multierr.Append(err, validateRootPath(cfg.RootPath))
+	if cfg.MaxConcurrency < 0 {
+		err = multierr.Append(err, errors.New("max_concurrency must not be negative"))
+	}
+	if cfg.ScraperTimeout < 0 {
+		err = multierr.Append(err, errors.New("scraper_timeout must not be negative"))
+	}
+	for key, overrides := range cfg.scraperOverrides {
+		if overrides.RootPath != nil {
+			if scraperErr := validateScraperRootPath(*overrides.RootPath); scraperErr != nil {
+				err = multierr.Append(err, fmt.Errorf("scraper %q: %w", key, scraperErr))
+			}
+		}
+		if overrides.CollectionInterval < 0 {
+			err = multierr.Append(err, fmt.Errorf("scraper %q: %s must not be negative", key, collectionIntervalKey))
+		}
+		if _, policyErr := newErrorPolicy(overrides.MuteErrors, overrides.DebugErrors); policyErr != nil {
+			err = multierr.Append(err, fmt.Errorf("scraper %q: %w", key, policyErr))
+		}
+	}
 	return err
 }
 
+// scraperCollectionInterval returns the collection interval of a scraper.
+func (cfg *Config) scraperCollectionInterval(key string) time.Duration {
+	if interval := cfg.scraperOverrides[key].CollectionInterval; interval > 0 {
+		return interval
+	}
+	return cfg.CollectionInterval
+}
+
 // Unmarshal a config.Parser into the config struct.
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
@@ -72,13 +159,41 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 		if err != nil {
 			return err
 		}
+
+		// the root_path and collection_interval of a scraper override those of the receiver, and its error policy is
+		// applied by the receiver
+		scraperMap := collectorViperSection.ToStringMap()
+		overridesMap := map[string]any{}
+		for _, overrideKey := range []string{rootPathKey, collectionIntervalKey, muteErrorsKey, debugErrorsKey} {
+			if value, ok := scraperMap[overrideKey]; ok {
+				overridesMap[overrideKey] = value
+				delete(scraperMap, overrideKey)
+			}
+		}
+		var overrides scraperOverrides
+		if len(overridesMap) > 0 {
+			if err = confmap.NewFromStringMap(overridesMap).Unmarshal(&overrides); err != nil {
+				return fmt.Errorf("error reading settings for scraper type %q: %w", key, err)
+			}
+			if cfg.scraperOverrides == nil {
+				cfg.scraperOverrides = map[string]scraperOverrides{}
+			}
+			cfg.scraperOverrides[key] = overrides
+			collectorViperSection = confmap.NewFromStringMap(scraperMap)
+		}
+
+		rootPath := cfg.RootPath
+		if overrides.RootPath != nil {
+			rootPath = *overrides.RootPath
+		}
+
 		err = collectorViperSection.Unmarshal(collectorCfg)
 		if err != nil {
 			return fmt.Errorf("error reading settings for scraper type %q: %w", key, err)
 		}
 
-		collectorCfg.SetRootPath(cfg.RootPath)
-		envMap := setGoPsutilEnvVars(cfg.RootPath, &osEnv{})
+		collectorCfg.SetRootPath(rootPath)
+		envMap := setGoPsutilEnvVars(rootPath, &osEnv{})
 		collectorCfg.SetEnvMap(envMap)
 
 		cfg.Scrapers[key] = collectorCfg
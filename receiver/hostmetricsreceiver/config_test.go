@@ -21,12 +21,18 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/kernelscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemscraper"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -82,6 +88,22 @@ func TestLoadConfig(t *testing.T) {
 				cfg.SetEnvMap(common.EnvMap{})
 				return cfg
 			}(),
+			gpuscraper.TypeStr: func() internal.Config {
+				cfg := (&gpuscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*gpuscraper.Config).NvidiaSMIPath = "/usr/bin/nvidia-smi"
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			}(),
+			hwmonscraper.TypeStr: func() internal.Config {
+				cfg := (&hwmonscraper.Factory{}).CreateDefaultConfig()
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			}(),
+			kernelscraper.TypeStr: func() internal.Config {
+				cfg := (&kernelscraper.Factory{}).CreateDefaultConfig()
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			}(),
 			memoryscraper.TypeStr: func() internal.Config {
 				cfg := (&memoryscraper.Factory{}).CreateDefaultConfig()
 				cfg.SetEnvMap(common.EnvMap{})
@@ -106,6 +128,11 @@ func TestLoadConfig(t *testing.T) {
 				cfg.SetEnvMap(common.EnvMap{})
 				return cfg
 			}(),
+			pressurescraper.TypeStr: func() internal.Config {
+				cfg := (&pressurescraper.Factory{}).CreateDefaultConfig()
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			}(),
 			processscraper.TypeStr: (func() internal.Config {
 				cfg := (&processscraper.Factory{}).CreateDefaultConfig()
 				cfg.(*processscraper.Config).Include = processscraper.MatchConfig{
@@ -115,6 +142,20 @@ func TestLoadConfig(t *testing.T) {
 				cfg.SetEnvMap(common.EnvMap{})
 				return cfg
 			})(),
+			systemscraper.TypeStr: func() internal.Config {
+				cfg := (&systemscraper.Factory{}).CreateDefaultConfig()
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			}(),
+			systemdscraper.TypeStr: (func() internal.Config {
+				cfg := (&systemdscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*systemdscraper.Config).Exclude = systemdscraper.MatchConfig{
+					Units:  []string{"test4"},
+					Config: filterset.Config{MatchType: "strict"},
+				}
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			})(),
 		},
 	}
 
@@ -142,3 +183,89 @@ func TestLoadInvalidConfig_InvalidScraperKey(t *testing.T) {
 
 	require.Contains(t, err.Error(), "error reading configuration for \"hostmetrics\": invalid scraper key: invalidscraperkey")
 }
+
+func TestLoadConfig_ScraperCollectionInterval(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-scraper-collection-interval.yaml"), factories)
+	require.NoError(t, err)
+
+	r := cfg.Receivers[component.NewID(metadata.Type)].(*Config)
+	assert.Equal(t, 30*time.Second, r.CollectionInterval)
+	assert.Equal(t, 10*time.Second, r.scraperCollectionInterval(cpuscraper.TypeStr))
+	assert.Equal(t, 10*time.Second, r.scraperCollectionInterval(loadscraper.TypeStr))
+	assert.Equal(t, 30*time.Second, r.scraperCollectionInterval(memoryscraper.TypeStr))
+	assert.Equal(t, time.Minute, r.scraperCollectionInterval(diskscraper.TypeStr))
+
+	// the collection interval is not part of the scraper config
+	cpuScraperCfg := (&cpuscraper.Factory{}).CreateDefaultConfig()
+	cpuScraperCfg.SetEnvMap(common.EnvMap{})
+	assert.Equal(t, cpuScraperCfg, r.Scrapers[cpuscraper.TypeStr])
+}
+
+func TestLoadInvalidConfig_ScraperCollectionInterval(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	_, err = otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-bad-scraper-collection-interval.yaml"), factories)
+
+	require.ErrorContains(t, err, `scraper "disk": collection_interval must not be negative`)
+}
+
+func TestLoadConfig_Parallel(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-parallel.yaml"), factories)
+	require.NoError(t, err)
+
+	r := cfg.Receivers[component.NewID(metadata.Type)].(*Config)
+	assert.Equal(t, 4, r.MaxConcurrency)
+	assert.Equal(t, 5*time.Second, r.ScraperTimeout)
+	assert.Len(t, r.Scrapers, 4)
+}
+
+func TestLoadInvalidConfig_Parallel(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	_, err = otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-bad-parallel.yaml"), factories)
+
+	require.ErrorContains(t, err, "max_concurrency must not be negative")
+	require.ErrorContains(t, err, "scraper_timeout must not be negative")
+}
+
+func TestLoadConfig_ScraperErrors(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-scraper-errors.yaml"), factories)
+	require.NoError(t, err)
+
+	r := cfg.Receivers[component.NewID(metadata.Type)].(*Config)
+	assert.Equal(t, []string{"permission denied"}, r.scraperOverrides[processscraper.TypeStr].MuteErrors)
+	assert.Equal(t, []string{"no such file or directory"}, r.scraperOverrides[processscraper.TypeStr].DebugErrors)
+	assert.True(t, r.Scrapers[processscraper.TypeStr].(*processscraper.Config).MuteProcessNameError)
+}
+
+func TestLoadInvalidConfig_ScraperErrors(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	_, err = otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-bad-scraper-errors.yaml"), factories)
+
+	require.ErrorContains(t, err, `scraper "process": invalid debug_errors pattern`)
+}
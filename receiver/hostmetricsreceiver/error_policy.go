@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+)
+
+// errorSeparator separates the errors combined by multierr, and thus by scrapererror.ScrapeErrors.
+const errorSeparator = "; "
+
+// errorPolicy holds the patterns of the scrape errors of a scraper which are muted.
+type errorPolicy struct {
+	mute  []*regexp.Regexp
+	debug []*regexp.Regexp
+}
+
+func newErrorPolicy(mute, debug []string) (*errorPolicy, error) {
+	policy := &errorPolicy{}
+	var err error
+	if policy.mute, err = compilePatterns(mute); err != nil {
+		return nil, fmt.Errorf("invalid %s pattern: %w", muteErrorsKey, err)
+	}
+	if policy.debug, err = compilePatterns(debug); err != nil {
+		return nil, fmt.Errorf("invalid %s pattern: %w", debugErrorsKey, err)
+	}
+	return policy, nil
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	regexps := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+func matchAny(regexps []*regexp.Regexp, s string) bool {
+	for _, re := range regexps {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorPolicyScraper drops the scrape errors of a scraper matching its error policy, which would otherwise be logged
+// as errors by its scraper controller.
+type errorPolicyScraper struct {
+	scraperhelper.Scraper
+	policy *errorPolicy
+	logger *zap.Logger
+}
+
+func newErrorPolicyScraper(scraper scraperhelper.Scraper, policy *errorPolicy, logger *zap.Logger) *errorPolicyScraper {
+	return &errorPolicyScraper{Scraper: scraper, policy: policy, logger: logger}
+}
+
+func (s *errorPolicyScraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
+	md, err := s.Scraper.Scrape(ctx)
+	if err == nil {
+		return md, nil
+	}
+
+	// PartialScrapeError does not expose the errors it wraps, so the combined errors are told apart by their message
+	var remaining []string
+	for _, msg := range strings.Split(err.Error(), errorSeparator) {
+		switch {
+		case matchAny(s.policy.mute, msg):
+		case matchAny(s.policy.debug, msg):
+			s.logger.Debug("Error scraping metrics", zap.String("error", msg), zap.Stringer("scraper", s.ID()))
+		default:
+			remaining = append(remaining, msg)
+		}
+	}
+
+	switch {
+	case len(remaining) == 0:
+		return md, nil
+	case len(remaining) == strings.Count(err.Error(), errorSeparator)+1:
+		return md, err
+	}
+	remainingErr := errors.New(strings.Join(remaining, errorSeparator))
+	var partialErr scrapererror.PartialScrapeError
+	if errors.As(err, &partialErr) {
+		return md, scrapererror.NewPartialScrapeError(remainingErr, partialErr.Failed)
+	}
+	return md, remainingErr
+}
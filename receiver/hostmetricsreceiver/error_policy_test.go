@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestErrorPolicyScraper(t *testing.T) {
+	permissionErr := errors.New("error reading username for process \"sshd\" (pid 1234): open /proc/1234/status: permission denied")
+	missingErr := errors.New("error reading cgroup for process \"bash\" (pid 4321): open /proc/4321/cgroup: no such file or directory")
+	otherErr := errors.New("error reading process name for pid 1: err1")
+
+	tests := []struct {
+		name              string
+		err               error
+		expectedErr       string
+		expectedFailed    int
+		expectedDebugLogs int
+	}{
+		{
+			name: "No error",
+		},
+		{
+			name:              "All errors muted",
+			err:               scrapererror.NewPartialScrapeError(multierr.Combine(permissionErr, missingErr), 2),
+			expectedDebugLogs: 1,
+		},
+		{
+			name:              "Some errors muted",
+			err:               scrapererror.NewPartialScrapeError(multierr.Combine(permissionErr, otherErr, missingErr), 3),
+			expectedErr:       otherErr.Error(),
+			expectedFailed:    3,
+			expectedDebugLogs: 1,
+		},
+		{
+			name:        "No error muted",
+			err:         scrapererror.NewPartialScrapeError(otherErr, 1),
+			expectedErr: otherErr.Error(),
+			// the error of the scraper is returned as is
+			expectedFailed: 1,
+		},
+		{
+			name: "Error muted",
+			err:  permissionErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := newErrorPolicy([]string{"permission denied"}, []string{"no such file"})
+			require.NoError(t, err)
+			core, logs := observer.New(zapcore.DebugLevel)
+			scraper := newErrorPolicyScraper(newTestScraper(t, "process", test.err), policy, zap.New(core))
+
+			md, err := scraper.Scrape(context.Background())
+			assert.Equal(t, 1, md.DataPointCount())
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+				var partialErr scrapererror.PartialScrapeError
+				require.ErrorAs(t, err, &partialErr)
+				assert.Equal(t, test.expectedFailed, partialErr.Failed)
+			}
+			assert.Equal(t, test.expectedDebugLogs, logs.FilterMessage("Error scraping metrics").Len())
+		})
+	}
+}
+
+func TestNewErrorPolicy_InvalidPattern(t *testing.T) {
+	_, err := newErrorPolicy([]string{"permission denied"}, []string{"(unclosed"})
+	assert.ErrorContains(t, err, "invalid debug_errors pattern: error parsing regexp")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/shirou/gopsutil/v3/host"
 	"github.com/shirou/gopsutil/v3/process"
@@ -14,18 +15,25 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/kernelscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemscraper"
 )
 
 // This file implements Factory for HostMetrics receiver.
@@ -35,11 +43,17 @@ var (
 		diskscraper.TypeStr:       &diskscraper.Factory{},
 		loadscraper.TypeStr:       &loadscraper.Factory{},
 		filesystemscraper.TypeStr: &filesystemscraper.Factory{},
+		gpuscraper.TypeStr:        &gpuscraper.Factory{},
+		hwmonscraper.TypeStr:      &hwmonscraper.Factory{},
+		kernelscraper.TypeStr:     &kernelscraper.Factory{},
 		memoryscraper.TypeStr:     &memoryscraper.Factory{},
 		networkscraper.TypeStr:    &networkscraper.Factory{},
 		pagingscraper.TypeStr:     &pagingscraper.Factory{},
+		pressurescraper.TypeStr:   &pressurescraper.Factory{},
 		processesscraper.TypeStr:  &processesscraper.Factory{},
 		processscraper.TypeStr:    &processscraper.Factory{},
+		systemscraper.TypeStr:     &systemscraper.Factory{},
+		systemdscraper.TypeStr:    &systemdscraper.Factory{},
 	}
 )
 
@@ -73,44 +87,142 @@ func createMetricsReceiver(
 ) (receiver.Metrics, error) {
 	oCfg := cfg.(*Config)
 
-	addScraperOptions, err := createAddScraperOptions(ctx, set, oCfg, scraperFactories)
-	if err != nil {
-		return nil, err
+	// the scrapers overriding the collection interval of the receiver are run by a scraper controller of their own
+	scrapersByInterval := map[time.Duration]map[string]internal.Config{oCfg.CollectionInterval: {}}
+	for key, scraperCfg := range oCfg.Scrapers {
+		interval := oCfg.scraperCollectionInterval(key)
+		if scrapersByInterval[interval] == nil {
+			scrapersByInterval[interval] = map[string]internal.Config{}
+		}
+		scrapersByInterval[interval][key] = scraperCfg
+	}
+	if len(scrapersByInterval) > 1 && len(scrapersByInterval[oCfg.CollectionInterval]) == 0 {
+		delete(scrapersByInterval, oCfg.CollectionInterval)
+	}
+
+	controllers := make(scraperControllers, 0, len(scrapersByInterval)+2)
+
+	// the resource detector is started first, so that the attributes are detected by the time metrics are scraped
+	if oCfg.ResourceDetection.Enabled {
+		detector := newResourceDetector(consumer, set.Logger, setGoPsutilEnvVars(oCfg.RootPath, &osEnv{}))
+		controllers = append(controllers, detector)
+		consumer = detector
+	}
+
+	var trigger *triggerServer
+	if oCfg.Trigger != nil {
+		trigger = newTriggerServer(oCfg.Trigger, set.Logger, consumer)
+	}
+
+	for interval, scrapers := range scrapersByInterval {
+		addScraperOptions, err := createAddScraperOptions(ctx, set, oCfg, scrapers, scraperFactories, trigger)
+		if err != nil {
+			return nil, err
+		}
+
+		controllerCfg := oCfg.ControllerConfig
+		controllerCfg.CollectionInterval = interval
+		controller, err := scraperhelper.NewScraperControllerReceiver(
+			&controllerCfg,
+			set,
+			consumer,
+			addScraperOptions...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		controllers = append(controllers, controller)
+	}
+
+	if trigger != nil {
+		controllers = append(controllers, trigger)
 	}
 
 	host.EnableBootTimeCache(true)
 	process.EnableBootTimeCache(true)
 
-	return scraperhelper.NewScraperControllerReceiver(
-		&oCfg.ControllerConfig,
-		set,
-		consumer,
-		addScraperOptions...,
-	)
+	if len(controllers) == 1 {
+		return controllers[0], nil
+	}
+	return controllers, nil
+}
+
+// scraperControllers runs the scraper controllers of the scrapers with different collection intervals, the trigger
+// endpoint and the resource detector as one receiver.
+type scraperControllers []component.Component
+
+func (c scraperControllers) Start(ctx context.Context, h component.Host) error {
+	for _, controller := range c {
+		if err := controller.Start(ctx, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c scraperControllers) Shutdown(ctx context.Context) error {
+	var err error
+	for i := len(c) - 1; i >= 0; i-- {
+		err = multierr.Append(err, c[i].Shutdown(ctx))
+	}
+	return err
 }
 
 func createAddScraperOptions(
 	ctx context.Context,
 	set receiver.CreateSettings,
-	config *Config,
+	oCfg *Config,
+	scrapers map[string]internal.Config,
 	factories map[string]internal.ScraperFactory,
+	trigger *triggerServer,
 ) ([]scraperhelper.ScraperControllerOption, error) {
-	scraperControllerOptions := make([]scraperhelper.ScraperControllerOption, 0, len(config.Scrapers))
+	hostMetricsScrapers := make([]scraperhelper.Scraper, 0, len(scrapers))
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
 
-	for key, cfg := range config.Scrapers {
+	for key, cfg := range scrapers {
 		hostMetricsScraper, ok, err := createHostMetricsScraper(ctx, set, key, cfg, factories)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create scraper for key %q: %w", key, err)
 		}
 
 		if ok {
-			scraperControllerOptions = append(scraperControllerOptions, scraperhelper.AddScraper(hostMetricsScraper))
+			if overrides := oCfg.scraperOverrides[key]; len(overrides.MuteErrors) > 0 || len(overrides.DebugErrors) > 0 {
+				policy, policyErr := newErrorPolicy(overrides.MuteErrors, overrides.DebugErrors)
+				if policyErr != nil {
+					return nil, fmt.Errorf("failed to create scraper for key %q: %w", key, policyErr)
+				}
+				hostMetricsScraper = newErrorPolicyScraper(hostMetricsScraper, policy, set.Logger)
+			}
+			if trigger != nil {
+				hostMetricsScraper = trigger.addScraper(key, hostMetricsScraper)
+			}
+			if oCfg.ScraperTimeout > 0 {
+				hostMetricsScraper = newTimeoutScraper(hostMetricsScraper, oCfg.ScraperTimeout)
+			}
+			hostMetricsScraper = newTelemetryScraper(hostMetricsScraper, key, telemetryBuilder)
+			hostMetricsScrapers = append(hostMetricsScrapers, hostMetricsScraper)
 			continue
 		}
 
 		return nil, fmt.Errorf("host metrics scraper factory not found for key: %q", key)
 	}
 
+	// the scrapers are run concurrently by a single scraper, as the scraper controller runs its scrapers sequentially
+	if oCfg.MaxConcurrency > 1 && len(hostMetricsScrapers) > 1 {
+		parallelScraper, err := newParallelScraper(hostMetricsScrapers, oCfg.MaxConcurrency)
+		if err != nil {
+			return nil, err
+		}
+		hostMetricsScrapers = []scraperhelper.Scraper{parallelScraper}
+	}
+
+	scraperControllerOptions := make([]scraperhelper.ScraperControllerOption, 0, len(hostMetricsScrapers))
+	for _, hostMetricsScraper := range hostMetricsScrapers {
+		scraperControllerOptions = append(scraperControllerOptions, scraperhelper.AddScraper(hostMetricsScraper))
+	}
 	return scraperControllerOptions, nil
 }
 
@@ -44,6 +44,20 @@ func validateRootPath(rootPath string) error {
 	return nil
 }
 
+// validateScraperRootPath validates the root_path of a scraper. Unlike the root_path of the receiver, it does not
+// have to be consistent across the receivers, as it is only passed down to the scraper through its EnvMap.
+func validateScraperRootPath(rootPath string) error {
+	if rootPath == "" || rootPath == "/" {
+		return nil
+	}
+
+	if _, err := os.Stat(rootPath); err != nil {
+		return fmt.Errorf("invalid root_path: %w", err)
+	}
+
+	return nil
+}
+
 func setGoPsutilEnvVars(rootPath string, env environment) common.EnvMap {
 	m := common.EnvMap{}
 	if rootPath == "" || rootPath == "/" {
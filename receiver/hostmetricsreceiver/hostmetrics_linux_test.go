@@ -18,6 +18,8 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 )
 
 func TestConsistentRootPaths(t *testing.T) {
@@ -68,6 +70,55 @@ func TestLoadInvalidConfig_RootPathNotExist(t *testing.T) {
 	globalRootPath = ""
 }
 
+func TestLoadConfigScraperRootPath(t *testing.T) {
+	t.Setenv("HOST_PROC", "testdata")
+	factories, _ := otelcoltest.NopFactories()
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-scraper-root-path.yaml"), factories)
+	require.NoError(t, err)
+	globalRootPath = ""
+
+	r := cfg.Receivers[component.NewID(metadata.Type)].(*Config)
+	assert.Equal(t, "testdata", r.RootPath)
+
+	cpuScraperCfg := (&cpuscraper.Factory{}).CreateDefaultConfig()
+	cpuScraperCfg.SetRootPath("testdata")
+	cpuScraperCfg.SetEnvMap(common.EnvMap{
+		common.HostDevEnvKey: "testdata/dev",
+		common.HostEtcEnvKey: "testdata/etc",
+		common.HostRunEnvKey: "testdata/run",
+		common.HostSysEnvKey: "testdata/sys",
+		common.HostVarEnvKey: "testdata/var",
+	})
+	assert.Equal(t, cpuScraperCfg, r.Scrapers[cpuscraper.TypeStr])
+
+	memoryScraperCfg := (&memoryscraper.Factory{}).CreateDefaultConfig()
+	memoryScraperCfg.SetRootPath("/")
+	memoryScraperCfg.SetEnvMap(common.EnvMap{})
+	assert.Equal(t, memoryScraperCfg, r.Scrapers[memoryscraper.TypeStr])
+
+	processesScraperCfg := (&processesscraper.Factory{}).CreateDefaultConfig()
+	processesScraperCfg.SetRootPath("testdata/e2e")
+	processesScraperCfg.SetEnvMap(common.EnvMap{
+		common.HostDevEnvKey: "testdata/e2e/dev",
+		common.HostEtcEnvKey: "testdata/e2e/etc",
+		common.HostRunEnvKey: "testdata/e2e/run",
+		common.HostSysEnvKey: "testdata/e2e/sys",
+		common.HostVarEnvKey: "testdata/e2e/var",
+	})
+	assert.Equal(t, processesScraperCfg, r.Scrapers[processesscraper.TypeStr])
+}
+
+func TestLoadInvalidConfig_ScraperRootPathNotExist(t *testing.T) {
+	factories, _ := otelcoltest.NopFactories()
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	_, err := otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-bad-scraper-root-path.yaml"), factories)
+	assert.ErrorContains(t, err, `scraper "processes": invalid root_path:`)
+	globalRootPath = ""
+}
+
 func testValidate(rootPath string) error {
 	err := validateRootPath(rootPath)
 	globalRootPath = ""
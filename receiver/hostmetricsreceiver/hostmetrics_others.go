@@ -18,6 +18,10 @@ func validateRootPath(rootPath string) error {
 	return fmt.Errorf("root_path is supported on linux only")
 }
 
+func validateScraperRootPath(rootPath string) error {
+	return validateRootPath(rootPath)
+}
+
 func setGoPsutilEnvVars(_ string, _ environment) common.EnvMap {
 	return common.EnvMap{}
 }
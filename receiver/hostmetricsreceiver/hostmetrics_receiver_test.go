@@ -28,12 +28,18 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/kernelscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemscraper"
 )
 
 var armMetrics = []string{
@@ -74,18 +80,25 @@ var systemSpecificMetrics = map[string][]string{
 	"freebsd": {"system.filesystem.inodes.usage", "system.paging.faults", "system.processes.count"},
 	"openbsd": {"system.filesystem.inodes.usage", "system.paging.faults", "system.processes.created", "system.processes.count"},
 	"solaris": {"system.filesystem.inodes.usage", "system.paging.faults"},
+	"aix":     {"system.filesystem.inodes.usage"},
 }
 
 var factories = map[string]internal.ScraperFactory{
 	cpuscraper.TypeStr:        &cpuscraper.Factory{},
 	diskscraper.TypeStr:       &diskscraper.Factory{},
 	filesystemscraper.TypeStr: &filesystemscraper.Factory{},
+	gpuscraper.TypeStr:        &gpuscraper.Factory{},
+	hwmonscraper.TypeStr:      &hwmonscraper.Factory{},
+	kernelscraper.TypeStr:     &kernelscraper.Factory{},
 	loadscraper.TypeStr:       &loadscraper.Factory{},
 	memoryscraper.TypeStr:     &memoryscraper.Factory{},
 	networkscraper.TypeStr:    &networkscraper.Factory{},
 	pagingscraper.TypeStr:     &pagingscraper.Factory{},
+	pressurescraper.TypeStr:   &pressurescraper.Factory{},
 	processesscraper.TypeStr:  &processesscraper.Factory{},
 	processscraper.TypeStr:    &processscraper.Factory{},
+	systemscraper.TypeStr:     &systemscraper.Factory{},
+	systemdscraper.TypeStr:    &systemdscraper.Factory{},
 }
 
 type testEnv struct {
@@ -128,6 +141,9 @@ func TestGatherMetrics_EndToEnd(t *testing.T) {
 	if runtime.GOOS == "linux" || runtime.GOOS == "windows" {
 		cfg.Scrapers[processscraper.TypeStr] = scraperFactories[processscraper.TypeStr].CreateDefaultConfig()
 	}
+	if runtime.GOOS == "aix" {
+		delete(cfg.Scrapers, diskscraper.TypeStr)
+	}
 
 	receiver, err := NewFactory().CreateMetricsReceiver(context.Background(), creationSet, cfg, sink)
 
@@ -266,6 +282,41 @@ func TestGatherMetrics_CreateMetricsScraperError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestGatherMetrics_ScraperCollectionInterval(t *testing.T) {
+	mFactory := &mockFactory{}
+	mFactory.On("CreateMetricsScraper").Return(&mockScraper{}, nil)
+	tmp := scraperFactories
+	scraperFactories = map[string]internal.ScraperFactory{mockTypeStr: mFactory, "mock2": mFactory, "mock3": mFactory}
+	defer func() {
+		scraperFactories = tmp
+	}()
+
+	sink := new(consumertest.MetricsSink)
+	cfg := &Config{
+		ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+		Scrapers:         map[string]internal.Config{mockTypeStr: &mockConfig{}, "mock2": &mockConfig{}, "mock3": &mockConfig{}},
+		scraperOverrides: map[string]scraperOverrides{
+			"mock2": {CollectionInterval: 10 * time.Second},
+			"mock3": {CollectionInterval: 10 * time.Second},
+		},
+	}
+	r, err := NewFactory().CreateMetricsReceiver(context.Background(), creationSet, cfg, sink)
+	require.NoError(t, err)
+	require.IsType(t, scraperControllers{}, r)
+	assert.Len(t, r, 2)
+	mFactory.AssertNumberOfCalls(t, "CreateMetricsScraper", 3)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	// the receiver runs a single scraper controller when all the scrapers override the collection interval
+	cfg.scraperOverrides[mockTypeStr] = scraperOverrides{CollectionInterval: 10 * time.Second}
+	r, err = NewFactory().CreateMetricsReceiver(context.Background(), creationSet, cfg, sink)
+	require.NoError(t, err)
+	_, ok := r.(scraperControllers)
+	assert.False(t, ok)
+}
+
 type notifyingSink struct {
 	receivedMetrics bool
 	timesCalled     int
@@ -292,7 +343,7 @@ func benchmarkScrapeMetrics(b *testing.B, cfg *Config) {
 	sink := &notifyingSink{ch: make(chan int, 10)}
 	tickerCh := make(chan time.Time)
 
-	options, err := createAddScraperOptions(context.Background(), receivertest.NewNopCreateSettings(), cfg, scraperFactories)
+	options, err := createAddScraperOptions(context.Background(), receivertest.NewNopCreateSettings(), cfg, cfg.Scrapers, scraperFactories, nil)
 	require.NoError(b, err)
 	options = append(options, scraperhelper.WithTickerChannel(tickerCh))
 
@@ -0,0 +1,264 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroup // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// userHZ is the frequency of the ticks of the cpuacct.stat file of cgroup v1, which is 100 on all the architectures
+// supported by Linux.
+const userHZ = 100
+
+// unlimitedV1 is the lowest value considered unlimited in the memory.limit_in_bytes file of cgroup v1, which reports
+// no limit as the largest multiple of the page size.
+const unlimitedV1 = 1 << 62
+
+// Memory holds the memory usage and limit of a cgroup.
+type Memory struct {
+	// Usage is the memory used by the cgroup, in bytes, excluding the inactive page cache which the kernel reclaims
+	// before reaching the limit, as the working set reported by container runtimes.
+	Usage uint64
+	// Limit is the memory limit of the cgroup, in bytes, or 0 if the cgroup has no limit.
+	Limit uint64
+}
+
+// CPU holds the CPU time and limit of a cgroup.
+type CPU struct {
+	// User and System are the CPU times of the cgroup in user and system mode, in seconds.
+	User   float64
+	System float64
+	// Limit is the number of CPUs the cgroup is allowed to use per unit of time, or 0 if the cgroup has no limit.
+	Limit float64
+}
+
+// Reader reads the cgroup of the collector from the given procfs and sysfs mount points.
+type Reader struct {
+	ProcPath string
+	SysPath  string
+}
+
+// NewReader returns a Reader of the cgroup of the collector, as seen from its own mount namespace.
+func NewReader() *Reader {
+	return &Reader{ProcPath: "/proc", SysPath: "/sys"}
+}
+
+// ReadMemory reads the memory usage and limit of the cgroup of the collector.
+func (r *Reader) ReadMemory() (*Memory, error) {
+	dir, v2, err := r.dir("memory")
+	if err != nil {
+		return nil, err
+	}
+
+	usageFile, limitFile, inactiveFileKey := "memory.usage_in_bytes", "memory.limit_in_bytes", "total_inactive_file"
+	if v2 {
+		usageFile, limitFile, inactiveFileKey = "memory.current", "memory.max", "inactive_file"
+	}
+	usage, err := readUint(filepath.Join(dir, usageFile))
+	if err != nil {
+		return nil, err
+	}
+	// memory.max is missing in the root cgroup, which has no limit
+	limit, err := readUint(filepath.Join(dir, limitFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if limit >= unlimitedV1 {
+		limit = 0
+	}
+	stat, err := readKeyedUints(filepath.Join(dir, "memory.stat"))
+	if err != nil {
+		return nil, err
+	}
+	if inactiveFile := stat[inactiveFileKey]; inactiveFile < usage {
+		usage -= inactiveFile
+	}
+	return &Memory{Usage: usage, Limit: limit}, nil
+}
+
+// ReadCPU reads the CPU time and limit of the cgroup of the collector.
+func (r *Reader) ReadCPU() (*CPU, error) {
+	if r.unified() {
+		return r.readCPUV2()
+	}
+	return r.readCPUV1()
+}
+
+func (r *Reader) readCPUV2() (*CPU, error) {
+	dir, _, err := r.dir("cpu")
+	if err != nil {
+		return nil, err
+	}
+	stat, err := readKeyedUints(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return nil, err
+	}
+	cpu := &CPU{User: float64(stat["user_usec"]) / 1e6, System: float64(stat["system_usec"]) / 1e6}
+
+	// cpu.max is missing in the root cgroup, which has no limit
+	data, err := os.ReadFile(filepath.Join(dir, "cpu.max"))
+	if errors.Is(err, os.ErrNotExist) {
+		return cpu, nil
+	} else if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("unexpected content of cpu.max: %q", data)
+	}
+	if fields[0] == "max" {
+		return cpu, nil
+	}
+	quota, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quota in cpu.max: %w", err)
+	}
+	period, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil || period <= 0 {
+		return nil, fmt.Errorf("invalid period in cpu.max: %q", fields[1])
+	}
+	cpu.Limit = quota / period
+	return cpu, nil
+}
+
+func (r *Reader) readCPUV1() (*CPU, error) {
+	acctDir, _, err := r.dir("cpuacct")
+	if err != nil {
+		return nil, err
+	}
+	stat, err := readKeyedUints(filepath.Join(acctDir, "cpuacct.stat"))
+	if err != nil {
+		return nil, err
+	}
+	cpu := &CPU{User: float64(stat["user"]) / userHZ, System: float64(stat["system"]) / userHZ}
+
+	dir, _, err := r.dir("cpu")
+	if err != nil {
+		return nil, err
+	}
+	quota, err := readInt(filepath.Join(dir, "cpu.cfs_quota_us"))
+	if err != nil {
+		return nil, err
+	}
+	// a quota of -1 means no limit
+	if quota <= 0 {
+		return cpu, nil
+	}
+	period, err := readInt(filepath.Join(dir, "cpu.cfs_period_us"))
+	if err != nil {
+		return nil, err
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("invalid cpu.cfs_period_us: %d", period)
+	}
+	cpu.Limit = float64(quota) / float64(period)
+	return cpu, nil
+}
+
+// dir returns the directory of the cgroup of the collector for the given controller, and whether it is part of the
+// unified cgroup v2 hierarchy.
+func (r *Reader) dir(controller string) (string, bool, error) {
+	root := filepath.Join(r.SysPath, "fs", "cgroup")
+	v2 := r.unified()
+	if !v2 {
+		root = filepath.Join(root, controller)
+	}
+
+	path, err := r.path(controller, v2)
+	if err != nil {
+		return "", false, err
+	}
+	// the cgroup of a container is usually mounted as the root of the hierarchy, while /proc/self/cgroup still
+	// reports its path in the hierarchy of the host unless the container has its own cgroup namespace
+	if dir := filepath.Join(root, path); path != "/" {
+		if _, err := os.Stat(dir); err == nil {
+			return dir, v2, nil
+		}
+	}
+	return root, v2, nil
+}
+
+// unified returns whether the unified cgroup v2 hierarchy is mounted, rather than the hierarchies of cgroup v1.
+func (r *Reader) unified() bool {
+	_, err := os.Stat(filepath.Join(r.SysPath, "fs", "cgroup", "cgroup.controllers"))
+	return err == nil
+}
+
+// path returns the path of the cgroup of the collector in the hierarchy of the given controller, as read from
+// /proc/self/cgroup, whose lines are formatted as `hierarchy-ID:controller-list:cgroup-path`.
+func (r *Reader) path(controller string, v2 bool) (string, error) {
+	data, err := os.ReadFile(filepath.Join(r.ProcPath, "self", "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if v2 {
+			if fields[0] == "0" && fields[1] == "" {
+				return fields[2], nil
+			}
+			continue
+		}
+		for _, c := range strings.Split(fields[1], ",") {
+			if c == controller {
+				return fields[2], nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no cgroup found for the %s controller", controller)
+}
+
+func readUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value := strings.TrimSpace(string(data))
+	// the limits of cgroup v2 are set to max when unlimited
+	if value == "max" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+func readInt(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// readKeyedUints reads a file of `key value` lines, such as memory.stat and cpu.stat.
+func readKeyedUints(path string) (map[string]uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s in %s: %w", fields[0], path, err)
+		}
+		values[fields[0]] = value
+	}
+	return values, nil
+}
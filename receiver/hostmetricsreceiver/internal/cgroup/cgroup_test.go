@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+}
+
+func newTestReader(t *testing.T, selfCgroup string) (*Reader, string) {
+	root := t.TempDir()
+	reader := &Reader{ProcPath: filepath.Join(root, "proc"), SysPath: filepath.Join(root, "sys")}
+	writeFiles(t, filepath.Join(reader.ProcPath, "self"), map[string]string{"cgroup": selfCgroup})
+	return reader, filepath.Join(reader.SysPath, "fs", "cgroup")
+}
+
+func TestReadV2(t *testing.T) {
+	reader, cgroupRoot := newTestReader(t, "0::/kubepods/pod1/collector\n")
+	writeFiles(t, cgroupRoot, map[string]string{"cgroup.controllers": "cpu memory\n"})
+	writeFiles(t, filepath.Join(cgroupRoot, "kubepods", "pod1", "collector"), map[string]string{
+		"memory.current": "104857600\n",
+		"memory.max":     "268435456\n",
+		"memory.stat":    "anon 73400320\nfile 31457280\ninactive_file 20971520\n",
+		"cpu.stat":       "usage_usec 3500000\nuser_usec 2500000\nsystem_usec 1000000\n",
+		"cpu.max":        "50000 100000\n",
+	})
+
+	memory, err := reader.ReadMemory()
+	require.NoError(t, err)
+	assert.Equal(t, &Memory{Usage: 83886080, Limit: 268435456}, memory)
+
+	cpu, err := reader.ReadCPU()
+	require.NoError(t, err)
+	assert.Equal(t, &CPU{User: 2.5, System: 1, Limit: 0.5}, cpu)
+
+	// no limit
+	writeFiles(t, filepath.Join(cgroupRoot, "kubepods", "pod1", "collector"), map[string]string{
+		"memory.max": "max\n",
+		"cpu.max":    "max 100000\n",
+	})
+	memory, err = reader.ReadMemory()
+	require.NoError(t, err)
+	assert.Zero(t, memory.Limit)
+	cpu, err = reader.ReadCPU()
+	require.NoError(t, err)
+	assert.Zero(t, cpu.Limit)
+}
+
+func TestReadV2_CgroupNamespace(t *testing.T) {
+	// the cgroup of the container is the root of the hierarchy mounted in the container
+	reader, cgroupRoot := newTestReader(t, "0::/\n")
+	writeFiles(t, cgroupRoot, map[string]string{
+		"cgroup.controllers": "cpu memory\n",
+		"memory.current":     "1000\n",
+		"memory.max":         "4000\n",
+		"memory.stat":        "inactive_file 0\n",
+	})
+
+	memory, err := reader.ReadMemory()
+	require.NoError(t, err)
+	assert.Equal(t, &Memory{Usage: 1000, Limit: 4000}, memory)
+}
+
+func TestReadV1(t *testing.T) {
+	reader, cgroupRoot := newTestReader(t, "12:memory:/docker/abc\n4:cpu,cpuacct:/docker/abc\n1:name=systemd:/docker/abc\n")
+	// the cgroup of the container is mounted as the root of each hierarchy
+	writeFiles(t, filepath.Join(cgroupRoot, "memory"), map[string]string{
+		"memory.usage_in_bytes": "104857600\n",
+		"memory.limit_in_bytes": "9223372036854771712\n",
+		"memory.stat":           "cache 31457280\ntotal_inactive_file 20971520\n",
+	})
+	writeFiles(t, filepath.Join(cgroupRoot, "cpu"), map[string]string{
+		"cpu.cfs_quota_us":  "150000\n",
+		"cpu.cfs_period_us": "100000\n",
+	})
+	writeFiles(t, filepath.Join(cgroupRoot, "cpuacct"), map[string]string{
+		"cpuacct.stat": "user 250\nsystem 100\n",
+	})
+
+	memory, err := reader.ReadMemory()
+	require.NoError(t, err)
+	assert.Equal(t, &Memory{Usage: 83886080}, memory)
+
+	cpu, err := reader.ReadCPU()
+	require.NoError(t, err)
+	assert.Equal(t, &CPU{User: 2.5, System: 1, Limit: 1.5}, cpu)
+
+	writeFiles(t, filepath.Join(cgroupRoot, "cpu"), map[string]string{"cpu.cfs_quota_us": "-1\n"})
+	cpu, err = reader.ReadCPU()
+	require.NoError(t, err)
+	assert.Zero(t, cpu.Limit)
+}
+
+func TestRead_Errors(t *testing.T) {
+	reader, cgroupRoot := newTestReader(t, "1:name=systemd:/\n")
+	writeFiles(t, filepath.Join(cgroupRoot, "memory"), map[string]string{})
+
+	_, err := reader.ReadMemory()
+	assert.EqualError(t, err, "no cgroup found for the memory controller")
+
+	reader, cgroupRoot = newTestReader(t, "0::/\n")
+	writeFiles(t, cgroupRoot, map[string]string{
+		"cgroup.controllers": "cpu memory\n",
+		"cpu.stat":           "user_usec 1\nsystem_usec 1\n",
+		"cpu.max":            "garbage\n",
+	})
+	_, err = reader.ReadCPU()
+	assert.EqualError(t, err, `unexpected content of cpu.max: "garbage\n"`)
+}
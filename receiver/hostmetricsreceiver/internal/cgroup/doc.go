@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cgroup reads the resource usage and limits of the cgroup of the
+// collector, from the cgroup v1 or v2 hierarchies of Linux, so that the
+// utilization of the resources of a container can be computed against its
+// limits rather than the totals of the host.
+package cgroup // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
@@ -3,6 +3,8 @@
 package metadata
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
@@ -15,3 +17,51 @@ func Meter(settings component.TelemetrySettings) metric.Meter {
 func Tracer(settings component.TelemetrySettings) trace.Tracer {
 	return settings.TracerProvider.Tracer("otelcol/hostmetricsreceiver")
 }
+
+// TelemetryBuilder provides an interface for components to report telemetry
+// as defined in metadata and user config.
+type TelemetryBuilder struct {
+	HostmetricsScraperDataPoints metric.Int64Counter
+	HostmetricsScraperDuration   metric.Float64Histogram
+	HostmetricsScraperErrors     metric.Int64Counter
+	HostmetricsScraperFiltered   metric.Int64Counter
+}
+
+// telemetryBuilderOption applies changes to default builder.
+type telemetryBuilderOption func(*TelemetryBuilder)
+
+// NewTelemetryBuilder provides a struct with methods to update all internal telemetry
+// for a component
+func NewTelemetryBuilder(settings component.TelemetrySettings, options ...telemetryBuilderOption) (*TelemetryBuilder, error) {
+	builder := TelemetryBuilder{}
+	for _, op := range options {
+		op(&builder)
+	}
+	var err, errs error
+	meter := Meter(settings)
+	builder.HostmetricsScraperDataPoints, err = meter.Int64Counter(
+		"hostmetrics_scraper_data_points",
+		metric.WithDescription("Number of data points produced by a scraper"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
+	builder.HostmetricsScraperDuration, err = meter.Float64Histogram(
+		"hostmetrics_scraper_duration",
+		metric.WithDescription("Duration of the scrapes of a scraper"),
+		metric.WithUnit("s"),
+	)
+	errs = errors.Join(errs, err)
+	builder.HostmetricsScraperErrors, err = meter.Int64Counter(
+		"hostmetrics_scraper_errors",
+		metric.WithDescription("Number of scrapes of a scraper which returned an error"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
+	builder.HostmetricsScraperFiltered, err = meter.Int64Counter(
+		"hostmetrics_scraper_filtered",
+		metric.WithDescription("Number of devices, processes and other entities skipped by the filters of a scraper"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
+	return &builder, errs
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package psi reads the pressure stall information (PSI) of the CPU, I/O and
+// memory of Linux hosts from /proc/pressure, as reported by the pressure and
+// load scrapers.
+package psi // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/psi"
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package psi // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/psi"
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+)
+
+const (
+	// StallSome is the stall of the lines reporting the time some tasks were stalled.
+	StallSome = "some"
+	// StallFull is the stall of the lines reporting the time all the non-idle tasks were stalled at once.
+	StallFull = "full"
+)
+
+// Stat holds a line of a /proc/pressure file: the share of the time some or all the tasks were stalled waiting for a
+// resource over the last 10, 60 and 300 seconds, in percent, and the total time they were stalled.
+type Stat struct {
+	Stall  string
+	Avg10  float64
+	Avg60  float64
+	Avg300 float64
+	Total  time.Duration
+}
+
+// Read reads the pressure stall information of a resource, `cpu`, `io` or `memory`, from /proc/pressure. Its lines
+// look like `some avg10=0.12 avg60=0.05 avg300=0.01 total=123456`, the total being in microseconds. The `full` line of
+// the cpu resource is missing before Linux 5.13.
+func Read(ctx context.Context, resource string) ([]Stat, error) {
+	path := filepath.Join(internal.ProcPath(ctx), "pressure", resource)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var stats []Stat
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] != StallSome && fields[0] != StallFull {
+			return nil, fmt.Errorf("unexpected line in %s: %q", path, scanner.Text())
+		}
+		stat := Stat{Stall: fields[0]}
+		for _, field := range fields[1:] {
+			key, value, _ := strings.Cut(field, "=")
+			switch key {
+			case "avg10":
+				stat.Avg10, err = strconv.ParseFloat(value, 64)
+			case "avg60":
+				stat.Avg60, err = strconv.ParseFloat(value, 64)
+			case "avg300":
+				stat.Avg300, err = strconv.ParseFloat(value, 64)
+			case "total":
+				var usec uint64
+				usec, err = strconv.ParseUint(value, 10, 64)
+				stat.Total = time.Duration(usec) * time.Microsecond
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s in %s: %w", key, path, err)
+			}
+		}
+		stats = append(stats, stat)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package psi
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	procPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: procPath})
+
+	// kernel without PSI
+	_, err := Read(ctx, "cpu")
+	assert.Error(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "pressure"), 0o755))
+	writePressure := func(resource, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(procPath, "pressure", resource), []byte(content), 0o600))
+	}
+	writePressure("cpu", "some avg10=1.50 avg60=1.00 avg300=0.50 total=3000000\n")
+	writePressure("io", `some avg10=20.00 avg60=10.00 avg300=5.00 total=8000000
+full avg10=2.00 avg60=1.00 avg300=0.50 total=4000000
+`)
+	writePressure("memory", "some avg10=garbage avg60=0.00 avg300=0.00 total=0\n")
+	writePressure("irq", "partial avg10=0.00 avg60=0.00 avg300=0.00 total=0\n")
+
+	stats, err := Read(ctx, "cpu")
+	require.NoError(t, err)
+	assert.Equal(t, []Stat{{Stall: StallSome, Avg10: 1.5, Avg60: 1, Avg300: 0.5, Total: 3 * time.Second}}, stats)
+
+	stats, err = Read(ctx, "io")
+	require.NoError(t, err)
+	assert.Equal(t, []Stat{
+		{Stall: StallSome, Avg10: 20, Avg60: 10, Avg300: 5, Total: 8 * time.Second},
+		{Stall: StallFull, Avg10: 2, Avg60: 1, Avg300: 0.5, Total: 4 * time.Second},
+	}, stats)
+
+	_, err = Read(ctx, "memory")
+	assert.ErrorContains(t, err, "invalid avg10")
+
+	_, err = Read(ctx, "irq")
+	assert.ErrorContains(t, err, "unexpected line")
+}
@@ -39,3 +39,28 @@ func (p *ScraperConfig) SetRootPath(rootPath string) {
 func (p *ScraperConfig) SetEnvMap(envMap common.EnvMap) {
 	p.EnvMap = envMap
 }
+
+// ProcPath returns the procfs mount point, honoring the HOST_PROC environment variable, or the root_path of the
+// scraper passed down through the EnvMap of ctx.
+func ProcPath(ctx context.Context) string {
+	return hostPath(ctx, common.HostProcEnvKey, "/proc")
+}
+
+// SysPath returns the sysfs mount point, honoring the HOST_SYS environment variable, or the root_path of the scraper
+// passed down through the EnvMap of ctx.
+func SysPath(ctx context.Context) string {
+	return hostPath(ctx, common.HostSysEnvKey, "/sys")
+}
+
+// DevPath returns the devfs mount point, honoring the HOST_DEV environment variable, or the root_path of the scraper
+// passed down through the EnvMap of ctx.
+func DevPath(ctx context.Context) string {
+	return hostPath(ctx, common.HostDevEnvKey, "/dev")
+}
+
+func hostPath(ctx context.Context, key common.EnvKeyType, defaultPath string) string {
+	if env, ok := ctx.Value(common.EnvKey).(common.EnvMap); ok && env[key] != "" {
+		return env[key]
+	}
+	return defaultPath
+}
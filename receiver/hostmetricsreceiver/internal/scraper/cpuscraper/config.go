@@ -12,4 +12,15 @@ import (
 type Config struct {
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	internal.ScraperConfig
+
+	// Aggregation is the level at which the system.cpu.time and system.cpu.utilization metrics are reported: `cpu`,
+	// the default, reports them for each logical CPU, and `core` for each physical core, summing the times of the
+	// logical CPUs sharing a core. `core` is only supported on Linux.
+	Aggregation string `mapstructure:"aggregation"`
+
+	// UtilizationLimit is the capacity the system.cpu.utilization metric is computed against: `host`, the default,
+	// computes it for each logical CPU of the host, and `cgroup` for the cgroup of the collector, against its CPU
+	// quota, so that a collector running in a container reports the utilization of the container. `cgroup` is only
+	// supported on Linux.
+	UtilizationLimit string `mapstructure:"utilization_limit"`
 }
@@ -6,6 +6,7 @@ package cpuscraper // import "github.com/open-telemetry/opentelemetry-collector-
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/common"
@@ -17,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/ucal"
 )
@@ -24,6 +26,17 @@ import (
 const metricsLen = 2
 const hzInAMHz = 1_000_000
 
+const (
+	aggregationCPU  = "cpu"
+	aggregationCore = "core"
+
+	utilizationLimitHost   = "host"
+	utilizationLimitCgroup = "cgroup"
+
+	// cgroupCPU is the value of the cpu attribute of the utilization of the cgroup of the collector.
+	cgroupCPU = "cgroup"
+)
+
 // scraper for CPU Metrics
 type scraper struct {
 	settings receiver.CreateSettings
@@ -32,9 +45,19 @@ type scraper struct {
 	ucal     *ucal.CPUUtilizationCalculator
 
 	// for mocking
-	bootTime func(context.Context) (uint64, error)
-	times    func(context.Context, bool) ([]cpu.TimesStat, error)
-	now      func() time.Time
+	bootTime   func(context.Context) (uint64, error)
+	times      func(context.Context, bool) ([]cpu.TimesStat, error)
+	now        func() time.Time
+	idleStates func(context.Context) ([]idleState, error)
+	throttles  func(context.Context) (cores []throttleCount, packages []throttleCount, err error)
+	topology   func(context.Context) ([]cpuTopology, error)
+	cgroupCPU  func() (*cgroup.CPU, error)
+	interrupts func(context.Context) (count uint64, ok bool, err error)
+	softirqs   func(context.Context) ([]softirqCount, error)
+
+	// the CPU time of the cgroup of the collector at the previous scrape
+	previousCgroupCPU  *cgroup.CPU
+	previousCgroupTime pcommon.Timestamp
 }
 
 type cpuInfo struct {
@@ -42,9 +65,42 @@ type cpuInfo struct {
 	processor uint
 }
 
+// idleState holds the residency of a logical CPU in one of its idle states (C-states).
+type idleState struct {
+	cpu   string
+	name  string
+	time  float64 // seconds
+	usage uint64
+}
+
+// throttleCount holds the number of thermal throttling events of a logical CPU core or of a CPU package.
+type throttleCount struct {
+	id    string
+	count uint64
+}
+
+// softirqCount holds the number of software interrupts of a type serviced by a logical CPU.
+type softirqCount struct {
+	cpu   string
+	name  string
+	count uint64
+}
+
+// cpuTopology maps a logical CPU to its physical core and package (socket).
+type cpuTopology struct {
+	cpu  string
+	core string
+	pkg  string
+}
+
+// coreID identifies the physical core of a logical CPU across packages.
+func (t cpuTopology) coreID() string {
+	return "package" + t.pkg + "-core" + t.core
+}
+
 // newCPUScraper creates a set of CPU related metrics
 func newCPUScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
-	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, times: cpu.TimesWithContext, ucal: &ucal.CPUUtilizationCalculator{}, now: time.Now}
+	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, times: cpu.TimesWithContext, ucal: &ucal.CPUUtilizationCalculator{}, now: time.Now, idleStates: readIdleStates, throttles: readThrottleCounts, topology: readTopology, cgroupCPU: cgroup.NewReader().ReadCPU, interrupts: readInterrupts, softirqs: readSoftirqs}
 }
 
 func (s *scraper) start(ctx context.Context, _ component.Host) error {
@@ -65,11 +121,29 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
 
+	logicalCPUs := len(cpuTimes)
+	var topology []cpuTopology
+	if s.config.MetricsBuilderConfig.Metrics.SystemCPUTopology.Enabled || s.config.Aggregation == aggregationCore {
+		topology, err = s.topology(ctx)
+		if err != nil {
+			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+		}
+	}
+	if s.config.Aggregation == aggregationCore {
+		cpuTimes = aggregateByCore(cpuTimes, topology)
+	}
+
 	for _, cpuTime := range cpuTimes {
 		s.recordCPUTimeStateDataPoints(now, cpuTime)
 	}
 
-	err = s.ucal.CalculateAndRecord(now, cpuTimes, s.recordCPUUtilization)
+	if s.config.UtilizationLimit == utilizationLimitCgroup {
+		if s.config.MetricsBuilderConfig.Metrics.SystemCPUUtilization.Enabled {
+			err = s.recordCgroupCPUUtilization(now, logicalCPUs)
+		}
+	} else {
+		err = s.ucal.CalculateAndRecord(now, cpuTimes, s.recordCPUUtilization)
+	}
 	if err != nil {
 		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
@@ -91,7 +165,7 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	}
 
 	if s.config.MetricsBuilderConfig.Metrics.SystemCPUFrequency.Enabled {
-		cpuInfos, err := s.getCPUInfo()
+		cpuInfos, err := s.getCPUInfo(ctx)
 		if err != nil {
 			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
 		}
@@ -100,5 +174,120 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		}
 	}
 
+	if s.config.MetricsBuilderConfig.Metrics.SystemCPUIdleStateTime.Enabled || s.config.MetricsBuilderConfig.Metrics.SystemCPUIdleStateUsage.Enabled {
+		idleStates, err := s.idleStates(ctx)
+		if err != nil {
+			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+		}
+		for _, state := range idleStates {
+			s.mb.RecordSystemCPUIdleStateTimeDataPoint(now, state.time, state.cpu, state.name)
+			s.mb.RecordSystemCPUIdleStateUsageDataPoint(now, int64(state.usage), state.cpu, state.name)
+		}
+	}
+
+	if s.config.MetricsBuilderConfig.Metrics.SystemCPUThrottleCore.Enabled || s.config.MetricsBuilderConfig.Metrics.SystemCPUThrottlePackage.Enabled {
+		cores, packages, err := s.throttles(ctx)
+		if err != nil {
+			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+		}
+		for _, core := range cores {
+			s.mb.RecordSystemCPUThrottleCoreDataPoint(now, int64(core.count), core.id)
+		}
+		for _, pkg := range packages {
+			s.mb.RecordSystemCPUThrottlePackageDataPoint(now, int64(pkg.count), pkg.id)
+		}
+	}
+
+	if s.config.MetricsBuilderConfig.Metrics.SystemCPUInterrupts.Enabled {
+		count, ok, err := s.interrupts(ctx)
+		if err != nil {
+			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+		}
+		if ok {
+			s.mb.RecordSystemCPUInterruptsDataPoint(now, int64(count))
+		}
+	}
+
+	if s.config.MetricsBuilderConfig.Metrics.SystemCPUSoftirqs.Enabled {
+		softirqs, err := s.softirqs(ctx)
+		if err != nil {
+			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+		}
+		for _, softirq := range softirqs {
+			s.mb.RecordSystemCPUSoftirqsDataPoint(now, int64(softirq.count), softirq.cpu, softirq.name)
+		}
+	}
+
+	if s.config.MetricsBuilderConfig.Metrics.SystemCPUTopology.Enabled {
+		for _, t := range topology {
+			s.mb.RecordSystemCPUTopologyDataPoint(now, 1, t.cpu, t.core, t.pkg)
+		}
+	}
+
 	return s.mb.Emit(), nil
 }
+
+// recordCgroupCPUUtilization records the utilization of the cgroup of the collector since the previous scrape, against
+// its CPU quota, or against the logical CPUs of the host if it has none.
+func (s *scraper) recordCgroupCPUUtilization(now pcommon.Timestamp, logicalCPUs int) error {
+	current, err := s.cgroupCPU()
+	if err != nil {
+		return fmt.Errorf("failed to read the CPU time of the cgroup: %w", err)
+	}
+	previous, previousTime := s.previousCgroupCPU, s.previousCgroupTime
+	s.previousCgroupCPU, s.previousCgroupTime = current, now
+	if previous == nil || now <= previousTime {
+		return nil
+	}
+
+	limit := float64(logicalCPUs)
+	if current.Limit > 0 && current.Limit < limit {
+		limit = current.Limit
+	}
+	capacity := now.AsTime().Sub(previousTime.AsTime()).Seconds() * limit
+	if capacity <= 0 {
+		return nil
+	}
+	user := (current.User - previous.User) / capacity
+	system := (current.System - previous.System) / capacity
+	s.mb.RecordSystemCPUUtilizationDataPoint(now, user, cgroupCPU, metadata.AttributeStateUser)
+	s.mb.RecordSystemCPUUtilizationDataPoint(now, system, cgroupCPU, metadata.AttributeStateSystem)
+	s.mb.RecordSystemCPUUtilizationDataPoint(now, math.Max(0, 1-user-system), cgroupCPU, metadata.AttributeStateIdle)
+	return nil
+}
+
+// aggregateByCore sums the times of the logical CPUs sharing a physical core, in the order of the first logical CPU
+// of each core. The logical CPUs missing from the topology are left as is.
+func aggregateByCore(cpuTimes []cpu.TimesStat, topology []cpuTopology) []cpu.TimesStat {
+	coreIDs := make(map[string]string, len(topology))
+	for _, t := range topology {
+		coreIDs[t.cpu] = t.coreID()
+	}
+
+	coreTimes := make([]cpu.TimesStat, 0, len(cpuTimes))
+	indexes := make(map[string]int, len(cpuTimes))
+	for _, cpuTime := range cpuTimes {
+		id, ok := coreIDs[cpuTime.CPU]
+		if !ok {
+			id = cpuTime.CPU
+		}
+		i, ok := indexes[id]
+		if !ok {
+			indexes[id] = len(coreTimes)
+			coreTimes = append(coreTimes, cpu.TimesStat{CPU: id})
+			i = len(coreTimes) - 1
+		}
+		c := &coreTimes[i]
+		c.User += cpuTime.User
+		c.System += cpuTime.System
+		c.Idle += cpuTime.Idle
+		c.Nice += cpuTime.Nice
+		c.Iowait += cpuTime.Iowait
+		c.Irq += cpuTime.Irq
+		c.Softirq += cpuTime.Softirq
+		c.Steal += cpuTime.Steal
+		c.Guest += cpuTime.Guest
+		c.GuestNice += cpuTime.GuestNice
+	}
+	return coreTimes
+}
@@ -6,11 +6,21 @@
 package cpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 
 import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
 	"github.com/prometheus/procfs"
 	"github.com/shirou/gopsutil/v3/cpu"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/ucal"
 )
@@ -24,6 +34,8 @@ func (s *scraper) recordCPUTimeStateDataPoints(now pcommon.Timestamp, cpuTime cp
 	s.mb.RecordSystemCPUTimeDataPoint(now, cpuTime.Softirq, cpuTime.CPU, metadata.AttributeStateSoftirq)
 	s.mb.RecordSystemCPUTimeDataPoint(now, cpuTime.Steal, cpuTime.CPU, metadata.AttributeStateSteal)
 	s.mb.RecordSystemCPUTimeDataPoint(now, cpuTime.Iowait, cpuTime.CPU, metadata.AttributeStateWait)
+	s.mb.RecordSystemCPUTimeDataPoint(now, cpuTime.Guest, cpuTime.CPU, metadata.AttributeStateGuest)
+	s.mb.RecordSystemCPUTimeDataPoint(now, cpuTime.GuestNice, cpuTime.CPU, metadata.AttributeStateGuestNice)
 }
 
 func (s *scraper) recordCPUUtilization(now pcommon.Timestamp, cpuUtilization ucal.CPUUtilization) {
@@ -35,9 +47,14 @@ func (s *scraper) recordCPUUtilization(now pcommon.Timestamp, cpuUtilization uca
 	s.mb.RecordSystemCPUUtilizationDataPoint(now, cpuUtilization.Softirq, cpuUtilization.CPU, metadata.AttributeStateSoftirq)
 	s.mb.RecordSystemCPUUtilizationDataPoint(now, cpuUtilization.Steal, cpuUtilization.CPU, metadata.AttributeStateSteal)
 	s.mb.RecordSystemCPUUtilizationDataPoint(now, cpuUtilization.Iowait, cpuUtilization.CPU, metadata.AttributeStateWait)
+	s.mb.RecordSystemCPUUtilizationDataPoint(now, cpuUtilization.Guest, cpuUtilization.CPU, metadata.AttributeStateGuest)
+	s.mb.RecordSystemCPUUtilizationDataPoint(now, cpuUtilization.GuestNice, cpuUtilization.CPU, metadata.AttributeStateGuestNice)
 }
 
-func (s *scraper) getCPUInfo() ([]cpuInfo, error) {
+// getCPUInfo returns the current frequency of each logical CPU, in MHz. The frequency reported by the
+// cpufreq driver is preferred over the one of /proc/cpuinfo, which is missing on some architectures
+// such as arm64.
+func (s *scraper) getCPUInfo(ctx context.Context) ([]cpuInfo, error) {
 	var cpuInfos []cpuInfo
 	fs, err := procfs.NewDefaultFS()
 	if err != nil {
@@ -52,7 +69,202 @@ func (s *scraper) getCPUInfo() ([]cpuInfo, error) {
 			frequency: cInfo.CPUMHz,
 			processor: cInfo.Processor,
 		}
+		if kHz, ok := readScalingCurFreq(ctx, cInfo.Processor); ok {
+			c.frequency = kHz / 1e3
+		}
 		cpuInfos = append(cpuInfos, c)
 	}
 	return cpuInfos, nil
 }
+
+// readIdleStates reads the residency of each logical CPU in each of its idle states from the sysfs cpuidle
+// entries. Nothing is returned if no cpuidle driver is loaded, as in many virtual machines.
+func readIdleStates(ctx context.Context) ([]idleState, error) {
+	statePaths, err := filepath.Glob(filepath.Join(internal.SysPath(ctx), "devices", "system", "cpu", "cpu[0-9]*", "cpuidle", "state[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	idleStates := make([]idleState, 0, len(statePaths))
+	for _, statePath := range statePaths {
+		name, err := os.ReadFile(filepath.Join(statePath, "name"))
+		if err != nil {
+			return nil, err
+		}
+		// the time spent in the state is in microseconds
+		usec, err := readUint(filepath.Join(statePath, "time"))
+		if err != nil {
+			return nil, err
+		}
+		usage, err := readUint(filepath.Join(statePath, "usage"))
+		if err != nil {
+			return nil, err
+		}
+		idleStates = append(idleStates, idleState{
+			cpu:   filepath.Base(filepath.Dir(filepath.Dir(statePath))),
+			name:  strings.TrimSpace(string(name)),
+			time:  float64(usec) / 1e6,
+			usage: usage,
+		})
+	}
+	return idleStates, nil
+}
+
+// readThrottleCounts reads the number of thermal throttling events of each logical CPU core and of each
+// CPU package from the sysfs thermal_throttle entries. The package counter is exposed by every logical CPU
+// of the package, so it is reported once per physical_package_id. Nothing is returned if the entries are
+// missing, as on non-x86 architectures and in most virtual machines.
+func readThrottleCounts(ctx context.Context) (cores []throttleCount, packages []throttleCount, err error) {
+	cpuPaths, err := filepath.Glob(filepath.Join(internal.SysPath(ctx), "devices", "system", "cpu", "cpu[0-9]*"))
+	if err != nil {
+		return nil, nil, err
+	}
+	seenPackages := make(map[string]bool)
+	for _, cpuPath := range cpuPaths {
+		throttlePath := filepath.Join(cpuPath, "thermal_throttle")
+		if _, err := os.Stat(throttlePath); os.IsNotExist(err) {
+			continue
+		}
+		count, err := readUint(filepath.Join(throttlePath, "core_throttle_count"))
+		if err != nil {
+			return nil, nil, err
+		}
+		cores = append(cores, throttleCount{id: filepath.Base(cpuPath), count: count})
+
+		pkg, err := os.ReadFile(filepath.Join(cpuPath, "topology", "physical_package_id"))
+		if err != nil {
+			return nil, nil, err
+		}
+		pkgID := strings.TrimSpace(string(pkg))
+		if seenPackages[pkgID] {
+			continue
+		}
+		seenPackages[pkgID] = true
+		count, err = readUint(filepath.Join(throttlePath, "package_throttle_count"))
+		if err != nil {
+			return nil, nil, err
+		}
+		packages = append(packages, throttleCount{id: pkgID, count: count})
+	}
+	return cores, packages, nil
+}
+
+// readTopology reads the physical core and package of each logical CPU from the sysfs topology entries.
+func readTopology(ctx context.Context) ([]cpuTopology, error) {
+	cpuPaths, err := filepath.Glob(filepath.Join(internal.SysPath(ctx), "devices", "system", "cpu", "cpu[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	topology := make([]cpuTopology, 0, len(cpuPaths))
+	for _, cpuPath := range cpuPaths {
+		topologyPath := filepath.Join(cpuPath, "topology")
+		// offline CPUs have no topology
+		if _, err := os.Stat(topologyPath); os.IsNotExist(err) {
+			continue
+		}
+		core, err := os.ReadFile(filepath.Join(topologyPath, "core_id"))
+		if err != nil {
+			return nil, err
+		}
+		pkg, err := os.ReadFile(filepath.Join(topologyPath, "physical_package_id"))
+		if err != nil {
+			return nil, err
+		}
+		topology = append(topology, cpuTopology{
+			cpu:  filepath.Base(cpuPath),
+			core: strings.TrimSpace(string(core)),
+			pkg:  strings.TrimSpace(string(pkg)),
+		})
+	}
+	return topology, nil
+}
+
+// readInterrupts reads the number of hardware interrupts serviced since boot from the intr line of /proc/stat.
+func readInterrupts(ctx context.Context) (count uint64, ok bool, err error) {
+	f, err := os.Open(filepath.Join(internal.ProcPath(ctx), "stat"))
+	if err != nil {
+		return 0, false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	// the intr line holds a counter per interrupt number, and can be longer than the default buffer
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "intr" {
+			continue
+		}
+		count, err = strconv.ParseUint(fields[1], 10, 64)
+		return count, err == nil, err
+	}
+	if err = scanner.Err(); err != nil {
+		return 0, false, err
+	}
+	return 0, false, fmt.Errorf("no intr line in %s", f.Name())
+}
+
+// readSoftirqs reads the number of software interrupts of each type serviced by each logical CPU from /proc/softirqs.
+func readSoftirqs(ctx context.Context) ([]softirqCount, error) {
+	f, err := os.Open(filepath.Join(internal.ProcPath(ctx), "softirqs"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseSoftirqs(f)
+}
+
+// parseSoftirqs parses the content of /proc/softirqs: a header naming the logical CPUs, such as `CPU0 CPU1`, followed
+// by a line per type, such as `NET_RX: 1024 2048`. CPUs are named as in the cpu attribute of system.cpu.time, and
+// types are lowercased.
+func parseSoftirqs(r io.Reader) ([]softirqCount, error) {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("empty softirqs: %w", scanner.Err())
+	}
+	cpus := strings.Fields(scanner.Text())
+	for i, cpu := range cpus {
+		cpus[i] = strings.ToLower(cpu)
+	}
+
+	var softirqs []softirqCount
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != len(cpus)+1 || !strings.HasSuffix(fields[0], ":") {
+			return nil, fmt.Errorf("unexpected softirqs line %q", scanner.Text())
+		}
+		name := strings.ToLower(strings.TrimSuffix(fields[0], ":"))
+		for i, cpu := range cpus {
+			count, err := strconv.ParseUint(fields[i+1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s softirqs count: %w", name, err)
+			}
+			softirqs = append(softirqs, softirqCount{cpu: cpu, name: name, count: count})
+		}
+	}
+	return softirqs, scanner.Err()
+}
+
+func readUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// readScalingCurFreq reads the current frequency of a logical CPU, in kHz, as last set by its cpufreq
+// driver. ok is false if the CPU has no cpufreq driver, as in many virtual machines.
+func readScalingCurFreq(ctx context.Context, processor uint) (kHz float64, ok bool) {
+	data, err := os.ReadFile(filepath.Join(internal.SysPath(ctx), "devices", "system", "cpu", "cpu"+strconv.FormatUint(uint64(processor), 10), "cpufreq", "scaling_cur_freq"))
+	if err != nil {
+		return 0, false
+	}
+	kHz, err = strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
+	if err != nil {
+		return 0, false
+	}
+	return kHz, true
+}
@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package cpuscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadScalingCurFreq(t *testing.T) {
+	sysPath := t.TempDir()
+	writeFreq := func(cpu, value string) {
+		path := filepath.Join(sysPath, "devices", "system", "cpu", cpu, "cpufreq")
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "scaling_cur_freq"), []byte(value), 0o600))
+	}
+	writeFreq("cpu0", "2400000\n")
+	writeFreq("cpu1", "garbage\n")
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+
+	kHz, ok := readScalingCurFreq(ctx, 0)
+	assert.True(t, ok)
+	assert.Equal(t, 2400000.0, kHz)
+
+	_, ok = readScalingCurFreq(ctx, 1)
+	assert.False(t, ok)
+
+	// no cpufreq driver
+	_, ok = readScalingCurFreq(ctx, 2)
+	assert.False(t, ok)
+}
+
+func TestReadIdleStates(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+
+	// no cpuidle driver
+	idleStates, err := readIdleStates(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, idleStates)
+
+	writeState := func(cpu, state, name, time, usage string) {
+		path := filepath.Join(sysPath, "devices", "system", "cpu", cpu, "cpuidle", state)
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "name"), []byte(name+"\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "time"), []byte(time+"\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "usage"), []byte(usage+"\n"), 0o600))
+	}
+	writeState("cpu0", "state0", "POLL", "1500000", "42")
+	writeState("cpu0", "state1", "C6", "250000000", "1234")
+	writeState("cpu1", "state0", "POLL", "0", "0")
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "devices", "system", "cpu", "cpufreq"), 0o755))
+
+	idleStates, err = readIdleStates(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []idleState{
+		{cpu: "cpu0", name: "POLL", time: 1.5, usage: 42},
+		{cpu: "cpu0", name: "C6", time: 250, usage: 1234},
+		{cpu: "cpu1", name: "POLL"},
+	}, idleStates)
+
+	writeState("cpu1", "state1", "C1", "garbage", "1")
+	_, err = readIdleStates(ctx)
+	assert.Error(t, err)
+}
+
+func TestReadThrottleCounts(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+
+	// no thermal_throttle entries
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "devices", "system", "cpu", "cpu0"), 0o755))
+	cores, packages, err := readThrottleCounts(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, cores)
+	assert.Empty(t, packages)
+
+	writeThrottle := func(cpu, pkg, core, pkgCount string) {
+		path := filepath.Join(sysPath, "devices", "system", "cpu", cpu)
+		require.NoError(t, os.MkdirAll(filepath.Join(path, "thermal_throttle"), 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(path, "topology"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "topology", "physical_package_id"), []byte(pkg+"\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "thermal_throttle", "core_throttle_count"), []byte(core+"\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "thermal_throttle", "package_throttle_count"), []byte(pkgCount+"\n"), 0o600))
+	}
+	writeThrottle("cpu0", "0", "3", "7")
+	writeThrottle("cpu1", "0", "5", "7")
+	writeThrottle("cpu2", "1", "0", "2")
+
+	cores, packages, err = readThrottleCounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []throttleCount{{id: "cpu0", count: 3}, {id: "cpu1", count: 5}, {id: "cpu2"}}, cores)
+	assert.Equal(t, []throttleCount{{id: "0", count: 7}, {id: "1", count: 2}}, packages)
+
+	writeThrottle("cpu3", "1", "garbage", "2")
+	_, _, err = readThrottleCounts(ctx)
+	assert.Error(t, err)
+}
+
+func TestReadTopology(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+
+	writeTopology := func(cpu, pkg, core string) {
+		path := filepath.Join(sysPath, "devices", "system", "cpu", cpu, "topology")
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "physical_package_id"), []byte(pkg+"\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "core_id"), []byte(core+"\n"), 0o600))
+	}
+	writeTopology("cpu0", "0", "0")
+	writeTopology("cpu1", "1", "0")
+	// offline CPU
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "devices", "system", "cpu", "cpu2"), 0o755))
+
+	topology, err := readTopology(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []cpuTopology{{cpu: "cpu0", core: "0", pkg: "0"}, {cpu: "cpu1", core: "0", pkg: "1"}}, topology)
+	assert.NotEqual(t, topology[0].coreID(), topology[1].coreID())
+
+	require.NoError(t, os.Remove(filepath.Join(sysPath, "devices", "system", "cpu", "cpu1", "topology", "core_id")))
+	_, err = readTopology(ctx)
+	assert.Error(t, err)
+}
+
+func TestReadInterrupts(t *testing.T) {
+	procPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: procPath})
+
+	_, _, err := readInterrupts(ctx)
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, "stat"), []byte(`cpu  10 0 20 300 0 0 1 0 0 0
+cpu0 10 0 20 300 0 0 1 0 0 0
+intr 4357528 0 9 0 0 0 0 0 0 0 0 0 0 156 0
+ctxt 8412379
+softirq 1245061 0 347005 4 168 0 0 1 389241 0 508642
+`), 0o600))
+	count, ok, err := readInterrupts(ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(4357528), count)
+
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, "stat"), []byte("cpu  10 0 20 300 0 0 1 0 0 0\n"), 0o600))
+	_, _, err = readInterrupts(ctx)
+	assert.Error(t, err)
+}
+
+func TestParseSoftirqs(t *testing.T) {
+	softirqs, err := parseSoftirqs(strings.NewReader(`                    CPU0       CPU1
+          HI:          0          1
+       TIMER:     347005     351220
+      NET_TX:          4          7
+      NET_RX:        168      90210
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []softirqCount{
+		{cpu: "cpu0", name: "hi", count: 0},
+		{cpu: "cpu1", name: "hi", count: 1},
+		{cpu: "cpu0", name: "timer", count: 347005},
+		{cpu: "cpu1", name: "timer", count: 351220},
+		{cpu: "cpu0", name: "net_tx", count: 4},
+		{cpu: "cpu1", name: "net_tx", count: 7},
+		{cpu: "cpu0", name: "net_rx", count: 168},
+		{cpu: "cpu1", name: "net_rx", count: 90210},
+	}, softirqs)
+
+	_, err = parseSoftirqs(strings.NewReader(""))
+	assert.Error(t, err)
+	_, err = parseSoftirqs(strings.NewReader("CPU0 CPU1\nHI: 0\n"))
+	assert.Error(t, err)
+	_, err = parseSoftirqs(strings.NewReader("CPU0\nHI: many\n"))
+	assert.Error(t, err)
+}
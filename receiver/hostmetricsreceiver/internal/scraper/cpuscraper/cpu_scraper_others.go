@@ -6,6 +6,8 @@
 package cpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 
 import (
+	"context"
+
 	"github.com/shirou/gopsutil/v3/cpu"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
@@ -27,7 +29,27 @@ func (s *scraper) recordCPUUtilization(now pcommon.Timestamp, cpuUtilization uca
 	s.mb.RecordSystemCPUUtilizationDataPoint(now, cpuUtilization.Irq, cpuUtilization.CPU, metadata.AttributeStateInterrupt)
 }
 
-func (s *scraper) getCPUInfo() ([]cpuInfo, error) {
+func readIdleStates(context.Context) ([]idleState, error) {
+	return nil, nil
+}
+
+func readThrottleCounts(context.Context) ([]throttleCount, []throttleCount, error) {
+	return nil, nil, nil
+}
+
+func readTopology(context.Context) ([]cpuTopology, error) {
+	return nil, nil
+}
+
+func readInterrupts(context.Context) (uint64, bool, error) {
+	return 0, false, nil
+}
+
+func readSoftirqs(context.Context) ([]softirqCount, error) {
+	return nil, nil
+}
+
+func (s *scraper) getCPUInfo(context.Context) ([]cpuInfo, error) {
 	var cpuInfos []cpuInfo
 	return cpuInfos, nil
 }
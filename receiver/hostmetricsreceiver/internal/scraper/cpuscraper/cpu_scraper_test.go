@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/internal/metadata"
 )
 
@@ -307,7 +308,7 @@ func TestScrape_CpuUtilizationStandard(t *testing.T) {
 
 		expectedDataPoints := 8
 		if runtime.GOOS == "linux" {
-			expectedDataPoints = 16
+			expectedDataPoints = 20
 			assertCPUUtilizationMetricHasLinuxSpecificStateLabels(t, metric)
 		}
 		assert.Equal(t, expectedDataPoints, dp.Len())
@@ -323,6 +324,235 @@ func TestScrape_CpuUtilizationStandard(t *testing.T) {
 	}
 }
 
+func TestScrape_IdleStates(t *testing.T) {
+	metricsConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsConfig.Metrics.SystemCPUTime.Enabled = false
+	metricsConfig.Metrics.SystemCPUIdleStateTime.Enabled = true
+	metricsConfig.Metrics.SystemCPUIdleStateUsage.Enabled = true
+	scraper := newCPUScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metricsConfig})
+	scraper.idleStates = func(context.Context) ([]idleState, error) {
+		return []idleState{{cpu: "cpu0", name: "C6", time: 250, usage: 1234}}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	assert.Equal(t, "system.cpu.idle_state.time", metrics.At(0).Name())
+	dp := metrics.At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, 250.0, dp.DoubleValue())
+	assert.Equal(t, map[string]any{"cpu": "cpu0", "idle_state": "C6"}, dp.Attributes().AsRaw())
+
+	assert.Equal(t, "system.cpu.idle_state.usage", metrics.At(1).Name())
+	dp = metrics.At(1).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(1234), dp.IntValue())
+	assert.Equal(t, map[string]any{"cpu": "cpu0", "idle_state": "C6"}, dp.Attributes().AsRaw())
+
+	scraper.idleStates = func(context.Context) ([]idleState, error) {
+		return nil, errors.New("err1")
+	}
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
+func TestScrape_Throttles(t *testing.T) {
+	metricsConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsConfig.Metrics.SystemCPUTime.Enabled = false
+	metricsConfig.Metrics.SystemCPUThrottleCore.Enabled = true
+	metricsConfig.Metrics.SystemCPUThrottlePackage.Enabled = true
+	scraper := newCPUScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metricsConfig})
+	scraper.throttles = func(context.Context) ([]throttleCount, []throttleCount, error) {
+		return []throttleCount{{id: "cpu0", count: 3}, {id: "cpu1", count: 5}}, []throttleCount{{id: "0", count: 7}}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	assert.Equal(t, "system.cpu.throttle.core", metrics.At(0).Name())
+	dps := metrics.At(0).Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, int64(3), dps.At(0).IntValue())
+	assert.Equal(t, map[string]any{"cpu": "cpu0"}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, int64(5), dps.At(1).IntValue())
+	assert.Equal(t, map[string]any{"cpu": "cpu1"}, dps.At(1).Attributes().AsRaw())
+
+	assert.Equal(t, "system.cpu.throttle.package", metrics.At(1).Name())
+	dps = metrics.At(1).Sum().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, int64(7), dps.At(0).IntValue())
+	assert.Equal(t, map[string]any{"package": "0"}, dps.At(0).Attributes().AsRaw())
+
+	scraper.throttles = func(context.Context) ([]throttleCount, []throttleCount, error) {
+		return nil, nil, errors.New("err1")
+	}
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
+func TestScrape_Interrupts(t *testing.T) {
+	metricsConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsConfig.Metrics.SystemCPUTime.Enabled = false
+	metricsConfig.Metrics.SystemCPUInterrupts.Enabled = true
+	metricsConfig.Metrics.SystemCPUSoftirqs.Enabled = true
+	scraper := newCPUScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metricsConfig})
+	scraper.interrupts = func(context.Context) (uint64, bool, error) {
+		return 4357528, true, nil
+	}
+	scraper.softirqs = func(context.Context) ([]softirqCount, error) {
+		return []softirqCount{{cpu: "cpu0", name: "net_rx", count: 1024}, {cpu: "cpu1", name: "net_rx", count: 2048}}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	assert.Equal(t, "system.cpu.interrupts", metrics.At(0).Name())
+	assert.Equal(t, int64(4357528), metrics.At(0).Sum().DataPoints().At(0).IntValue())
+
+	assert.Equal(t, "system.cpu.softirqs", metrics.At(1).Name())
+	dps := metrics.At(1).Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, int64(1024), dps.At(0).IntValue())
+	assert.Equal(t, map[string]any{"cpu": "cpu0", "softirq": "net_rx"}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, int64(2048), dps.At(1).IntValue())
+	assert.Equal(t, map[string]any{"cpu": "cpu1", "softirq": "net_rx"}, dps.At(1).Attributes().AsRaw())
+
+	// no data point is recorded when the count is not available
+	scraper.interrupts = func(context.Context) (uint64, bool, error) {
+		return 0, false, nil
+	}
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, md.MetricCount())
+
+	scraper.softirqs = func(context.Context) ([]softirqCount, error) {
+		return nil, errors.New("err1")
+	}
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
+func TestScrape_Topology(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("core aggregation is only supported on Linux")
+	}
+
+	metricsConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsConfig.Metrics.SystemCPUTopology.Enabled = true
+	metricsConfig.Metrics.SystemCPUUtilization.Enabled = true
+	scraper := newCPUScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metricsConfig, Aggregation: aggregationCore})
+	scraper.topology = func(context.Context) ([]cpuTopology, error) {
+		return []cpuTopology{
+			{cpu: "cpu0", core: "0", pkg: "0"},
+			{cpu: "cpu1", core: "1", pkg: "0"},
+			{cpu: "cpu2", core: "0", pkg: "0"},
+			{cpu: "cpu3", core: "1", pkg: "0"},
+		}, nil
+	}
+	cpuTimes := []cpu.TimesStat{
+		{CPU: "cpu0", User: 1, System: 1, Idle: 2},
+		{CPU: "cpu1", User: 2, System: 1, Idle: 1},
+		{CPU: "cpu2", User: 3, System: 1, Idle: 2},
+		{CPU: "cpu3", User: 4, System: 1, Idle: 1},
+	}
+	scraper.times = func(context.Context, bool) ([]cpu.TimesStat, error) { return cpuTimes, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	// the times of the logical CPUs sharing a core are summed
+	assert.Equal(t, "system.cpu.time", metrics.At(0).Name())
+	dps := metrics.At(0).Sum().DataPoints()
+	require.Equal(t, 2*10, dps.Len())
+	assertDatapointValueAndStringAttributes(t, dps.At(0), 4, map[string]string{"cpu": "package0-core0", "state": "user"})
+	assertDatapointValueAndStringAttributes(t, dps.At(10), 6, map[string]string{"cpu": "package0-core1", "state": "user"})
+
+	assert.Equal(t, "system.cpu.topology", metrics.At(1).Name())
+	dps = metrics.At(1).Gauge().DataPoints()
+	require.Equal(t, 4, dps.Len())
+	assert.Equal(t, int64(1), dps.At(2).IntValue())
+	assert.Equal(t, map[string]any{"cpu": "cpu2", "core": "0", "package": "0"}, dps.At(2).Attributes().AsRaw())
+
+	cpuTimes = []cpu.TimesStat{
+		{CPU: "cpu0", User: 2, System: 1, Idle: 3},
+		{CPU: "cpu1", User: 2, System: 1, Idle: 1},
+		{CPU: "cpu2", User: 4, System: 1, Idle: 3},
+		{CPU: "cpu3", User: 4, System: 1, Idle: 1},
+	}
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, "system.cpu.utilization", metrics.At(2).Name())
+	dps = metrics.At(2).Gauge().DataPoints()
+	assertDatapointValueAndStringAttributes(t, dps.At(0), 0.5, map[string]string{"cpu": "package0-core0", "state": "user"})
+
+	scraper.topology = func(context.Context) ([]cpuTopology, error) { return nil, errors.New("err1") }
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
+func TestScrape_CgroupUtilization(t *testing.T) {
+	metricsConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsConfig.Metrics.SystemCPUTime.Enabled = false
+	metricsConfig.Metrics.SystemCPUUtilization.Enabled = true
+	scraper := newCPUScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metricsConfig, UtilizationLimit: utilizationLimitCgroup})
+	scraper.times = func(context.Context, bool) ([]cpu.TimesStat, error) {
+		return []cpu.TimesStat{{CPU: "cpu0"}, {CPU: "cpu1"}, {CPU: "cpu2"}, {CPU: "cpu3"}}, nil
+	}
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	scraper.now = func() time.Time { return now }
+	cgroupCPU := &cgroup.CPU{User: 1, System: 0, Limit: 0.5}
+	scraper.cgroupCPU = func() (*cgroup.CPU, error) { return cgroupCPU, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	// no utilization in the first scrape
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.DataPointCount())
+
+	// 3s of CPU time over 10s with a quota of half a CPU
+	now = now.Add(10 * time.Second)
+	cgroupCPU = &cgroup.CPU{User: 3, System: 1, Limit: 0.5}
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	dps := metrics.At(0).Gauge().DataPoints()
+	require.Equal(t, 3, dps.Len())
+	assertDatapointValueAndStringAttributes(t, dps.At(0), 0.4, map[string]string{"cpu": "cgroup", "state": "user"})
+	assertDatapointValueAndStringAttributes(t, dps.At(1), 0.2, map[string]string{"cpu": "cgroup", "state": "system"})
+	assertDatapointValueAndStringAttributes(t, dps.At(2), 0.4, map[string]string{"cpu": "cgroup", "state": "idle"})
+
+	// without a quota the utilization is computed against the logical CPUs of the host
+	now = now.Add(10 * time.Second)
+	cgroupCPU = &cgroup.CPU{User: 11, System: 5}
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	dps = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	assertDatapointValueAndStringAttributes(t, dps.At(0), 0.2, map[string]string{"cpu": "cgroup", "state": "user"})
+	assertDatapointValueAndStringAttributes(t, dps.At(1), 0.1, map[string]string{"cpu": "cgroup", "state": "system"})
+	assertDatapointValueAndStringAttributes(t, dps.At(2), 0.7, map[string]string{"cpu": "cgroup", "state": "idle"})
+
+	scraper.cgroupCPU = func() (*cgroup.CPU, error) { return nil, errors.New("err1") }
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read the CPU time of the cgroup: err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
 func assertDatapointValueAndStringAttributes(t *testing.T, dp pmetric.NumberDataPoint, value float64, attrs map[string]string) {
 	assert.InDelta(t, value, dp.DoubleValue(), 0.0001)
 	for k, v := range attrs {
@@ -363,6 +593,10 @@ func assertCPUMetricHasLinuxSpecificStateLabels(t *testing.T, metric pmetric.Met
 		pcommon.NewValueStr(metadata.AttributeStateSteal.String()))
 	internal.AssertSumMetricHasAttributeValue(t, metric, 7, "state",
 		pcommon.NewValueStr(metadata.AttributeStateWait.String()))
+	internal.AssertSumMetricHasAttributeValue(t, metric, 8, "state",
+		pcommon.NewValueStr(metadata.AttributeStateGuest.String()))
+	internal.AssertSumMetricHasAttributeValue(t, metric, 9, "state",
+		pcommon.NewValueStr(metadata.AttributeStateGuestNice.String()))
 }
 
 func assertCPUUtilizationMetricValid(t *testing.T, metric pmetric.Metric, startTime pcommon.Timestamp) {
@@ -395,4 +629,8 @@ func assertCPUUtilizationMetricHasLinuxSpecificStateLabels(t *testing.T, metric
 		pcommon.NewValueStr(metadata.AttributeStateSteal.String()))
 	internal.AssertGaugeMetricHasAttributeValue(t, metric, 7, "state",
 		pcommon.NewValueStr(metadata.AttributeStateWait.String()))
+	internal.AssertGaugeMetricHasAttributeValue(t, metric, 8, "state",
+		pcommon.NewValueStr(metadata.AttributeStateGuest.String()))
+	internal.AssertGaugeMetricHasAttributeValue(t, metric, 9, "state",
+		pcommon.NewValueStr(metadata.AttributeStateGuestNice.String()))
 }
@@ -5,6 +5,9 @@ package cpuscraper // import "github.com/open-telemetry/opentelemetry-collector-
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"runtime"
 
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -37,6 +40,24 @@ func (f *Factory) CreateMetricsScraper(
 	config internal.Config,
 ) (scraperhelper.Scraper, error) {
 	cfg := config.(*Config)
+	switch cfg.Aggregation {
+	case "", aggregationCPU:
+	case aggregationCore:
+		if runtime.GOOS != "linux" {
+			return nil, errors.New("core aggregation only available on Linux")
+		}
+	default:
+		return nil, fmt.Errorf("invalid aggregation %q, must be cpu or core", cfg.Aggregation)
+	}
+	switch cfg.UtilizationLimit {
+	case "", utilizationLimitHost:
+	case utilizationLimitCgroup:
+		if runtime.GOOS != "linux" {
+			return nil, errors.New("cgroup utilization limit only available on Linux")
+		}
+	default:
+		return nil, fmt.Errorf("invalid utilization_limit %q, must be host or cgroup", cfg.UtilizationLimit)
+	}
 	s := newCPUScraper(ctx, settings, cfg)
 
 	return scraperhelper.NewScraper(
@@ -5,6 +5,7 @@ package cpuscraper
 
 import (
 	"context"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,3 +27,37 @@ func TestCreateMetricsScraper(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, scraper)
 }
+
+func TestCreateMetricsScraper_Aggregation(t *testing.T) {
+	factory := &Factory{}
+
+	_, err := factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{Aggregation: "cpu"})
+	assert.NoError(t, err)
+
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{Aggregation: "core"})
+	if runtime.GOOS == "linux" {
+		assert.NoError(t, err)
+	} else {
+		assert.EqualError(t, err, "core aggregation only available on Linux")
+	}
+
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{Aggregation: "socket"})
+	assert.EqualError(t, err, `invalid aggregation "socket", must be cpu or core`)
+}
+
+func TestCreateMetricsScraper_UtilizationLimit(t *testing.T) {
+	factory := &Factory{}
+
+	_, err := factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{UtilizationLimit: "host"})
+	assert.NoError(t, err)
+
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{UtilizationLimit: "cgroup"})
+	if runtime.GOOS == "linux" {
+		assert.NoError(t, err)
+	} else {
+		assert.EqualError(t, err, "cgroup utilization limit only available on Linux")
+	}
+
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{UtilizationLimit: "container"})
+	assert.EqualError(t, err, `invalid utilization_limit "container", must be host or cgroup`)
+}
@@ -27,11 +27,18 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for hostmetricsreceiver/cpu metrics.
 type MetricsConfig struct {
-	SystemCPUFrequency     MetricConfig `mapstructure:"system.cpu.frequency"`
-	SystemCPULogicalCount  MetricConfig `mapstructure:"system.cpu.logical.count"`
-	SystemCPUPhysicalCount MetricConfig `mapstructure:"system.cpu.physical.count"`
-	SystemCPUTime          MetricConfig `mapstructure:"system.cpu.time"`
-	SystemCPUUtilization   MetricConfig `mapstructure:"system.cpu.utilization"`
+	SystemCPUFrequency       MetricConfig `mapstructure:"system.cpu.frequency"`
+	SystemCPUIdleStateTime   MetricConfig `mapstructure:"system.cpu.idle_state.time"`
+	SystemCPUIdleStateUsage  MetricConfig `mapstructure:"system.cpu.idle_state.usage"`
+	SystemCPUInterrupts      MetricConfig `mapstructure:"system.cpu.interrupts"`
+	SystemCPULogicalCount    MetricConfig `mapstructure:"system.cpu.logical.count"`
+	SystemCPUPhysicalCount   MetricConfig `mapstructure:"system.cpu.physical.count"`
+	SystemCPUSoftirqs        MetricConfig `mapstructure:"system.cpu.softirqs"`
+	SystemCPUThrottleCore    MetricConfig `mapstructure:"system.cpu.throttle.core"`
+	SystemCPUThrottlePackage MetricConfig `mapstructure:"system.cpu.throttle.package"`
+	SystemCPUTime            MetricConfig `mapstructure:"system.cpu.time"`
+	SystemCPUTopology        MetricConfig `mapstructure:"system.cpu.topology"`
+	SystemCPUUtilization     MetricConfig `mapstructure:"system.cpu.utilization"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -39,15 +46,36 @@ func DefaultMetricsConfig() MetricsConfig {
 		SystemCPUFrequency: MetricConfig{
 			Enabled: false,
 		},
+		SystemCPUIdleStateTime: MetricConfig{
+			Enabled: false,
+		},
+		SystemCPUIdleStateUsage: MetricConfig{
+			Enabled: false,
+		},
+		SystemCPUInterrupts: MetricConfig{
+			Enabled: false,
+		},
 		SystemCPULogicalCount: MetricConfig{
 			Enabled: false,
 		},
 		SystemCPUPhysicalCount: MetricConfig{
 			Enabled: false,
 		},
+		SystemCPUSoftirqs: MetricConfig{
+			Enabled: false,
+		},
+		SystemCPUThrottleCore: MetricConfig{
+			Enabled: false,
+		},
+		SystemCPUThrottlePackage: MetricConfig{
+			Enabled: false,
+		},
 		SystemCPUTime: MetricConfig{
 			Enabled: true,
 		},
+		SystemCPUTopology: MetricConfig{
+			Enabled: false,
+		},
 		SystemCPUUtilization: MetricConfig{
 			Enabled: false,
 		},
@@ -26,11 +26,18 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemCPUFrequency:     MetricConfig{Enabled: true},
-					SystemCPULogicalCount:  MetricConfig{Enabled: true},
-					SystemCPUPhysicalCount: MetricConfig{Enabled: true},
-					SystemCPUTime:          MetricConfig{Enabled: true},
-					SystemCPUUtilization:   MetricConfig{Enabled: true},
+					SystemCPUFrequency:       MetricConfig{Enabled: true},
+					SystemCPUIdleStateTime:   MetricConfig{Enabled: true},
+					SystemCPUIdleStateUsage:  MetricConfig{Enabled: true},
+					SystemCPUInterrupts:      MetricConfig{Enabled: true},
+					SystemCPULogicalCount:    MetricConfig{Enabled: true},
+					SystemCPUPhysicalCount:   MetricConfig{Enabled: true},
+					SystemCPUSoftirqs:        MetricConfig{Enabled: true},
+					SystemCPUThrottleCore:    MetricConfig{Enabled: true},
+					SystemCPUThrottlePackage: MetricConfig{Enabled: true},
+					SystemCPUTime:            MetricConfig{Enabled: true},
+					SystemCPUTopology:        MetricConfig{Enabled: true},
+					SystemCPUUtilization:     MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -38,11 +45,18 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemCPUFrequency:     MetricConfig{Enabled: false},
-					SystemCPULogicalCount:  MetricConfig{Enabled: false},
-					SystemCPUPhysicalCount: MetricConfig{Enabled: false},
-					SystemCPUTime:          MetricConfig{Enabled: false},
-					SystemCPUUtilization:   MetricConfig{Enabled: false},
+					SystemCPUFrequency:       MetricConfig{Enabled: false},
+					SystemCPUIdleStateTime:   MetricConfig{Enabled: false},
+					SystemCPUIdleStateUsage:  MetricConfig{Enabled: false},
+					SystemCPUInterrupts:      MetricConfig{Enabled: false},
+					SystemCPULogicalCount:    MetricConfig{Enabled: false},
+					SystemCPUPhysicalCount:   MetricConfig{Enabled: false},
+					SystemCPUSoftirqs:        MetricConfig{Enabled: false},
+					SystemCPUThrottleCore:    MetricConfig{Enabled: false},
+					SystemCPUThrottlePackage: MetricConfig{Enabled: false},
+					SystemCPUTime:            MetricConfig{Enabled: false},
+					SystemCPUTopology:        MetricConfig{Enabled: false},
+					SystemCPUUtilization:     MetricConfig{Enabled: false},
 				},
 			},
 		},
@@ -17,6 +17,8 @@ type AttributeState int
 
 const (
 	_ AttributeState = iota
+	AttributeStateGuest
+	AttributeStateGuestNice
 	AttributeStateIdle
 	AttributeStateInterrupt
 	AttributeStateNice
@@ -30,6 +32,10 @@ const (
 // String returns the string representation of the AttributeState.
 func (av AttributeState) String() string {
 	switch av {
+	case AttributeStateGuest:
+		return "guest"
+	case AttributeStateGuestNice:
+		return "guest_nice"
 	case AttributeStateIdle:
 		return "idle"
 	case AttributeStateInterrupt:
@@ -52,14 +58,16 @@ func (av AttributeState) String() string {
 
 // MapAttributeState is a helper map of string to AttributeState attribute value.
 var MapAttributeState = map[string]AttributeState{
-	"idle":      AttributeStateIdle,
-	"interrupt": AttributeStateInterrupt,
-	"nice":      AttributeStateNice,
-	"softirq":   AttributeStateSoftirq,
-	"steal":     AttributeStateSteal,
-	"system":    AttributeStateSystem,
-	"user":      AttributeStateUser,
-	"wait":      AttributeStateWait,
+	"guest":      AttributeStateGuest,
+	"guest_nice": AttributeStateGuestNice,
+	"idle":       AttributeStateIdle,
+	"interrupt":  AttributeStateInterrupt,
+	"nice":       AttributeStateNice,
+	"softirq":    AttributeStateSoftirq,
+	"steal":      AttributeStateSteal,
+	"system":     AttributeStateSystem,
+	"user":       AttributeStateUser,
+	"wait":       AttributeStateWait,
 }
 
 type metricSystemCPUFrequency struct {
@@ -113,6 +121,165 @@ func newMetricSystemCPUFrequency(cfg MetricConfig) metricSystemCPUFrequency {
 	return m
 }
 
+type metricSystemCPUIdleStateTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.idle_state.time metric with initial data.
+func (m *metricSystemCPUIdleStateTime) init() {
+	m.data.SetName("system.cpu.idle_state.time")
+	m.data.SetDescription("Total seconds each logical CPU spent in each idle state.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCPUIdleStateTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, cpuAttributeValue string, idleStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("cpu", cpuAttributeValue)
+	dp.Attributes().PutStr("idle_state", idleStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUIdleStateTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUIdleStateTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUIdleStateTime(cfg MetricConfig) metricSystemCPUIdleStateTime {
+	m := metricSystemCPUIdleStateTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCPUIdleStateUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.idle_state.usage metric with initial data.
+func (m *metricSystemCPUIdleStateUsage) init() {
+	m.data.SetName("system.cpu.idle_state.usage")
+	m.data.SetDescription("Number of times each logical CPU entered each idle state.")
+	m.data.SetUnit("{entries}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCPUIdleStateUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cpuAttributeValue string, idleStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cpu", cpuAttributeValue)
+	dp.Attributes().PutStr("idle_state", idleStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUIdleStateUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUIdleStateUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUIdleStateUsage(cfg MetricConfig) metricSystemCPUIdleStateUsage {
+	m := metricSystemCPUIdleStateUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCPUInterrupts struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.interrupts metric with initial data.
+func (m *metricSystemCPUInterrupts) init() {
+	m.data.SetName("system.cpu.interrupts")
+	m.data.SetDescription("Number of hardware interrupts serviced by all the CPUs. (Linux only)")
+	m.data.SetUnit("{interrupts}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemCPUInterrupts) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUInterrupts) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUInterrupts) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUInterrupts(cfg MetricConfig) metricSystemCPUInterrupts {
+	m := metricSystemCPUInterrupts{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSystemCPULogicalCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -215,6 +382,166 @@ func newMetricSystemCPUPhysicalCount(cfg MetricConfig) metricSystemCPUPhysicalCo
 	return m
 }
 
+type metricSystemCPUSoftirqs struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.softirqs metric with initial data.
+func (m *metricSystemCPUSoftirqs) init() {
+	m.data.SetName("system.cpu.softirqs")
+	m.data.SetDescription("Number of software interrupts serviced by each logical CPU, by type. (Linux only)")
+	m.data.SetUnit("{interrupts}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCPUSoftirqs) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cpuAttributeValue string, softirqAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cpu", cpuAttributeValue)
+	dp.Attributes().PutStr("softirq", softirqAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUSoftirqs) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUSoftirqs) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUSoftirqs(cfg MetricConfig) metricSystemCPUSoftirqs {
+	m := metricSystemCPUSoftirqs{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCPUThrottleCore struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.throttle.core metric with initial data.
+func (m *metricSystemCPUThrottleCore) init() {
+	m.data.SetName("system.cpu.throttle.core")
+	m.data.SetDescription("Number of times the core of each logical CPU was throttled because of its temperature.")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCPUThrottleCore) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cpuAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cpu", cpuAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUThrottleCore) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUThrottleCore) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUThrottleCore(cfg MetricConfig) metricSystemCPUThrottleCore {
+	m := metricSystemCPUThrottleCore{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCPUThrottlePackage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.throttle.package metric with initial data.
+func (m *metricSystemCPUThrottlePackage) init() {
+	m.data.SetName("system.cpu.throttle.package")
+	m.data.SetDescription("Number of times each CPU package was throttled because of its temperature.")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCPUThrottlePackage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, packageAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("package", packageAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUThrottlePackage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUThrottlePackage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUThrottlePackage(cfg MetricConfig) metricSystemCPUThrottlePackage {
+	m := metricSystemCPUThrottlePackage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSystemCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -269,6 +596,59 @@ func newMetricSystemCPUTime(cfg MetricConfig) metricSystemCPUTime {
 	return m
 }
 
+type metricSystemCPUTopology struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.topology metric with initial data.
+func (m *metricSystemCPUTopology) init() {
+	m.data.SetName("system.cpu.topology")
+	m.data.SetDescription("Topology of the logical CPUs, mapping each logical CPU to its physical core and package (socket). The value is always 1.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCPUTopology) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cpuAttributeValue string, coreAttributeValue string, packageAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cpu", cpuAttributeValue)
+	dp.Attributes().PutStr("core", coreAttributeValue)
+	dp.Attributes().PutStr("package", packageAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUTopology) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUTopology) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUTopology(cfg MetricConfig) metricSystemCPUTopology {
+	m := metricSystemCPUTopology{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSystemCPUUtilization struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -324,16 +704,23 @@ func newMetricSystemCPUUtilization(cfg MetricConfig) metricSystemCPUUtilization
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                       MetricsBuilderConfig // config of the metrics builder.
-	startTime                    pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity              int                  // maximum observed number of metrics per resource.
-	metricsBuffer                pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                    component.BuildInfo  // contains version information.
-	metricSystemCPUFrequency     metricSystemCPUFrequency
-	metricSystemCPULogicalCount  metricSystemCPULogicalCount
-	metricSystemCPUPhysicalCount metricSystemCPUPhysicalCount
-	metricSystemCPUTime          metricSystemCPUTime
-	metricSystemCPUUtilization   metricSystemCPUUtilization
+	config                         MetricsBuilderConfig // config of the metrics builder.
+	startTime                      pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                int                  // maximum observed number of metrics per resource.
+	metricsBuffer                  pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                      component.BuildInfo  // contains version information.
+	metricSystemCPUFrequency       metricSystemCPUFrequency
+	metricSystemCPUIdleStateTime   metricSystemCPUIdleStateTime
+	metricSystemCPUIdleStateUsage  metricSystemCPUIdleStateUsage
+	metricSystemCPUInterrupts      metricSystemCPUInterrupts
+	metricSystemCPULogicalCount    metricSystemCPULogicalCount
+	metricSystemCPUPhysicalCount   metricSystemCPUPhysicalCount
+	metricSystemCPUSoftirqs        metricSystemCPUSoftirqs
+	metricSystemCPUThrottleCore    metricSystemCPUThrottleCore
+	metricSystemCPUThrottlePackage metricSystemCPUThrottlePackage
+	metricSystemCPUTime            metricSystemCPUTime
+	metricSystemCPUTopology        metricSystemCPUTopology
+	metricSystemCPUUtilization     metricSystemCPUUtilization
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -348,15 +735,22 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                       mbc,
-		startTime:                    pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                pmetric.NewMetrics(),
-		buildInfo:                    settings.BuildInfo,
-		metricSystemCPUFrequency:     newMetricSystemCPUFrequency(mbc.Metrics.SystemCPUFrequency),
-		metricSystemCPULogicalCount:  newMetricSystemCPULogicalCount(mbc.Metrics.SystemCPULogicalCount),
-		metricSystemCPUPhysicalCount: newMetricSystemCPUPhysicalCount(mbc.Metrics.SystemCPUPhysicalCount),
-		metricSystemCPUTime:          newMetricSystemCPUTime(mbc.Metrics.SystemCPUTime),
-		metricSystemCPUUtilization:   newMetricSystemCPUUtilization(mbc.Metrics.SystemCPUUtilization),
+		config:                         mbc,
+		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                  pmetric.NewMetrics(),
+		buildInfo:                      settings.BuildInfo,
+		metricSystemCPUFrequency:       newMetricSystemCPUFrequency(mbc.Metrics.SystemCPUFrequency),
+		metricSystemCPUIdleStateTime:   newMetricSystemCPUIdleStateTime(mbc.Metrics.SystemCPUIdleStateTime),
+		metricSystemCPUIdleStateUsage:  newMetricSystemCPUIdleStateUsage(mbc.Metrics.SystemCPUIdleStateUsage),
+		metricSystemCPUInterrupts:      newMetricSystemCPUInterrupts(mbc.Metrics.SystemCPUInterrupts),
+		metricSystemCPULogicalCount:    newMetricSystemCPULogicalCount(mbc.Metrics.SystemCPULogicalCount),
+		metricSystemCPUPhysicalCount:   newMetricSystemCPUPhysicalCount(mbc.Metrics.SystemCPUPhysicalCount),
+		metricSystemCPUSoftirqs:        newMetricSystemCPUSoftirqs(mbc.Metrics.SystemCPUSoftirqs),
+		metricSystemCPUThrottleCore:    newMetricSystemCPUThrottleCore(mbc.Metrics.SystemCPUThrottleCore),
+		metricSystemCPUThrottlePackage: newMetricSystemCPUThrottlePackage(mbc.Metrics.SystemCPUThrottlePackage),
+		metricSystemCPUTime:            newMetricSystemCPUTime(mbc.Metrics.SystemCPUTime),
+		metricSystemCPUTopology:        newMetricSystemCPUTopology(mbc.Metrics.SystemCPUTopology),
+		metricSystemCPUUtilization:     newMetricSystemCPUUtilization(mbc.Metrics.SystemCPUUtilization),
 	}
 
 	for _, op := range options {
@@ -416,9 +810,16 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricSystemCPUFrequency.emit(ils.Metrics())
+	mb.metricSystemCPUIdleStateTime.emit(ils.Metrics())
+	mb.metricSystemCPUIdleStateUsage.emit(ils.Metrics())
+	mb.metricSystemCPUInterrupts.emit(ils.Metrics())
 	mb.metricSystemCPULogicalCount.emit(ils.Metrics())
 	mb.metricSystemCPUPhysicalCount.emit(ils.Metrics())
+	mb.metricSystemCPUSoftirqs.emit(ils.Metrics())
+	mb.metricSystemCPUThrottleCore.emit(ils.Metrics())
+	mb.metricSystemCPUThrottlePackage.emit(ils.Metrics())
 	mb.metricSystemCPUTime.emit(ils.Metrics())
+	mb.metricSystemCPUTopology.emit(ils.Metrics())
 	mb.metricSystemCPUUtilization.emit(ils.Metrics())
 
 	for _, op := range rmo {
@@ -446,6 +847,21 @@ func (mb *MetricsBuilder) RecordSystemCPUFrequencyDataPoint(ts pcommon.Timestamp
 	mb.metricSystemCPUFrequency.recordDataPoint(mb.startTime, ts, val, cpuAttributeValue)
 }
 
+// RecordSystemCPUIdleStateTimeDataPoint adds a data point to system.cpu.idle_state.time metric.
+func (mb *MetricsBuilder) RecordSystemCPUIdleStateTimeDataPoint(ts pcommon.Timestamp, val float64, cpuAttributeValue string, idleStateAttributeValue string) {
+	mb.metricSystemCPUIdleStateTime.recordDataPoint(mb.startTime, ts, val, cpuAttributeValue, idleStateAttributeValue)
+}
+
+// RecordSystemCPUIdleStateUsageDataPoint adds a data point to system.cpu.idle_state.usage metric.
+func (mb *MetricsBuilder) RecordSystemCPUIdleStateUsageDataPoint(ts pcommon.Timestamp, val int64, cpuAttributeValue string, idleStateAttributeValue string) {
+	mb.metricSystemCPUIdleStateUsage.recordDataPoint(mb.startTime, ts, val, cpuAttributeValue, idleStateAttributeValue)
+}
+
+// RecordSystemCPUInterruptsDataPoint adds a data point to system.cpu.interrupts metric.
+func (mb *MetricsBuilder) RecordSystemCPUInterruptsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemCPUInterrupts.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordSystemCPULogicalCountDataPoint adds a data point to system.cpu.logical.count metric.
 func (mb *MetricsBuilder) RecordSystemCPULogicalCountDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricSystemCPULogicalCount.recordDataPoint(mb.startTime, ts, val)
@@ -456,11 +872,31 @@ func (mb *MetricsBuilder) RecordSystemCPUPhysicalCountDataPoint(ts pcommon.Times
 	mb.metricSystemCPUPhysicalCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSystemCPUSoftirqsDataPoint adds a data point to system.cpu.softirqs metric.
+func (mb *MetricsBuilder) RecordSystemCPUSoftirqsDataPoint(ts pcommon.Timestamp, val int64, cpuAttributeValue string, softirqAttributeValue string) {
+	mb.metricSystemCPUSoftirqs.recordDataPoint(mb.startTime, ts, val, cpuAttributeValue, softirqAttributeValue)
+}
+
+// RecordSystemCPUThrottleCoreDataPoint adds a data point to system.cpu.throttle.core metric.
+func (mb *MetricsBuilder) RecordSystemCPUThrottleCoreDataPoint(ts pcommon.Timestamp, val int64, cpuAttributeValue string) {
+	mb.metricSystemCPUThrottleCore.recordDataPoint(mb.startTime, ts, val, cpuAttributeValue)
+}
+
+// RecordSystemCPUThrottlePackageDataPoint adds a data point to system.cpu.throttle.package metric.
+func (mb *MetricsBuilder) RecordSystemCPUThrottlePackageDataPoint(ts pcommon.Timestamp, val int64, packageAttributeValue string) {
+	mb.metricSystemCPUThrottlePackage.recordDataPoint(mb.startTime, ts, val, packageAttributeValue)
+}
+
 // RecordSystemCPUTimeDataPoint adds a data point to system.cpu.time metric.
 func (mb *MetricsBuilder) RecordSystemCPUTimeDataPoint(ts pcommon.Timestamp, val float64, cpuAttributeValue string, stateAttributeValue AttributeState) {
 	mb.metricSystemCPUTime.recordDataPoint(mb.startTime, ts, val, cpuAttributeValue, stateAttributeValue.String())
 }
 
+// RecordSystemCPUTopologyDataPoint adds a data point to system.cpu.topology metric.
+func (mb *MetricsBuilder) RecordSystemCPUTopologyDataPoint(ts pcommon.Timestamp, val int64, cpuAttributeValue string, coreAttributeValue string, packageAttributeValue string) {
+	mb.metricSystemCPUTopology.recordDataPoint(mb.startTime, ts, val, cpuAttributeValue, coreAttributeValue, packageAttributeValue)
+}
+
 // RecordSystemCPUUtilizationDataPoint adds a data point to system.cpu.utilization metric.
 func (mb *MetricsBuilder) RecordSystemCPUUtilizationDataPoint(ts pcommon.Timestamp, val float64, cpuAttributeValue string, stateAttributeValue AttributeState) {
 	mb.metricSystemCPUUtilization.recordDataPoint(mb.startTime, ts, val, cpuAttributeValue, stateAttributeValue.String())
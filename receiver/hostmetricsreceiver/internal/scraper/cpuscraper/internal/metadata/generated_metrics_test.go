@@ -62,18 +62,39 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSystemCPUFrequencyDataPoint(ts, 1, "cpu-val")
 
+			allMetricsCount++
+			mb.RecordSystemCPUIdleStateTimeDataPoint(ts, 1, "cpu-val", "idle_state-val")
+
+			allMetricsCount++
+			mb.RecordSystemCPUIdleStateUsageDataPoint(ts, 1, "cpu-val", "idle_state-val")
+
+			allMetricsCount++
+			mb.RecordSystemCPUInterruptsDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordSystemCPULogicalCountDataPoint(ts, 1)
 
 			allMetricsCount++
 			mb.RecordSystemCPUPhysicalCountDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordSystemCPUSoftirqsDataPoint(ts, 1, "cpu-val", "softirq-val")
+
+			allMetricsCount++
+			mb.RecordSystemCPUThrottleCoreDataPoint(ts, 1, "cpu-val")
+
+			allMetricsCount++
+			mb.RecordSystemCPUThrottlePackageDataPoint(ts, 1, "package-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
-			mb.RecordSystemCPUTimeDataPoint(ts, 1, "cpu-val", AttributeStateIdle)
+			mb.RecordSystemCPUTimeDataPoint(ts, 1, "cpu-val", AttributeStateGuest)
 
 			allMetricsCount++
-			mb.RecordSystemCPUUtilizationDataPoint(ts, 1, "cpu-val", AttributeStateIdle)
+			mb.RecordSystemCPUTopologyDataPoint(ts, 1, "cpu-val", "core-val", "package-val")
+
+			allMetricsCount++
+			mb.RecordSystemCPUUtilizationDataPoint(ts, 1, "cpu-val", AttributeStateGuest)
 
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
@@ -112,6 +133,60 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("cpu")
 					assert.True(t, ok)
 					assert.EqualValues(t, "cpu-val", attrVal.Str())
+				case "system.cpu.idle_state.time":
+					assert.False(t, validatedMetrics["system.cpu.idle_state.time"], "Found a duplicate in the metrics slice: system.cpu.idle_state.time")
+					validatedMetrics["system.cpu.idle_state.time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total seconds each logical CPU spent in each idle state.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("cpu")
+					assert.True(t, ok)
+					assert.EqualValues(t, "cpu-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("idle_state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "idle_state-val", attrVal.Str())
+				case "system.cpu.idle_state.usage":
+					assert.False(t, validatedMetrics["system.cpu.idle_state.usage"], "Found a duplicate in the metrics slice: system.cpu.idle_state.usage")
+					validatedMetrics["system.cpu.idle_state.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of times each logical CPU entered each idle state.", ms.At(i).Description())
+					assert.Equal(t, "{entries}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cpu")
+					assert.True(t, ok)
+					assert.EqualValues(t, "cpu-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("idle_state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "idle_state-val", attrVal.Str())
+				case "system.cpu.interrupts":
+					assert.False(t, validatedMetrics["system.cpu.interrupts"], "Found a duplicate in the metrics slice: system.cpu.interrupts")
+					validatedMetrics["system.cpu.interrupts"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of hardware interrupts serviced by all the CPUs. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "{interrupts}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "system.cpu.logical.count":
 					assert.False(t, validatedMetrics["system.cpu.logical.count"], "Found a duplicate in the metrics slice: system.cpu.logical.count")
 					validatedMetrics["system.cpu.logical.count"] = true
@@ -140,6 +215,60 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "system.cpu.softirqs":
+					assert.False(t, validatedMetrics["system.cpu.softirqs"], "Found a duplicate in the metrics slice: system.cpu.softirqs")
+					validatedMetrics["system.cpu.softirqs"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of software interrupts serviced by each logical CPU, by type. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "{interrupts}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cpu")
+					assert.True(t, ok)
+					assert.EqualValues(t, "cpu-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("softirq")
+					assert.True(t, ok)
+					assert.EqualValues(t, "softirq-val", attrVal.Str())
+				case "system.cpu.throttle.core":
+					assert.False(t, validatedMetrics["system.cpu.throttle.core"], "Found a duplicate in the metrics slice: system.cpu.throttle.core")
+					validatedMetrics["system.cpu.throttle.core"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of times the core of each logical CPU was throttled because of its temperature.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cpu")
+					assert.True(t, ok)
+					assert.EqualValues(t, "cpu-val", attrVal.Str())
+				case "system.cpu.throttle.package":
+					assert.False(t, validatedMetrics["system.cpu.throttle.package"], "Found a duplicate in the metrics slice: system.cpu.throttle.package")
+					validatedMetrics["system.cpu.throttle.package"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of times each CPU package was throttled because of its temperature.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("package")
+					assert.True(t, ok)
+					assert.EqualValues(t, "package-val", attrVal.Str())
 				case "system.cpu.time":
 					assert.False(t, validatedMetrics["system.cpu.time"], "Found a duplicate in the metrics slice: system.cpu.time")
 					validatedMetrics["system.cpu.time"] = true
@@ -159,7 +288,28 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.EqualValues(t, "cpu-val", attrVal.Str())
 					attrVal, ok = dp.Attributes().Get("state")
 					assert.True(t, ok)
-					assert.EqualValues(t, "idle", attrVal.Str())
+					assert.EqualValues(t, "guest", attrVal.Str())
+				case "system.cpu.topology":
+					assert.False(t, validatedMetrics["system.cpu.topology"], "Found a duplicate in the metrics slice: system.cpu.topology")
+					validatedMetrics["system.cpu.topology"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Topology of the logical CPUs, mapping each logical CPU to its physical core and package (socket). The value is always 1.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cpu")
+					assert.True(t, ok)
+					assert.EqualValues(t, "cpu-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("core")
+					assert.True(t, ok)
+					assert.EqualValues(t, "core-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("package")
+					assert.True(t, ok)
+					assert.EqualValues(t, "package-val", attrVal.Str())
 				case "system.cpu.utilization":
 					assert.False(t, validatedMetrics["system.cpu.utilization"], "Found a duplicate in the metrics slice: system.cpu.utilization")
 					validatedMetrics["system.cpu.utilization"] = true
@@ -177,7 +327,7 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.EqualValues(t, "cpu-val", attrVal.Str())
 					attrVal, ok = dp.Attributes().Get("state")
 					assert.True(t, ok)
-					assert.EqualValues(t, "idle", attrVal.Str())
+					assert.EqualValues(t, "guest", attrVal.Str())
 				}
 			}
 		})
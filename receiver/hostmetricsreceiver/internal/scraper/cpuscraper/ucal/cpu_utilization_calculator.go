@@ -15,15 +15,17 @@ var ErrTimeStatNotFound = errors.New("cannot find TimesStat for cpu")
 
 // CPUUtilization stores the utilization percents [0-1] for the different cpu states
 type CPUUtilization struct {
-	CPU     string
-	User    float64
-	System  float64
-	Idle    float64
-	Nice    float64
-	Iowait  float64
-	Irq     float64
-	Softirq float64
-	Steal   float64
+	CPU       string
+	User      float64
+	System    float64
+	Idle      float64
+	Nice      float64
+	Iowait    float64
+	Irq       float64
+	Softirq   float64
+	Steal     float64
+	Guest     float64
+	GuestNice float64
 }
 
 // CPUUtilizationCalculator calculates the cpu utilization percents for the different cpu states
@@ -57,15 +59,17 @@ func cpuUtilization(timeStart cpu.TimesStat, timeEnd cpu.TimesStat) CPUUtilizati
 		return CPUUtilization{CPU: timeStart.CPU}
 	}
 	return CPUUtilization{
-		CPU:     timeStart.CPU,
-		User:    (timeEnd.User - timeStart.User) / elapsedSeconds,
-		System:  (timeEnd.System - timeStart.System) / elapsedSeconds,
-		Idle:    (timeEnd.Idle - timeStart.Idle) / elapsedSeconds,
-		Nice:    (timeEnd.Nice - timeStart.Nice) / elapsedSeconds,
-		Iowait:  (timeEnd.Iowait - timeStart.Iowait) / elapsedSeconds,
-		Irq:     (timeEnd.Irq - timeStart.Irq) / elapsedSeconds,
-		Softirq: (timeEnd.Softirq - timeStart.Softirq) / elapsedSeconds,
-		Steal:   (timeEnd.Steal - timeStart.Steal) / elapsedSeconds,
+		CPU:       timeStart.CPU,
+		User:      (timeEnd.User - timeStart.User) / elapsedSeconds,
+		System:    (timeEnd.System - timeStart.System) / elapsedSeconds,
+		Idle:      (timeEnd.Idle - timeStart.Idle) / elapsedSeconds,
+		Nice:      (timeEnd.Nice - timeStart.Nice) / elapsedSeconds,
+		Iowait:    (timeEnd.Iowait - timeStart.Iowait) / elapsedSeconds,
+		Irq:       (timeEnd.Irq - timeStart.Irq) / elapsedSeconds,
+		Softirq:   (timeEnd.Softirq - timeStart.Softirq) / elapsedSeconds,
+		Steal:     (timeEnd.Steal - timeStart.Steal) / elapsedSeconds,
+		Guest:     (timeEnd.Guest - timeStart.Guest) / elapsedSeconds,
+		GuestNice: (timeEnd.GuestNice - timeStart.GuestNice) / elapsedSeconds,
 	}
 }
 
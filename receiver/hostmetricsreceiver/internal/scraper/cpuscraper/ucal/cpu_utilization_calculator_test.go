@@ -199,6 +199,17 @@ func Test_cpuUtilization(t *testing.T) {
 
 }
 
+func Test_cpuUtilization_Guest(t *testing.T) {
+	timeStart := cpu.TimesStat{CPU: "cpu0", User: 10, Idle: 10, Guest: 4, GuestNice: 1}
+	timeEnd := cpu.TimesStat{CPU: "cpu0", User: 12, Idle: 13, Guest: 8, GuestNice: 2}
+
+	actualUtilization := cpuUtilization(timeStart, timeEnd)
+	assert.InDelta(t, 0.2, actualUtilization.User, 0.00001)
+	assert.InDelta(t, 0.3, actualUtilization.Idle, 0.00001)
+	assert.InDelta(t, 0.4, actualUtilization.Guest, 0.00001)
+	assert.InDelta(t, 0.1, actualUtilization.GuestNice, 0.00001)
+}
+
 func Test_cpuTimeByCpu(t *testing.T) {
 	testCases := []struct {
 		name             string
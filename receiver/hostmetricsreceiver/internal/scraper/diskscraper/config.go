@@ -4,6 +4,8 @@
 package diskscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 
 import (
+	"time"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper/internal/metadata"
@@ -20,6 +22,60 @@ type Config struct {
 	// If neither `include` or `exclude` are set, metrics will be generated for all devices.
 	Include MatchConfig `mapstructure:"include"`
 	Exclude MatchConfig `mapstructure:"exclude"`
+
+	// ReportPartitions selects which devices are reported depending on whether they are partitions:
+	// `all` (the default) reports every device, `none` only devices that are not partitions, and
+	// `only` only partitions. The synthetic `_total` device never includes partitions, whatever
+	// the value. This option is only supported on Linux.
+	ReportPartitions string `mapstructure:"report_partitions"`
+
+	// CgroupPath is the path of a cgroup v2 directory, such as `/sys/fs/cgroup` within a container.
+	// When set, the I/O of the cgroup is read from its `io.stat` file instead of the I/O of the
+	// whole host, and only the `system.disk.io` and `system.disk.operations` metrics are reported.
+	// This option is only supported on Linux.
+	CgroupPath string `mapstructure:"cgroup_path"`
+
+	// ScrapePhysicalDisks additionally scrapes the PhysicalDisk performance counters, next to the
+	// LogicalDisk ones, and adds a `disk.type` attribute set to `logical` or `physical` to every
+	// data point. This option is only supported on Windows.
+	ScrapePhysicalDisks bool `mapstructure:"scrape_physical_disks"`
+
+	// MinDeviceLifetime delays the reporting of the devices that appear after the first scrape, such as
+	// hot-plugged USB drives or attached cloud volumes, until they have been present for that long.
+	// This avoids short-lived series for devices that come and go. Devices present at the first
+	// scrape are reported right away. This option is not supported on Windows.
+	MinDeviceLifetime time.Duration `mapstructure:"min_device_lifetime"`
+
+	// SuppressIdleDevices skips emitting metrics for devices whose I/O counters did not
+	// change since the previous scrape. A device is reported again as soon as it resumes
+	// activity. This option is not supported on Windows.
+	SuppressIdleDevices bool `mapstructure:"suppress_idle_devices"`
+
+	// EmitTotal additionally reports a synthetic `_total` device holding the sum of the
+	// included whole-disk devices. Partitions and devices stacked on top of other devices
+	// (e.g. device-mapper) are left out of the sum so that I/O is not counted twice.
+	// This option is not supported on Windows.
+	EmitTotal bool `mapstructure:"emit_total"`
+
+	// TotalOnly reports the synthetic `_total` device instead of the per-device series, which
+	// keeps the cardinality constant on hosts with many devices. It implies EmitTotal, and
+	// SuppressIdleDevices has no effect when it is set. This option is not supported on Windows.
+	TotalOnly bool `mapstructure:"total_only"`
+
+	// UseDMName reports the name of device-mapper devices, as set up by LVM or cryptsetup (e.g. `vg0-data`
+	// for `dm-3`), as the `device` attribute instead of the kernel name. The `include` and `exclude` filters
+	// still match kernel names. This option is only supported on Linux.
+	UseDMName bool `mapstructure:"use_dm_name"`
+
+	// ReportAsRate reports the cumulative byte, operation and time metrics as gauges holding their
+	// per-second rate of change since the previous scrape, for backends that do not handle cumulative
+	// sums well. Their units get a `/s` suffix. Nothing is reported for a device on its first scrape.
+	// This option is not supported on Windows.
+	ReportAsRate bool `mapstructure:"report_as_rate"`
+
+	// TopProcesses is the number of processes reported by the `system.disk.process.io` metric, which are
+	// the processes that read and wrote the most bytes since the previous scrape. It defaults to 10.
+	TopProcesses int `mapstructure:"top_processes"`
 }
 
 type MatchConfig struct {
@@ -8,6 +8,8 @@ package diskscraper // import "github.com/open-telemetry/opentelemetry-collector
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/common"
@@ -20,14 +22,41 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper/internal/metadata"
 )
 
 const (
 	standardMetricsLen = 5
 	metricsLen         = standardMetricsLen + systemSpecificMetricsLen
+
+	// reportPartitionsAll, reportPartitionsNone and reportPartitionsOnly are the values of `report_partitions`.
+	reportPartitionsAll  = "all"
+	reportPartitionsNone = "none"
+	reportPartitionsOnly = "only"
+
+	// totalDevice is the name of the synthetic device reported when `emit_total` is enabled.
+	totalDevice = "_total"
+
+	smartMetricsLen   = 4
+	zpoolMetricsLen   = 3
+	processMetricsLen = 1
 )
 
+// rateMetrics are the cumulative metrics converted to per-second rates when `report_as_rate` is enabled.
+var rateMetrics = map[string]bool{
+	"system.disk.io":                 true,
+	"system.disk.operations":         true,
+	"system.disk.io_time":            true,
+	"system.disk.operation_time":     true,
+	"system.disk.weighted_io_time":   true,
+	"system.disk.merged":             true,
+	"system.disk.discard.io":         true,
+	"system.disk.discard.operations": true,
+	"system.disk.flush.operations":   true,
+	"system.disk.flush.time":         true,
+}
+
 // scraper for Disk Metrics
 type scraper struct {
 	settings  receiver.CreateSettings
@@ -37,17 +66,140 @@ type scraper struct {
 	includeFS filterset.FilterSet
 	excludeFS filterset.FilterSet
 
+	filterTelemetry *internal.FilterTelemetry
+
+	// prevIOCounters holds the counters of the previous scrape, used to detect idle devices.
+	prevIOCounters map[string]disk.IOCountersStat
+	// devices caches the metadata of each device, see lookupDevices.
+	devices map[string]deviceMetadata
+	// prevIOTime holds the io time of each device at its previous scrape, used to compute utilization.
+	prevIOTime map[string]ioTimeSample
+	// prevValues holds the value of each data point converted to a rate at the previous scrape.
+	prevValues map[rateKey]rateSample
+	// firstSeen holds the time each device currently present was first seen at, see trackDevices.
+	firstSeen map[string]pcommon.Timestamp
+	// prevOperations holds the operation count and time of each device and direction at the previous scrape,
+	// used to compute the operation latency.
+	prevOperations map[latencyKey]operationsSample
+	// prevProcessIO holds the bytes transferred by each process at the previous scrape, see recordTopProcesses.
+	prevProcessIO map[int32]uint64
+
 	// for mocking
-	bootTime   func(context.Context) (uint64, error)
-	ioCounters func(ctx context.Context, names ...string) (map[string]disk.IOCountersStat, error)
+	bootTime       func(context.Context) (uint64, error)
+	ioCounters     func(ctx context.Context, names ...string) (map[string]disk.IOCountersStat, error)
+	isWholeDisk    func(ctx context.Context, device string) bool
+	isPartition    func(ctx context.Context, device string) bool
+	deviceMetadata func(ctx context.Context, device string) deviceMetadata
+	inflight       func(ctx context.Context, device string) (read int64, write int64, ok bool)
+	extendedStat   func(ctx context.Context, device string) (extendedStat, bool)
+	smartLogs      func(ctx context.Context) (map[string]smartLog, error)
+	zpoolStats     func(ctx context.Context) (map[string]zpoolStat, error)
+	processIO      func(ctx context.Context) (map[int32]processIO, error)
+	mountpoints    func(ctx context.Context) map[string]string
+}
+
+// smartLog holds the SMART health information of an NVMe controller.
+type smartLog struct {
+	temperature     float64 // degrees Celsius
+	percentageUsed  int64
+	mediaErrors     uint64
+	unsafeShutdowns uint64
+}
+
+// extendedStat holds the discard and flush counters of a device, which the kernel reports
+// next to the counters of disk.IOCountersStat.
+type extendedStat struct {
+	discards     uint64
+	discardBytes uint64
+	// flushOK is false on kernels older than 5.5, which do not count flushes
+	flushOK   bool
+	flushes   uint64
+	flushTime uint64 // milliseconds
+}
+
+// processIO holds the name and the storage I/O counters of a process.
+type processIO struct {
+	name       string
+	readBytes  uint64
+	writeBytes uint64
+}
+
+// zpoolStat holds the health state and the I/O counters of a ZFS pool.
+type zpoolStat struct {
+	state      string
+	reads      uint64
+	writes     uint64
+	readBytes  uint64
+	writeBytes uint64
+}
+
+// rateKey identifies a data point converted to a rate.
+type rateKey struct {
+	metric    string
+	device    string
+	direction string
+}
+
+// rateSample is the value of a cumulative data point at a given time.
+type rateSample struct {
+	value float64
+	ts    pcommon.Timestamp
+}
+
+// ioTimeSample is the io time of a device, in milliseconds, at a given time.
+type ioTimeSample struct {
+	ioTime uint64
+	ts     pcommon.Timestamp
+}
+
+// latencyKey identifies the operation latency of a device and direction.
+type latencyKey struct {
+	device    string
+	direction metadata.AttributeDirection
+}
+
+// operationsSample is the operation count and time, in milliseconds, of a device and direction.
+type operationsSample struct {
+	count uint64
+	time  uint64
+}
+
+// deviceMetadata holds the metadata of a device read from sysfs. Fields that could not be
+// read are left empty, and rotationalOK is false when the media type is unknown.
+type deviceMetadata struct {
+	rotational   bool
+	rotationalOK bool
+	model        string
+	serial       string
+	scheduler    string
+	dmName       string
+	raidArray    string
+	transport    string
 }
 
 // newDiskScraper creates a Disk Scraper
 func newDiskScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) (*scraper, error) {
-	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, ioCounters: disk.IOCountersWithContext}
+	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, ioCounters: disk.IOCountersWithContext, isWholeDisk: isWholeDisk, isPartition: isPartition, deviceMetadata: readDeviceMetadata, inflight: readInflight, extendedStat: readExtendedStat, smartLogs: readSMARTLogs, zpoolStats: readZpoolStats, processIO: readProcessIO, mountpoints: readMountpoints}
+
+	if cfg.CgroupPath != "" {
+		scraper.ioCounters = func(ctx context.Context, _ ...string) (map[string]disk.IOCountersStat, error) {
+			return readCgroupIOStat(ctx, cfg.CgroupPath)
+		}
+	}
 
 	var err error
 
+	switch cfg.ReportPartitions {
+	case "", reportPartitionsAll, reportPartitionsNone, reportPartitionsOnly:
+	default:
+		return nil, fmt.Errorf("invalid report_partitions %q, must be one of %q, %q or %q",
+			cfg.ReportPartitions, reportPartitionsAll, reportPartitionsNone, reportPartitionsOnly)
+	}
+
+	if cfg.Metrics.SystemDiskProcessIo.Enabled && cfg.TopProcesses <= 0 {
+		return nil, fmt.Errorf("invalid top_processes %d, must be positive", cfg.TopProcesses)
+	}
+
 	if len(cfg.Include.Devices) > 0 {
 		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Devices, &cfg.Include.Config)
 		if err != nil {
@@ -62,6 +214,10 @@ func newDiskScraper(_ context.Context, settings receiver.CreateSettings, cfg *Co
 		}
 	}
 
+	if scraper.filterTelemetry, err = internal.NewFilterTelemetry(settings.TelemetrySettings, TypeStr); err != nil {
+		return nil, err
+	}
+
 	return scraper, nil
 }
 
@@ -87,66 +243,561 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	}
 
 	// filter devices by name
-	ioCounters = s.filterByDevice(ioCounters)
+	ioCounters = s.filterByDevice(ctx, ioCounters)
+	s.trackDevices(now, ioCounters)
+
+	// the total is computed before dropping idle devices and partitions, as they still contribute to it
+	emitTotal := s.config.EmitTotal || s.config.TotalOnly
+	var total disk.IOCountersStat
+	if emitTotal {
+		total = s.sumWholeDisks(ctx, ioCounters)
+	}
+
+	if s.config.TotalOnly {
+		ioCounters = make(map[string]disk.IOCountersStat, 1)
+	} else {
+		ioCounters = s.filterPartitions(ctx, ioCounters)
+		if s.config.MinDeviceLifetime > 0 {
+			ioCounters = s.filterShortLivedDevices(now, ioCounters)
+		}
+		if s.config.SuppressIdleDevices {
+			ioCounters = s.filterIdleDevices(ioCounters)
+		}
+	}
+
+	if emitTotal {
+		ioCounters[totalDevice] = total
+	}
+
+	if s.deviceMetadataEnabled() {
+		s.lookupDevices(ctx, ioCounters)
+	}
+	if s.deviceResourceEnabled() {
+		// the metrics of each device are emitted under a resource holding its metadata
+		var mountpoints map[string]string
+		if s.config.ResourceAttributes.Mountpoint.Enabled {
+			mountpoints = s.mountpoints(ctx)
+		}
+		for device, ioCounter := range ioCounters {
+			s.recordDeviceMetrics(ctx, now, map[string]disk.IOCountersStat{device: ioCounter})
+			s.mb.EmitForResource(metadata.WithResource(s.deviceResource(device, mountpoints)))
+		}
+	} else if len(ioCounters) > 0 {
+		s.recordDeviceMetrics(ctx, now, ioCounters)
+	}
+	s.forgetDevices(ioCounters)
 
-	if len(ioCounters) > 0 {
-		s.recordDiskIOMetric(now, ioCounters)
-		s.recordDiskOperationsMetric(now, ioCounters)
-		s.recordDiskIOTimeMetric(now, ioCounters)
-		s.recordDiskOperationTimeMetric(now, ioCounters)
-		s.recordDiskPendingOperationsMetric(now, ioCounters)
-		s.recordSystemSpecificDataPoints(now, ioCounters)
+	var smartErr error
+	if s.smartMetricsEnabled() {
+		smartErr = s.recordSMARTMetrics(ctx, now)
+	}
+	var zpoolErr error
+	if s.zpoolMetricsEnabled() {
+		zpoolErr = s.recordZpoolMetrics(ctx, now)
+	}
+
+	var processErr error
+	if s.config.Metrics.SystemDiskProcessIo.Enabled {
+		processErr = s.recordDiskProcessIOMetric(ctx, now)
 	}
 
-	return s.mb.Emit(), nil
+	md := s.mb.Emit()
+	if s.config.ReportAsRate {
+		s.convertToRates(md)
+	}
+
+	var errs scrapererror.ScrapeErrors
+	if smartErr != nil {
+		errs.AddPartial(smartMetricsLen, smartErr)
+	}
+	if zpoolErr != nil {
+		errs.AddPartial(zpoolMetricsLen, zpoolErr)
+	}
+	if processErr != nil {
+		errs.AddPartial(processMetricsLen, processErr)
+	}
+	return md, errs.Combine()
+}
+
+// recordDeviceMetrics records the metrics of the devices of ioCounters.
+func (s *scraper) recordDeviceMetrics(ctx context.Context, now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
+	s.recordDiskIOMetric(now, ioCounters)
+	s.recordDiskOperationsMetric(now, ioCounters)
+	// the io.stat file of a cgroup only holds byte and operation counts
+	if s.config.CgroupPath != "" {
+		return
+	}
+	s.recordDiskIOTimeMetric(now, ioCounters)
+	s.recordDiskOperationTimeMetric(now, ioCounters)
+	s.recordDiskPendingOperationsMetric(now, ioCounters)
+	if s.config.Metrics.SystemDiskUtilization.Enabled {
+		s.recordDiskUtilizationMetric(now, ioCounters)
+	}
+	if s.config.Metrics.SystemDiskOperationLatency.Enabled {
+		s.recordDiskOperationLatencyMetric(now, ioCounters)
+	}
+	if s.config.Metrics.SystemDiskInflight.Enabled {
+		s.recordDiskInflightMetric(ctx, now, ioCounters)
+	}
+	if s.extendedMetricsEnabled() {
+		s.recordDiskExtendedMetrics(ctx, now, ioCounters)
+	}
+	s.recordSystemSpecificDataPoints(now, ioCounters)
 }
 
 func (s *scraper) recordDiskIOMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
 	for device, ioCounter := range ioCounters {
-		s.mb.RecordSystemDiskIoDataPoint(now, int64(ioCounter.ReadBytes), device, metadata.AttributeDirectionRead)
-		s.mb.RecordSystemDiskIoDataPoint(now, int64(ioCounter.WriteBytes), device, metadata.AttributeDirectionWrite)
+		s.mb.RecordSystemDiskIoDataPoint(now, int64(ioCounter.ReadBytes), s.deviceName(device), metadata.AttributeDirectionRead)
+		s.mb.RecordSystemDiskIoDataPoint(now, int64(ioCounter.WriteBytes), s.deviceName(device), metadata.AttributeDirectionWrite)
 	}
 }
 
 func (s *scraper) recordDiskOperationsMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
 	for device, ioCounter := range ioCounters {
-		s.mb.RecordSystemDiskOperationsDataPoint(now, int64(ioCounter.ReadCount), device, metadata.AttributeDirectionRead)
-		s.mb.RecordSystemDiskOperationsDataPoint(now, int64(ioCounter.WriteCount), device, metadata.AttributeDirectionWrite)
+		s.mb.RecordSystemDiskOperationsDataPoint(now, int64(ioCounter.ReadCount), s.deviceName(device), metadata.AttributeDirectionRead)
+		s.mb.RecordSystemDiskOperationsDataPoint(now, int64(ioCounter.WriteCount), s.deviceName(device), metadata.AttributeDirectionWrite)
 	}
 }
 
 func (s *scraper) recordDiskIOTimeMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
 	for device, ioCounter := range ioCounters {
-		s.mb.RecordSystemDiskIoTimeDataPoint(now, float64(ioCounter.IoTime)/1e3, device)
+		s.mb.RecordSystemDiskIoTimeDataPoint(now, float64(ioCounter.IoTime)/1e3, s.deviceName(device))
 	}
 }
 
 func (s *scraper) recordDiskOperationTimeMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
 	for device, ioCounter := range ioCounters {
-		s.mb.RecordSystemDiskOperationTimeDataPoint(now, float64(ioCounter.ReadTime)/1e3, device, metadata.AttributeDirectionRead)
-		s.mb.RecordSystemDiskOperationTimeDataPoint(now, float64(ioCounter.WriteTime)/1e3, device, metadata.AttributeDirectionWrite)
+		s.mb.RecordSystemDiskOperationTimeDataPoint(now, float64(ioCounter.ReadTime)/1e3, s.deviceName(device), metadata.AttributeDirectionRead)
+		s.mb.RecordSystemDiskOperationTimeDataPoint(now, float64(ioCounter.WriteTime)/1e3, s.deviceName(device), metadata.AttributeDirectionWrite)
 	}
 }
 
 func (s *scraper) recordDiskPendingOperationsMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
 	for device, ioCounter := range ioCounters {
-		s.mb.RecordSystemDiskPendingOperationsDataPoint(now, int64(ioCounter.IopsInProgress), device)
+		s.mb.RecordSystemDiskPendingOperationsDataPoint(now, int64(ioCounter.IopsInProgress), s.deviceName(device))
+	}
+}
+
+// recordDiskUtilizationMetric records the fraction of the time elapsed since the previous scrape
+// of each device during which it was busy. Nothing is recorded for a device on its first scrape,
+// after its counters were reset, or for the synthetic total device, whose io time spans several disks.
+func (s *scraper) recordDiskUtilizationMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
+	if s.prevIOTime == nil {
+		s.prevIOTime = make(map[string]ioTimeSample, len(ioCounters))
+	}
+	for device, ioCounter := range ioCounters {
+		if device == totalDevice {
+			continue
+		}
+		prev, ok := s.prevIOTime[device]
+		s.prevIOTime[device] = ioTimeSample{ioTime: ioCounter.IoTime, ts: now}
+		if !ok || ioCounter.IoTime < prev.ioTime || now <= prev.ts {
+			continue
+		}
+		busy := float64(ioCounter.IoTime-prev.ioTime) / 1e3
+		elapsed := now.AsTime().Sub(prev.ts.AsTime()).Seconds()
+		s.mb.RecordSystemDiskUtilizationDataPoint(now, math.Min(busy/elapsed, 1), s.deviceName(device))
 	}
 }
 
-func (s *scraper) filterByDevice(ioCounters map[string]disk.IOCountersStat) map[string]disk.IOCountersStat {
+// recordDiskOperationLatencyMetric records the average latency of the operations completed by each device
+// since the previous scrape, split by direction. Nothing is recorded on the first scrape of a device, after
+// its counters were reset, nor when it completed no operation.
+func (s *scraper) recordDiskOperationLatencyMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
+	if s.prevOperations == nil {
+		s.prevOperations = make(map[latencyKey]operationsSample, 2*len(ioCounters))
+	}
+	record := func(key latencyKey, sample operationsSample) {
+		prev, ok := s.prevOperations[key]
+		s.prevOperations[key] = sample
+		if !ok || sample.count <= prev.count || sample.time < prev.time {
+			return
+		}
+		latency := float64(sample.time-prev.time) / 1e3 / float64(sample.count-prev.count)
+		s.mb.RecordSystemDiskOperationLatencyDataPoint(now, latency, s.deviceName(key.device), key.direction)
+	}
+	for device, ioCounter := range ioCounters {
+		record(latencyKey{device, metadata.AttributeDirectionRead}, operationsSample{count: ioCounter.ReadCount, time: ioCounter.ReadTime})
+		record(latencyKey{device, metadata.AttributeDirectionWrite}, operationsSample{count: ioCounter.WriteCount, time: ioCounter.WriteTime})
+	}
+}
+
+// recordDiskInflightMetric records the number of in-flight requests of each device, split by direction.
+// Nothing is recorded for the devices whose counts cannot be read, nor for the synthetic total device.
+func (s *scraper) recordDiskInflightMetric(ctx context.Context, now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
+	for device := range ioCounters {
+		if device == totalDevice {
+			continue
+		}
+		read, write, ok := s.inflight(ctx, device)
+		if !ok {
+			continue
+		}
+		s.mb.RecordSystemDiskInflightDataPoint(now, read, s.deviceName(device), metadata.AttributeDirectionRead)
+		s.mb.RecordSystemDiskInflightDataPoint(now, write, s.deviceName(device), metadata.AttributeDirectionWrite)
+	}
+}
+
+func (s *scraper) extendedMetricsEnabled() bool {
+	return s.config.Metrics.SystemDiskDiscardIo.Enabled ||
+		s.config.Metrics.SystemDiskDiscardOperations.Enabled ||
+		s.config.Metrics.SystemDiskFlushOperations.Enabled ||
+		s.config.Metrics.SystemDiskFlushTime.Enabled
+}
+
+// recordDiskExtendedMetrics records the discard and flush metrics of each device. Nothing is recorded
+// for the devices whose counters cannot be read, nor for the synthetic total device.
+func (s *scraper) recordDiskExtendedMetrics(ctx context.Context, now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
+	for device := range ioCounters {
+		if device == totalDevice {
+			continue
+		}
+		stat, ok := s.extendedStat(ctx, device)
+		if !ok {
+			continue
+		}
+		s.mb.RecordSystemDiskDiscardIoDataPoint(now, int64(stat.discardBytes), s.deviceName(device))
+		s.mb.RecordSystemDiskDiscardOperationsDataPoint(now, int64(stat.discards), s.deviceName(device))
+		if stat.flushOK {
+			s.mb.RecordSystemDiskFlushOperationsDataPoint(now, int64(stat.flushes), s.deviceName(device))
+			s.mb.RecordSystemDiskFlushTimeDataPoint(now, float64(stat.flushTime)/1e3, s.deviceName(device))
+		}
+	}
+}
+
+func (s *scraper) smartMetricsEnabled() bool {
+	return s.config.Metrics.SystemDiskSmartTemperature.Enabled ||
+		s.config.Metrics.SystemDiskSmartPercentageUsed.Enabled ||
+		s.config.Metrics.SystemDiskSmartMediaErrors.Enabled ||
+		s.config.Metrics.SystemDiskSmartUnsafeShutdowns.Enabled
+}
+
+// recordSMARTMetrics records the SMART health metrics of the NVMe controllers matching the device
+// filters. The metrics of the controllers that could be read are recorded even if others failed.
+func (s *scraper) recordSMARTMetrics(ctx context.Context, now pcommon.Timestamp) error {
+	logs, err := s.smartLogs(ctx)
+	for device, log := range logs {
+		if !s.includeDevice(device) {
+			continue
+		}
+		s.mb.RecordSystemDiskSmartTemperatureDataPoint(now, log.temperature, device)
+		s.mb.RecordSystemDiskSmartPercentageUsedDataPoint(now, log.percentageUsed, device)
+		s.mb.RecordSystemDiskSmartMediaErrorsDataPoint(now, int64(log.mediaErrors), device)
+		s.mb.RecordSystemDiskSmartUnsafeShutdownsDataPoint(now, int64(log.unsafeShutdowns), device)
+	}
+	return err
+}
+
+func (s *scraper) zpoolMetricsEnabled() bool {
+	return s.config.Metrics.SystemDiskZpoolHealth.Enabled ||
+		s.config.Metrics.SystemDiskZpoolIo.Enabled ||
+		s.config.Metrics.SystemDiskZpoolOperations.Enabled
+}
+
+// recordZpoolMetrics records the health and I/O metrics of the ZFS pools matching the device
+// filters. The metrics of the pools that could be read are recorded even if others failed.
+func (s *scraper) recordZpoolMetrics(ctx context.Context, now pcommon.Timestamp) error {
+	stats, err := s.zpoolStats(ctx)
+	for pool, stat := range stats {
+		if !s.includeDevice(pool) {
+			continue
+		}
+		s.mb.RecordSystemDiskZpoolHealthDataPoint(now, 1, pool, stat.state)
+		s.mb.RecordSystemDiskZpoolIoDataPoint(now, int64(stat.readBytes), pool, metadata.AttributeDirectionRead)
+		s.mb.RecordSystemDiskZpoolIoDataPoint(now, int64(stat.writeBytes), pool, metadata.AttributeDirectionWrite)
+		s.mb.RecordSystemDiskZpoolOperationsDataPoint(now, int64(stat.reads), pool, metadata.AttributeDirectionRead)
+		s.mb.RecordSystemDiskZpoolOperationsDataPoint(now, int64(stat.writes), pool, metadata.AttributeDirectionWrite)
+	}
+	return err
+}
+
+// recordDiskProcessIOMetric records the bytes read and written by the `top_processes` processes that transferred
+// the most bytes since the previous scrape, or since they started for the processes that were not seen before.
+// Processes that did not transfer anything are never reported.
+func (s *scraper) recordDiskProcessIOMetric(ctx context.Context, now pcommon.Timestamp) error {
+	procs, err := s.processIO(ctx)
+	if err != nil {
+		return err
+	}
+	prevProcessIO := s.prevProcessIO
+	s.prevProcessIO = make(map[int32]uint64, len(procs))
+	type activity struct {
+		pid   int32
+		bytes uint64
+	}
+	ranking := make([]activity, 0, len(procs))
+	for pid, proc := range procs {
+		bytes := proc.readBytes + proc.writeBytes
+		s.prevProcessIO[pid] = bytes
+		// a lower count means the pid was reused by a new process
+		if prev, ok := prevProcessIO[pid]; ok && prev <= bytes {
+			bytes -= prev
+		}
+		if bytes > 0 {
+			ranking = append(ranking, activity{pid: pid, bytes: bytes})
+		}
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].bytes != ranking[j].bytes {
+			return ranking[i].bytes > ranking[j].bytes
+		}
+		return ranking[i].pid < ranking[j].pid
+	})
+	if len(ranking) > s.config.TopProcesses {
+		ranking = ranking[:s.config.TopProcesses]
+	}
+
+	for _, a := range ranking {
+		proc := procs[a.pid]
+		s.mb.RecordSystemDiskProcessIoDataPoint(now, int64(proc.readBytes), int64(a.pid), proc.name, metadata.AttributeDirectionRead)
+		s.mb.RecordSystemDiskProcessIoDataPoint(now, int64(proc.writeBytes), int64(a.pid), proc.name, metadata.AttributeDirectionWrite)
+	}
+	return nil
+}
+
+// convertToRates replaces the cumulative sums listed in rateMetrics with gauges holding their
+// per-second rate of change since the previous scrape. A data point is dropped on the first scrape
+// of its device and after its counter was reset, and so are the metrics and resources left without data points.
+func (s *scraper) convertToRates(md pmetric.Metrics) {
+	prevValues := s.prevValues
+	s.prevValues = make(map[rateKey]rateSample, len(prevValues))
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		s.convertResourceToRates(md.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics(), prevValues)
+	}
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		return rm.ScopeMetrics().At(0).Metrics().Len() == 0
+	})
+
+	// keep the samples of the devices suppressed as idle, so their rate spans the idle scrapes
+	if s.config.SuppressIdleDevices {
+		for key, prev := range prevValues {
+			if _, ok := s.prevValues[key]; !ok {
+				s.prevValues[key] = prev
+			}
+		}
+	}
+}
+
+func (s *scraper) convertResourceToRates(metrics pmetric.MetricSlice, prevValues map[rateKey]rateSample) {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Type() != pmetric.MetricTypeSum || !rateMetrics[metric.Name()] {
+			continue
+		}
+		gauge := pmetric.NewGauge()
+		dps := metric.Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			device, _ := dp.Attributes().Get("device")
+			direction, _ := dp.Attributes().Get("direction")
+			key := rateKey{metric: metric.Name(), device: device.Str(), direction: direction.Str()}
+			value := dp.DoubleValue()
+			if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+				value = float64(dp.IntValue())
+			}
+			s.prevValues[key] = rateSample{value: value, ts: dp.Timestamp()}
+
+			prev, ok := prevValues[key]
+			if !ok || value < prev.value || dp.Timestamp() <= prev.ts {
+				continue
+			}
+			rate := gauge.DataPoints().AppendEmpty()
+			rate.SetTimestamp(dp.Timestamp())
+			rate.SetDoubleValue((value - prev.value) / dp.Timestamp().AsTime().Sub(prev.ts.AsTime()).Seconds())
+			dp.Attributes().CopyTo(rate.Attributes())
+		}
+		metric.SetUnit(metric.Unit() + "/s")
+		gauge.MoveTo(metric.SetEmptyGauge())
+	}
+	metrics.RemoveIf(func(metric pmetric.Metric) bool {
+		return metric.Type() == pmetric.MetricTypeGauge && rateMetrics[metric.Name()] && metric.Gauge().DataPoints().Len() == 0
+	})
+}
+
+func (s *scraper) filterByDevice(ctx context.Context, ioCounters map[string]disk.IOCountersStat) map[string]disk.IOCountersStat {
 	if s.includeFS == nil && s.excludeFS == nil {
 		return ioCounters
 	}
 
+	filtered := 0
 	for device := range ioCounters {
 		if !s.includeDevice(device) {
 			delete(ioCounters, device)
+			filtered++
+		}
+	}
+	s.filterTelemetry.RecordFiltered(ctx, filtered)
+	return ioCounters
+}
+
+// filterPartitions removes the partitions or the other devices, depending on `report_partitions`.
+func (s *scraper) filterPartitions(ctx context.Context, ioCounters map[string]disk.IOCountersStat) map[string]disk.IOCountersStat {
+	if s.config.ReportPartitions != reportPartitionsNone && s.config.ReportPartitions != reportPartitionsOnly {
+		return ioCounters
+	}
+	keepPartitions := s.config.ReportPartitions == reportPartitionsOnly
+	for device := range ioCounters {
+		if s.isPartition(ctx, device) != keepPartitions {
+			delete(ioCounters, device)
 		}
 	}
 	return ioCounters
 }
 
+// trackDevices records the time each device was first seen at. Devices that disappear are forgotten,
+// so that a device plugged again is handled as a new one. The devices present at the first scrape
+// were there before the scraper started, and are tracked as seen from the start of the epoch.
+func (s *scraper) trackDevices(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
+	firstSeen := s.firstSeen
+	s.firstSeen = make(map[string]pcommon.Timestamp, len(ioCounters))
+	for device := range ioCounters {
+		ts, ok := firstSeen[device]
+		switch {
+		case ok:
+		case firstSeen == nil:
+			ts = 0
+		default:
+			ts = now
+		}
+		s.firstSeen[device] = ts
+	}
+}
+
+// filterShortLivedDevices removes the devices that were first seen less than `min_device_lifetime` ago.
+func (s *scraper) filterShortLivedDevices(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) map[string]disk.IOCountersStat {
+	for device := range ioCounters {
+		if now.AsTime().Sub(s.firstSeen[device].AsTime()) < s.config.MinDeviceLifetime {
+			delete(ioCounters, device)
+		}
+	}
+	return ioCounters
+}
+
+// filterIdleDevices removes the devices whose counters did not change since the previous scrape.
+// Devices seen for the first time are always kept.
+func (s *scraper) filterIdleDevices(ioCounters map[string]disk.IOCountersStat) map[string]disk.IOCountersStat {
+	prevIOCounters := s.prevIOCounters
+	s.prevIOCounters = make(map[string]disk.IOCountersStat, len(ioCounters))
+	for device, ioCounter := range ioCounters {
+		s.prevIOCounters[device] = ioCounter
+		if prev, ok := prevIOCounters[device]; ok && prev == ioCounter {
+			delete(ioCounters, device)
+		}
+	}
+	return ioCounters
+}
+
+// sumWholeDisks returns the sum of the counters of the whole-disk devices.
+func (s *scraper) sumWholeDisks(ctx context.Context, ioCounters map[string]disk.IOCountersStat) disk.IOCountersStat {
+	total := disk.IOCountersStat{Name: totalDevice}
+	for device, ioCounter := range ioCounters {
+		if !s.isWholeDisk(ctx, device) {
+			continue
+		}
+		total.ReadCount += ioCounter.ReadCount
+		total.MergedReadCount += ioCounter.MergedReadCount
+		total.WriteCount += ioCounter.WriteCount
+		total.MergedWriteCount += ioCounter.MergedWriteCount
+		total.ReadBytes += ioCounter.ReadBytes
+		total.WriteBytes += ioCounter.WriteBytes
+		total.ReadTime += ioCounter.ReadTime
+		total.WriteTime += ioCounter.WriteTime
+		total.IopsInProgress += ioCounter.IopsInProgress
+		total.IoTime += ioCounter.IoTime
+		total.WeightedIO += ioCounter.WeightedIO
+	}
+	return total
+}
+
+// deviceMetadataEnabled returns whether the metadata of the devices is needed, see lookupDevices.
+func (s *scraper) deviceMetadataEnabled() bool {
+	return s.deviceResourceEnabled() || s.config.UseDMName
+}
+
+// deviceResourceEnabled returns whether a resource attribute holding device metadata is enabled.
+func (s *scraper) deviceResourceEnabled() bool {
+	ra := s.config.ResourceAttributes
+	return ra.DiskRotational.Enabled || ra.DiskModel.Enabled || ra.DiskSerial.Enabled || ra.DiskScheduler.Enabled ||
+		ra.DiskDmName.Enabled || ra.DiskTransport.Enabled || ra.RaidArray.Enabled || ra.Mountpoint.Enabled
+}
+
+// lookupDevices reads the metadata of the devices of ioCounters. The metadata of a device is read once
+// and then cached for as long as the device is reported, so that the metadata of a removed device is
+// not reused when its name is given to another device (e.g. a new device-mapper device).
+func (s *scraper) lookupDevices(ctx context.Context, ioCounters map[string]disk.IOCountersStat) {
+	devices := s.devices
+	s.devices = make(map[string]deviceMetadata, len(ioCounters))
+	for device := range ioCounters {
+		if device == totalDevice {
+			continue
+		}
+		dm, ok := devices[device]
+		if !ok {
+			dm = s.deviceMetadata(ctx, device)
+		}
+		s.devices[device] = dm
+	}
+}
+
+// deviceName returns the value of the `device` attribute of a device, which is its device-mapper
+// name when `use_dm_name` is enabled and the device has one, and its kernel name otherwise.
+func (s *scraper) deviceName(device string) string {
+	if dmName := s.devices[device].dmName; s.config.UseDMName && dmName != "" {
+		return dmName
+	}
+	return device
+}
+
+// deviceResource returns the resource of the metrics of a device, holding the enabled metadata attributes.
+// The attributes whose value is unknown are left out.
+func (s *scraper) deviceResource(device string, mountpoints map[string]string) pcommon.Resource {
+	rb := s.mb.NewResourceBuilder()
+	dm := s.devices[device]
+	if dm.rotationalOK {
+		rb.SetDiskRotational(dm.rotational)
+	}
+	if dm.model != "" {
+		rb.SetDiskModel(dm.model)
+	}
+	if dm.serial != "" {
+		rb.SetDiskSerial(dm.serial)
+	}
+	if dm.scheduler != "" {
+		rb.SetDiskScheduler(dm.scheduler)
+	}
+	if dm.dmName != "" {
+		rb.SetDiskDmName(dm.dmName)
+	}
+	if dm.transport != "" {
+		rb.SetDiskTransport(dm.transport)
+	}
+	if dm.raidArray != "" {
+		rb.SetRaidArray(dm.raidArray)
+	}
+	if mountpoint, ok := mountpoints[device]; ok {
+		rb.SetMountpoint(mountpoint)
+	}
+	return rb.Emit()
+}
+
+// forgetDevices drops the samples kept for the devices that are not reported anymore. The samples of the
+// devices suppressed as idle are kept, so that their utilization and latency span the idle scrapes.
+func (s *scraper) forgetDevices(ioCounters map[string]disk.IOCountersStat) {
+	if s.config.SuppressIdleDevices {
+		return
+	}
+	for device := range s.prevIOTime {
+		if _, ok := ioCounters[device]; !ok {
+			delete(s.prevIOTime, device)
+		}
+	}
+	for key := range s.prevOperations {
+		if _, ok := ioCounters[key.device]; !ok {
+			delete(s.prevOperations, key)
+		}
+	}
+}
+
 func (s *scraper) includeDevice(deviceName string) bool {
 	return (s.includeFS == nil || s.includeFS.Matches(deviceName)) &&
 		(s.excludeFS == nil || !s.excludeFS.Matches(deviceName))
@@ -6,6 +6,9 @@
 package diskscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 
 import (
+	"context"
+	"errors"
+
 	"github.com/shirou/gopsutil/v3/disk"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
@@ -14,3 +17,43 @@ const systemSpecificMetricsLen = 0
 
 func (s *scraper) recordSystemSpecificDataPoints(_ pcommon.Timestamp, _ map[string]disk.IOCountersStat) {
 }
+
+func isWholeDisk(context.Context, string) bool {
+	return true
+}
+
+func isPartition(context.Context, string) bool {
+	return false
+}
+
+func readDeviceMetadata(context.Context, string) deviceMetadata {
+	return deviceMetadata{}
+}
+
+func readSMARTLogs(context.Context) (map[string]smartLog, error) {
+	return nil, nil
+}
+
+func readExtendedStat(context.Context, string) (extendedStat, bool) {
+	return extendedStat{}, false
+}
+
+func readProcessIO(context.Context) (map[int32]processIO, error) {
+	return nil, errors.New("system.disk.process.io is only supported on Linux")
+}
+
+func readMountpoints(context.Context) map[string]string {
+	return nil
+}
+
+func readZpoolStats(context.Context) (map[string]zpoolStat, error) {
+	return nil, nil
+}
+
+func readInflight(context.Context, string) (int64, int64, bool) {
+	return 0, 0, false
+}
+
+func readCgroupIOStat(context.Context, string) (map[string]disk.IOCountersStat, error) {
+	return nil, errors.New("cgroup_path is only supported on Linux")
+}
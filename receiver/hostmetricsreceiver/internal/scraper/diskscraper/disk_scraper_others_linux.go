@@ -6,14 +6,60 @@
 package diskscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 
 import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"unsafe"
+
 	"github.com/shirou/gopsutil/v3/disk"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/multierr"
+	"golang.org/x/sys/unix"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper/internal/metadata"
 )
 
 const systemSpecificMetricsLen = 2
 
+const (
+	// nvmeIoctlAdminCmd is NVME_IOCTL_ADMIN_CMD, i.e. _IOWR('N', 0x41, struct nvme_admin_cmd).
+	nvmeIoctlAdminCmd = 0xC0484E41
+	// nvmeAdminGetLogPage is the opcode of the Get Log Page admin command.
+	nvmeAdminGetLogPage = 0x02
+	// nvmeLogSMART is the identifier of the SMART / Health Information log page.
+	nvmeLogSMART = 0x02
+	// nvmeSMARTLogLen is the length of the SMART / Health Information log page, in bytes.
+	nvmeSMARTLogLen = 512
+)
+
+// nvmeAdminCmd mirrors struct nvme_admin_cmd from linux/nvme_ioctl.h.
+type nvmeAdminCmd struct {
+	opcode      uint8
+	flags       uint8
+	rsvd1       uint16
+	nsid        uint32
+	cdw2        uint32
+	cdw3        uint32
+	metadata    uint64
+	addr        uint64
+	metadataLen uint32
+	dataLen     uint32
+	cdw10       uint32
+	cdw11       uint32
+	cdw12       uint32
+	cdw13       uint32
+	cdw14       uint32
+	cdw15       uint32
+	timeoutMs   uint32
+	result      uint32
+}
+
 func (s *scraper) recordSystemSpecificDataPoints(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
 	s.recordDiskWeightedIOTimeMetric(now, ioCounters)
 	s.recordDiskMergedMetric(now, ioCounters)
@@ -21,13 +67,466 @@ func (s *scraper) recordSystemSpecificDataPoints(now pcommon.Timestamp, ioCounte
 
 func (s *scraper) recordDiskWeightedIOTimeMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
 	for device, ioCounter := range ioCounters {
-		s.mb.RecordSystemDiskWeightedIoTimeDataPoint(now, float64(ioCounter.WeightedIO)/1e3, device)
+		s.mb.RecordSystemDiskWeightedIoTimeDataPoint(now, float64(ioCounter.WeightedIO)/1e3, s.deviceName(device))
 	}
 }
 
 func (s *scraper) recordDiskMergedMetric(now pcommon.Timestamp, ioCounters map[string]disk.IOCountersStat) {
 	for device, ioCounter := range ioCounters {
-		s.mb.RecordSystemDiskMergedDataPoint(now, int64(ioCounter.MergedReadCount), device, metadata.AttributeDirectionRead)
-		s.mb.RecordSystemDiskMergedDataPoint(now, int64(ioCounter.MergedWriteCount), device, metadata.AttributeDirectionWrite)
+		s.mb.RecordSystemDiskMergedDataPoint(now, int64(ioCounter.MergedReadCount), s.deviceName(device), metadata.AttributeDirectionRead)
+		s.mb.RecordSystemDiskMergedDataPoint(now, int64(ioCounter.MergedWriteCount), s.deviceName(device), metadata.AttributeDirectionWrite)
+	}
+}
+
+// isWholeDisk reports whether device is neither a partition nor stacked on top of other
+// block devices (e.g. device-mapper or md), based on the device's sysfs entry.
+func isWholeDisk(ctx context.Context, device string) bool {
+	if isPartition(ctx, device) {
+		return false
+	}
+	slaves, _ := os.ReadDir(filepath.Join(internal.SysPath(ctx), "class", "block", device, "slaves"))
+	return len(slaves) == 0
+}
+
+// isPartition reports whether device is a partition, based on the device's sysfs entry.
+func isPartition(ctx context.Context, device string) bool {
+	_, err := os.Stat(filepath.Join(internal.SysPath(ctx), "class", "block", device, "partition"))
+	return err == nil
+}
+
+// readDeviceMetadata reads the metadata of device from sysfs. Partitions and the synthetic
+// total device have no metadata of their own.
+func readDeviceMetadata(ctx context.Context, device string) deviceMetadata {
+	blockPath := filepath.Join(internal.SysPath(ctx), "block", device)
+	dm := deviceMetadata{
+		model:     readSysfsString(filepath.Join(blockPath, "device", "model")),
+		serial:    readSysfsString(filepath.Join(blockPath, "device", "serial")),
+		scheduler: activeScheduler(readSysfsString(filepath.Join(blockPath, "queue", "scheduler"))),
+		dmName:    readSysfsString(filepath.Join(blockPath, "dm", "name")),
+		raidArray: raidArray(ctx, device),
+	}
+	dm.transport = transport(ctx, device, dm.model)
+	if dm.serial == "" {
+		// virtio block devices expose their serial on the block device itself
+		dm.serial = readSysfsString(filepath.Join(blockPath, "serial"))
+	}
+	switch readSysfsString(filepath.Join(blockPath, "queue", "rotational")) {
+	case "1":
+		dm.rotational, dm.rotationalOK = true, true
+	case "0":
+		dm.rotational, dm.rotationalOK = false, true
+	}
+	return dm
+}
+
+// raidArray returns the md RAID array device is a member of, found among the holders of
+// device, or an empty string if device is not a RAID member.
+func raidArray(ctx context.Context, device string) string {
+	holders, _ := os.ReadDir(filepath.Join(internal.SysPath(ctx), "class", "block", device, "holders"))
+	for _, holder := range holders {
+		if strings.HasPrefix(holder.Name(), "md") {
+			return holder.Name()
+		}
+	}
+	return ""
+}
+
+// transport classifies how device is attached from its name, its model and its location in the sysfs
+// device tree. Virtual devices, such as device-mapper or md devices, have no transport.
+func transport(ctx context.Context, device, model string) string {
+	switch {
+	case strings.HasPrefix(device, "nbd"):
+		return "nbd"
+	case strings.HasPrefix(device, "rbd"):
+		return "rbd"
+	case model == "Amazon Elastic Block Store":
+		// EBS volumes are NVMe devices on Nitro instances
+		return "ebs"
+	}
+	// /sys/block/<device> links to the device in the tree of the buses it is attached through
+	path, err := filepath.EvalSymlinks(filepath.Join(internal.SysPath(ctx), "block", device))
+	if err != nil {
+		return ""
+	}
+	for _, component := range strings.Split(path, string(filepath.Separator)) {
+		switch {
+		case component == "virtual":
+			return ""
+		case strings.HasPrefix(component, "session"):
+			return "iscsi"
+		case strings.HasPrefix(component, "virtio"):
+			return "virtio"
+		case strings.HasPrefix(component, "vbd-"):
+			return "xen"
+		}
+	}
+	return "local"
+}
+
+// activeScheduler returns the active I/O scheduler of a queue/scheduler sysfs entry, which lists
+// the available schedulers with the active one in brackets, e.g. "mq-deadline kyber [bfq] none".
+func activeScheduler(schedulers string) string {
+	for _, scheduler := range strings.Fields(schedulers) {
+		if strings.HasPrefix(scheduler, "[") && strings.HasSuffix(scheduler, "]") {
+			return strings.Trim(scheduler, "[]")
+		}
+	}
+	return ""
+}
+
+// readInflight reads the number of in-flight read and write requests of device from its
+// inflight sysfs entry, which holds both counts separated by spaces.
+func readInflight(ctx context.Context, device string) (read int64, write int64, ok bool) {
+	fields := strings.Fields(readSysfsString(filepath.Join(internal.SysPath(ctx), "class", "block", device, "inflight")))
+	if len(fields) != 2 {
+		return 0, 0, false
+	}
+	read, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	write, err = strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return read, write, true
+}
+
+// readExtendedStat reads the discard and flush counters of device from its stat sysfs entry, which holds
+// 15 fields since Linux 4.18 and 17 since Linux 5.5. ok is false if the counters are not available.
+func readExtendedStat(ctx context.Context, device string) (stat extendedStat, ok bool) {
+	fields := strings.Fields(readSysfsString(filepath.Join(internal.SysPath(ctx), "class", "block", device, "stat")))
+	if len(fields) < 15 {
+		return extendedStat{}, false
+	}
+	values := make([]uint64, len(fields))
+	for i, field := range fields {
+		value, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return extendedStat{}, false
+		}
+		values[i] = value
+	}
+	// discard I/Os, merges, sectors and ticks, then flush I/Os and ticks; sectors are 512 bytes whatever the device
+	stat.discards = values[11]
+	stat.discardBytes = values[13] * 512
+	if len(values) >= 17 {
+		stat.flushOK = true
+		stat.flushes = values[15]
+		stat.flushTime = values[16]
+	}
+	return stat, true
+}
+
+// readCgroupIOStat reads the I/O counters of the cgroup at cgroupPath from its io.stat file, whose
+// lines hold the counters of a device, e.g. "8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353".
+// Devices are named after their sysfs entry, and keep their major:minor number if it cannot be resolved.
+func readCgroupIOStat(ctx context.Context, cgroupPath string) (map[string]disk.IOCountersStat, error) {
+	data, err := os.ReadFile(filepath.Join(cgroupPath, "io.stat"))
+	if err != nil {
+		return nil, err
+	}
+	ioCounters := make(map[string]disk.IOCountersStat)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		device := fields[0]
+		if target, err := os.Readlink(filepath.Join(internal.SysPath(ctx), "dev", "block", device)); err == nil {
+			device = filepath.Base(target)
+		}
+		ioCounter := disk.IOCountersStat{Name: device}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "rbytes":
+				ioCounter.ReadBytes = n
+			case "wbytes":
+				ioCounter.WriteBytes = n
+			case "rios":
+				ioCounter.ReadCount = n
+			case "wios":
+				ioCounter.WriteCount = n
+			}
+		}
+		ioCounters[device] = ioCounter
+	}
+	return ioCounters, nil
+}
+
+// readMountpoints returns the mount point of each block device holding a mounted filesystem, read from the
+// mountinfo of the init process, or of the collector if it cannot be read. When a device is mounted several
+// times, for instance by bind mounts, the mount of the root of its filesystem with the shortest path is kept.
+func readMountpoints(ctx context.Context) map[string]string {
+	data, err := os.ReadFile(filepath.Join(internal.ProcPath(ctx), "1", "mountinfo"))
+	if err != nil {
+		if data, err = os.ReadFile(filepath.Join(internal.ProcPath(ctx), "self", "mountinfo")); err != nil {
+			return nil
+		}
+	}
+	type mount struct {
+		root       string
+		mountpoint string
+	}
+	mounts := make(map[string]mount)
+	for _, line := range strings.Split(string(data), "\n") {
+		// e.g. "36 25 8:1 / /boot rw,relatime shared:7 - ext4 /dev/sda1 rw"
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
+		target, err := os.Readlink(filepath.Join(internal.SysPath(ctx), "dev", "block", fields[2]))
+		if err != nil {
+			// not a block device, such as tmpfs or proc
+			continue
+		}
+		device := filepath.Base(target)
+		m := mount{root: unescapeMountinfo(fields[3]), mountpoint: unescapeMountinfo(fields[4])}
+		if prev, ok := mounts[device]; ok && !preferMount(m.root, m.mountpoint, prev.root, prev.mountpoint) {
+			continue
+		}
+		mounts[device] = m
+	}
+	mountpoints := make(map[string]string, len(mounts))
+	for device, m := range mounts {
+		mountpoints[device] = m.mountpoint
+	}
+	return mountpoints
+}
+
+// preferMount reports whether the mount of root at mountpoint is preferred over the mount of prevRoot at
+// prevMountpoint: mounts of the root of the filesystem first, then the shortest and lowest paths.
+func preferMount(root, mountpoint, prevRoot, prevMountpoint string) bool {
+	if (root == "/") != (prevRoot == "/") {
+		return root == "/"
+	}
+	if len(mountpoint) != len(prevMountpoint) {
+		return len(mountpoint) < len(prevMountpoint)
+	}
+	return mountpoint < prevMountpoint
+}
+
+// unescapeMountinfo decodes the octal escapes of the spaces, tabs, newlines and backslashes of a mountinfo path.
+func unescapeMountinfo(path string) string {
+	if !strings.Contains(path, "\\") {
+		return path
+	}
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+3 < len(path) {
+			if c, err := strconv.ParseUint(path[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
+
+// readSysfsString returns the trimmed content of a sysfs entry, or an empty string if it cannot be read.
+func readSysfsString(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// readSMARTLogs reads the SMART / Health Information log of every NVMe controller listed in
+// sysfs, keyed by controller name (e.g. nvme0). Reading the log requires the CAP_SYS_ADMIN
+// capability. The logs that could be read are returned along with the errors of the others.
+func readSMARTLogs(ctx context.Context) (map[string]smartLog, error) {
+	entries, err := os.ReadDir(filepath.Join(internal.SysPath(ctx), "class", "nvme"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	logs := make(map[string]smartLog, len(entries))
+	var errs error
+	for _, entry := range entries {
+		log, err := readSMARTLog(filepath.Join(internal.DevPath(ctx), entry.Name()))
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to read SMART log of %s: %w", entry.Name(), err))
+			continue
+		}
+		logs[entry.Name()] = log
+	}
+	return logs, errs
+}
+
+// readSMARTLog issues a Get Log Page admin command for the SMART / Health Information log
+// to the NVMe controller character device at path.
+func readSMARTLog(path string) (smartLog, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return smartLog{}, err
+	}
+	defer f.Close()
+
+	var data [nvmeSMARTLogLen]byte
+	cmd := nvmeAdminCmd{
+		opcode:  nvmeAdminGetLogPage,
+		nsid:    0xFFFFFFFF,
+		addr:    uint64(uintptr(unsafe.Pointer(&data[0]))),
+		dataLen: nvmeSMARTLogLen,
+		// the log page identifier and the number of dwords to read, minus one
+		cdw10: nvmeLogSMART | (nvmeSMARTLogLen/4-1)<<16,
+	}
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nvmeIoctlAdminCmd, uintptr(unsafe.Pointer(&cmd)))
+	runtime.KeepAlive(&data)
+	if errno != 0 {
+		return smartLog{}, errno
+	}
+	return parseSMARTLog(data[:]), nil
+}
+
+// parseSMARTLog decodes the fields of a SMART / Health Information log page. Counters are
+// 128-bit wide, only their lower 64 bits are kept.
+func parseSMARTLog(data []byte) smartLog {
+	return smartLog{
+		temperature:     float64(binary.LittleEndian.Uint16(data[1:3])) - 273.15,
+		percentageUsed:  int64(data[5]),
+		unsafeShutdowns: binary.LittleEndian.Uint64(data[144:152]),
+		mediaErrors:     binary.LittleEndian.Uint64(data[160:168]),
+	}
+}
+
+// readProcessIO reads the storage I/O counters of every process from the io entry of its procfs directory,
+// keyed by pid. Reading the counters of the processes of other users requires the CAP_SYS_PTRACE capability.
+// The processes whose counters cannot be read, for instance because they exited, are skipped.
+func readProcessIO(ctx context.Context) (map[int32]processIO, error) {
+	entries, err := os.ReadDir(internal.ProcPath(ctx))
+	if err != nil {
+		return nil, err
+	}
+	procs := make(map[int32]processIO)
+	for _, entry := range entries {
+		pid, err := strconv.ParseInt(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		dir := filepath.Join(internal.ProcPath(ctx), entry.Name())
+		data, err := os.ReadFile(filepath.Join(dir, "io"))
+		if err != nil {
+			continue
+		}
+		proc := processIO{name: readSysfsString(filepath.Join(dir, "comm"))}
+		// the file holds a "name: value" line per counter, read_bytes and write_bytes count the
+		// bytes fetched from and sent to the storage layer
+		for _, line := range strings.Split(string(data), "\n") {
+			name, value, ok := strings.Cut(line, ":")
+			if !ok {
+				continue
+			}
+			n, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				continue
+			}
+			switch name {
+			case "read_bytes":
+				proc.readBytes = n
+			case "write_bytes":
+				proc.writeBytes = n
+			}
+		}
+		procs[int32(pid)] = proc
+	}
+	return procs, nil
+}
+
+// readZpoolStats reads the state and the I/O counters of every imported ZFS pool from the kstats of the
+// OpenZFS kernel module, keyed by pool name. Nothing is returned if the module is not loaded. The I/O
+// counters are the sums of those of the datasets of the pool, as OpenZFS exposes no pool-level counters.
+// The stats that could be read are returned along with the errors of the others.
+func readZpoolStats(ctx context.Context) (map[string]zpoolStat, error) {
+	root := filepath.Join(internal.ProcPath(ctx), "spl", "kstat", "zfs")
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	stats := make(map[string]zpoolStat, len(entries))
+	var errs error
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		stat, ok, err := readZpoolStat(filepath.Join(root, entry.Name()))
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to read stats of ZFS pool %s: %w", entry.Name(), err))
+			continue
+		}
+		if ok {
+			stats[entry.Name()] = stat
+		}
+	}
+	return stats, errs
+}
+
+// readZpoolStat reads the stats of the pool whose kstats are in dir. ok is false if dir holds no pool state.
+func readZpoolStat(dir string) (stat zpoolStat, ok bool, err error) {
+	state, err := os.ReadFile(filepath.Join(dir, "state"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return zpoolStat{}, false, nil
+		}
+		return zpoolStat{}, false, err
+	}
+	stat.state = strings.TrimSpace(string(state))
+
+	objsets, err := filepath.Glob(filepath.Join(dir, "objset-*"))
+	if err != nil {
+		return zpoolStat{}, false, err
+	}
+	for _, objset := range objsets {
+		data, err := os.ReadFile(objset)
+		if err != nil {
+			// the dataset may have been destroyed in the meantime
+			if os.IsNotExist(err) {
+				continue
+			}
+			return zpoolStat{}, false, err
+		}
+		values := parseKstat(data)
+		stat.reads += values["reads"]
+		stat.writes += values["writes"]
+		stat.readBytes += values["nread"]
+		stat.writeBytes += values["nwritten"]
+	}
+	return stat, true, nil
+}
+
+// parseKstat parses the unsigned integer values of a named kstat, laid out as a header line, a
+// `name type data` line and a line per value.
+func parseKstat(data []byte) map[string]uint64 {
+	values := make(map[string]uint64)
+	lines := strings.Split(string(data), "\n")
+	if len(lines) < 2 {
+		return values
+	}
+	for _, line := range lines[2:] {
+		fields := strings.Fields(line)
+		// 4 is the KSTAT_DATA_UINT64 type
+		if len(fields) != 3 || fields[1] != "4" {
+			continue
+		}
+		if value, err := strconv.ParseUint(fields[2], 10, 64); err == nil {
+			values[fields[0]] = value
+		}
 	}
+	return values
 }
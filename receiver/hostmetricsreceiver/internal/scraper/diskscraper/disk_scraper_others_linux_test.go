@@ -0,0 +1,633 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package diskscraper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper/internal/metadata"
+)
+
+func TestScrape_DeviceMetadata(t *testing.T) {
+	sysPath := t.TempDir()
+	writeSysfs := func(device, entry, value string) {
+		path := filepath.Join(sysPath, "block", device, entry)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(value), 0o600))
+	}
+	writeSysfs("sda", "queue/rotational", "1\n")
+	writeSysfs("sda", "queue/scheduler", "[mq-deadline] kyber bfq none\n")
+	writeSysfs("sda", "device/model", "ST4000DM004-2CV1\n")
+	writeSysfs("nvme0n1", "queue/rotational", "0\n")
+	writeSysfs("nvme0n1", "queue/scheduler", "[none] mq-deadline\n")
+	writeSysfs("nvme0n1", "device/model", "Samsung SSD 980 PRO 1TB                 \n")
+	writeSysfs("nvme0n1", "device/serial", "S5GXNF0R123456\n")
+	writeSysfs("vda", "serial", "virtio-serial\n")
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.ResourceAttributes.DiskRotational.Enabled = true
+	cfg.ResourceAttributes.DiskModel.Enabled = true
+	cfg.ResourceAttributes.DiskSerial.Enabled = true
+	cfg.ResourceAttributes.DiskScheduler.Enabled = true
+	cfg.Metrics.SystemDiskSmartTemperature.Enabled = true
+	cfg.EnvMap = common.EnvMap{common.HostSysEnvKey: sysPath}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"sda":     {Name: "sda"},
+			"nvme0n1": {Name: "nvme0n1"},
+			"vda":     {Name: "vda"},
+			"sda1":    {Name: "sda1"},
+		}, nil
+	}
+	lookups := map[string]int{}
+	scraper.deviceMetadata = func(ctx context.Context, device string) deviceMetadata {
+		lookups[device]++
+		return readDeviceMetadata(ctx, device)
+	}
+	scraper.smartLogs = func(context.Context) (map[string]smartLog, error) {
+		return map[string]smartLog{"nvme0": {temperature: 40}}, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	expected := map[string]map[string]any{
+		"sda": {
+			"disk.rotational": true,
+			"disk.model":      "ST4000DM004-2CV1",
+			"disk.scheduler":  "mq-deadline",
+		},
+		"nvme0n1": {
+			"disk.rotational": false,
+			"disk.model":      "Samsung SSD 980 PRO 1TB",
+			"disk.serial":     "S5GXNF0R123456",
+			"disk.scheduler":  "none",
+		},
+		"vda": {
+			"disk.serial": "virtio-serial",
+		},
+		// the sysfs entries are unreadable, so the attributes are skipped
+		"sda1": {},
+		// the SMART metrics are not device I/O metrics, so they are reported without device metadata
+		"nvme0": {},
+	}
+	for i := 0; i < 2; i++ {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, expected, deviceResources(md))
+	}
+
+	// sysfs is only read once per device
+	assert.Equal(t, map[string]int{"sda": 1, "nvme0n1": 1, "vda": 1, "sda1": 1}, lookups)
+}
+
+func TestScrape_DMName(t *testing.T) {
+	sysPath := t.TempDir()
+	dmPath := filepath.Join(sysPath, "block", "dm-0", "dm")
+	require.NoError(t, os.MkdirAll(dmPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dmPath, "name"), []byte("vg0-data\n"), 0o600))
+
+	for _, useDMName := range []bool{false, true} {
+		cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), UseDMName: useDMName}
+		cfg.ResourceAttributes.DiskDmName.Enabled = true
+		cfg.EnvMap = common.EnvMap{common.HostSysEnvKey: sysPath}
+		scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+		require.NoError(t, err, "Failed to create disk scraper: %v", err)
+		scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+			return map[string]disk.IOCountersStat{
+				"dm-0": {Name: "dm-0"},
+				"sda":  {Name: "sda"},
+			}, nil
+		}
+
+		err = scraper.start(context.Background(), componenttest.NewNopHost())
+		require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		if useDMName {
+			assert.Equal(t, map[string]map[string]any{
+				"vg0-data": {"disk.dm_name": "vg0-data"},
+				"sda":      {},
+			}, deviceResources(md))
+		} else {
+			assert.Equal(t, map[string]map[string]any{
+				"dm-0": {"disk.dm_name": "vg0-data"},
+				"sda":  {},
+			}, deviceResources(md))
+		}
+	}
+}
+
+func TestScrape_UseDMNameOnly(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), UseDMName: true}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"dm-0": {Name: "dm-0"},
+			"sda":  {Name: "sda"},
+		}, nil
+	}
+	scraper.deviceMetadata = func(_ context.Context, device string) deviceMetadata {
+		if device == "dm-0" {
+			return deviceMetadata{dmName: "vg0-data"}
+		}
+		return deviceMetadata{}
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	// without device resource attributes, every device is reported under a single resource
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.ElementsMatch(t, []string{"vg0-data", "sda"}, reportedDevices(md))
+}
+
+func TestScrape_RAIDArray(t *testing.T) {
+	sysPath := t.TempDir()
+	for _, member := range []string{"sda1", "sdb1"} {
+		holdersPath := filepath.Join(sysPath, "class", "block", member, "holders")
+		require.NoError(t, os.MkdirAll(holdersPath, 0o755))
+		require.NoError(t, os.Symlink("../../md0", filepath.Join(holdersPath, "md0")))
+	}
+	// a device held by device-mapper is not a RAID member
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "class", "block", "sdc", "holders", "dm-0"), 0o755))
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.ResourceAttributes.RaidArray.Enabled = true
+	cfg.EnvMap = common.EnvMap{common.HostSysEnvKey: sysPath}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"md0":  {Name: "md0"},
+			"sda1": {Name: "sda1"},
+			"sdb1": {Name: "sdb1"},
+			"sdc":  {Name: "sdc"},
+		}, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]map[string]any{
+		"md0":  {},
+		"sda1": {"raid.array": "md0"},
+		"sdb1": {"raid.array": "md0"},
+		"sdc":  {},
+	}, deviceResources(md))
+}
+
+func TestScrape_Transport(t *testing.T) {
+	sysPath := t.TempDir()
+	linkDevice := func(device, target string) {
+		path := filepath.Join(sysPath, "devices", target, "block", device)
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "block"), 0o755))
+		require.NoError(t, os.Symlink(path, filepath.Join(sysPath, "block", device)))
+	}
+	linkDevice("sda", "pci0000:00/0000:00:17.0/ata1/host0/target0:0:0/0:0:0:0")
+	linkDevice("sdb", "platform/host3/session1/target3:0:0/3:0:0:0")
+	linkDevice("vda", "pci0000:00/0000:00:04.0/virtio1")
+	linkDevice("xvda", "vbd-51712")
+	linkDevice("nvme1n1", "pci0000:00/0000:00:1f.0/nvme/nvme1")
+	linkDevice("rbd0", "rbd/0")
+	linkDevice("dm-0", "virtual")
+	modelPath := filepath.Join(sysPath, "block", "nvme1n1", "device", "model")
+	require.NoError(t, os.MkdirAll(filepath.Dir(modelPath), 0o755))
+	require.NoError(t, os.WriteFile(modelPath, []byte("Amazon Elastic Block Store              \n"), 0o600))
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.ResourceAttributes.DiskTransport.Enabled = true
+	cfg.EnvMap = common.EnvMap{common.HostSysEnvKey: sysPath}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"sda":     {Name: "sda"},
+			"sdb":     {Name: "sdb"},
+			"vda":     {Name: "vda"},
+			"xvda":    {Name: "xvda"},
+			"nvme1n1": {Name: "nvme1n1"},
+			"rbd0":    {Name: "rbd0"},
+			"nbd0":    {Name: "nbd0"},
+			"dm-0":    {Name: "dm-0"},
+			"sdz":     {Name: "sdz"},
+		}, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	transports := map[string]any{}
+	for device, attrs := range deviceResources(md) {
+		transports[device] = attrs["disk.transport"]
+	}
+	assert.Equal(t, map[string]any{
+		"sda":     "local",
+		"sdb":     "iscsi",
+		"vda":     "virtio",
+		"xvda":    "xen",
+		"nvme1n1": "ebs",
+		"rbd0":    "rbd",
+		"nbd0":    "nbd",
+		"dm-0":    nil,
+		// the device is missing from sysfs
+		"sdz": nil,
+	}, transports)
+}
+
+func TestScrape_Mountpoint(t *testing.T) {
+	sysPath, procPath := t.TempDir(), t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "dev", "block"), 0o755))
+	for devno, device := range map[string]string{"8:1": "sda1", "8:2": "sda2", "253:0": "dm-0", "8:16": "sdb"} {
+		require.NoError(t, os.Symlink("../../devices/virtual/block/"+device, filepath.Join(sysPath, "dev", "block", devno)))
+	}
+	mountinfo := `22 1 253:0 / / rw,relatime shared:1 - ext4 /dev/mapper/vg0-root rw
+25 22 0:21 / /proc rw,nosuid,nodev,noexec,relatime shared:12 - proc proc rw
+36 22 8:1 / /boot rw,relatime shared:7 - ext4 /dev/sda1 rw
+41 22 8:2 /data /var/lib/docker rw,relatime shared:9 - xfs /dev/sda2 rw
+42 22 8:2 / /srv/my\040data rw,relatime shared:9 - xfs /dev/sda2 rw
+43 22 8:1 / /mnt/boot rw,relatime shared:7 - ext4 /dev/sda1 rw
+`
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "self"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, "self", "mountinfo"), []byte(mountinfo), 0o600))
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), UseDMName: true}
+	cfg.ResourceAttributes.Mountpoint.Enabled = true
+	cfg.EnvMap = common.EnvMap{common.HostSysEnvKey: sysPath, common.HostProcEnvKey: procPath}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"sda":  {Name: "sda"},
+			"sda1": {Name: "sda1"},
+			"sda2": {Name: "sda2"},
+			"sdb":  {Name: "sdb"},
+			"dm-0": {Name: "dm-0"},
+		}, nil
+	}
+	// the boot time is read from procfs
+	scraper.bootTime = func(context.Context) (uint64, error) {
+		return 0, nil
+	}
+	scraper.deviceMetadata = func(_ context.Context, device string) deviceMetadata {
+		if device == "dm-0" {
+			return deviceMetadata{dmName: "vg0-root"}
+		}
+		return deviceMetadata{}
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// mount points are looked up by kernel name, before device-mapper names replace them
+	assert.Equal(t, map[string]map[string]any{
+		"sda":      {},
+		"sda1":     {"mountpoint": "/boot"},
+		"sda2":     {"mountpoint": "/srv/my data"},
+		"sdb":      {},
+		"vg0-root": {"mountpoint": "/"},
+	}, deviceResources(md))
+}
+
+func TestScrape_RotationalOnly(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.ResourceAttributes.DiskRotational.Enabled = true
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{"sda": {Name: "sda"}}, nil
+	}
+	scraper.deviceMetadata = func(context.Context, string) deviceMetadata {
+		return deviceMetadata{rotational: true, rotationalOK: true, model: "model", serial: "serial", scheduler: "bfq"}
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]any{"sda": {"disk.rotational": true}}, deviceResources(md))
+	attrs := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Attributes().AsRaw()
+	delete(attrs, "direction")
+	assert.Equal(t, map[string]any{"device": "sda"}, attrs)
+}
+
+// deviceResources returns the attributes of the resource of each device reported by md.
+func deviceResources(md pmetric.Metrics) map[string]map[string]any {
+	resources := map[string]map[string]any{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			var dps pmetric.NumberDataPointSlice
+			switch metrics.At(j).Type() {
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(j).Sum().DataPoints()
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(j).Gauge().DataPoints()
+			}
+			for k := 0; k < dps.Len(); k++ {
+				device, _ := dps.At(k).Attributes().Get("device")
+				resources[device.Str()] = rm.Resource().Attributes().AsRaw()
+			}
+		}
+	}
+	return resources
+}
+
+func TestParseSMARTLog(t *testing.T) {
+	data := make([]byte, nvmeSMARTLogLen)
+	binary.LittleEndian.PutUint16(data[1:3], 310)
+	data[5] = 7
+	binary.LittleEndian.PutUint64(data[144:152], 42)
+	binary.LittleEndian.PutUint64(data[160:168], 3)
+
+	log := parseSMARTLog(data)
+	assert.InDelta(t, 36.85, log.temperature, 1e-9)
+	assert.Equal(t, int64(7), log.percentageUsed)
+	assert.Equal(t, uint64(42), log.unsafeShutdowns)
+	assert.Equal(t, uint64(3), log.mediaErrors)
+}
+
+func TestReadSMARTLogs(t *testing.T) {
+	sysPath, devPath := t.TempDir(), t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{
+		common.HostSysEnvKey: sysPath,
+		common.HostDevEnvKey: devPath,
+	})
+
+	// no NVMe controller
+	logs, err := readSMARTLogs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+
+	// the controller device is not an NVMe character device, so the ioctl fails
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "class", "nvme", "nvme0"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(devPath, "nvme0"), nil, 0o600))
+	logs, err = readSMARTLogs(ctx)
+	assert.ErrorContains(t, err, "failed to read SMART log of nvme0")
+	assert.Empty(t, logs)
+}
+
+func TestReadZpoolStats(t *testing.T) {
+	procPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: procPath})
+
+	// the OpenZFS module is not loaded
+	stats, err := readZpoolStats(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, stats)
+
+	kstatPath := filepath.Join(procPath, "spl", "kstat", "zfs")
+	writeKstat := func(name, value string) {
+		path := filepath.Join(kstatPath, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(value), 0o600))
+	}
+	writeObjset := func(pool, objset, dataset string, reads, nread, writes, nwritten int) {
+		writeKstat(filepath.Join(pool, "objset-"+objset), fmt.Sprintf(`49 1 0x01 7 2160 5214787391 74985931356512
+name                            type data
+dataset_name                    7    %s
+writes                          4    %d
+nwritten                        4    %d
+reads                           4    %d
+nread                           4    %d
+nunlinks                        4    0
+nunlinked                       4    0
+`, dataset, writes, nwritten, reads, nread))
+	}
+	writeKstat("arcstats", "")
+	writeKstat(filepath.Join("tank", "state"), "ONLINE\n")
+	writeObjset("tank", "0x36", "tank", 2, 1024, 1, 512)
+	writeObjset("tank", "0x84", "tank/home", 3, 2048, 4, 4096)
+	writeKstat(filepath.Join("empty", "state"), "DEGRADED\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(kstatPath, "notapool"), 0o755))
+
+	stats, err = readZpoolStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]zpoolStat{
+		"tank":  {state: "ONLINE", reads: 5, writes: 5, readBytes: 3072, writeBytes: 4608},
+		"empty": {state: "DEGRADED"},
+	}, stats)
+}
+
+func TestReadProcessIO(t *testing.T) {
+	procPath := t.TempDir()
+	writeProc := func(pid, entry, value string) {
+		path := filepath.Join(procPath, pid, entry)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(value), 0o600))
+	}
+	writeProc("1", "comm", "systemd\n")
+	writeProc("1", "io", "rchar: 1948120\nwchar: 205934\nsyscr: 1250\nsyscw: 410\nread_bytes: 4096\nwrite_bytes: 8192\ncancelled_write_bytes: 0\n")
+	// the io entry of a process of another user cannot be read
+	writeProc("42", "comm", "sshd\n")
+	writeProc("self", "io", "read_bytes: 1\n")
+	writeProc("net", "dev", "")
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: procPath})
+
+	procs, err := readProcessIO(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]processIO{1: {name: "systemd", readBytes: 4096, writeBytes: 8192}}, procs)
+}
+
+func TestScrape_Inflight(t *testing.T) {
+	sysPath := t.TempDir()
+	writeInflight := func(device, value string) {
+		path := filepath.Join(sysPath, "class", "block", device)
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "inflight"), []byte(value), 0o600))
+	}
+	writeInflight("sda", "       3        5\n")
+	writeInflight("sdb", "garbage\n")
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), EmitTotal: true}
+	cfg.Metrics.SystemDiskInflight.Enabled = true
+	cfg.EnvMap = common.EnvMap{common.HostSysEnvKey: sysPath}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"sda": {Name: "sda"},
+			"sdb": {Name: "sdb"},
+			"sdc": {Name: "sdc"},
+		}, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	inflight := map[string]int64{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() != "system.disk.inflight" {
+			continue
+		}
+		dps := metrics.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			device, _ := dps.At(j).Attributes().Get("device")
+			direction, _ := dps.At(j).Attributes().Get("direction")
+			inflight[device.Str()+"/"+direction.Str()] = dps.At(j).IntValue()
+		}
+	}
+	// unreadable or malformed entries and the total device are skipped
+	assert.Equal(t, map[string]int64{"sda/read": 3, "sda/write": 5}, inflight)
+}
+
+func TestScrape_DiscardAndFlush(t *testing.T) {
+	sysPath := t.TempDir()
+	writeStat := func(device, value string) {
+		path := filepath.Join(sysPath, "class", "block", device)
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "stat"), []byte(value), 0o600))
+	}
+	writeStat("nvme0n1", "  193254  64870 12597770  54871  1040211  766541 31834208 2083560  0  1102396  2218880  4125  0  8388608  1230  87312  80270\n")
+	// Linux 4.18 to 5.4 count discards but not flushes
+	writeStat("sda", "  8302  1523  613294  6209  4101  3982  126648  9212  0  9764  15421  12  0  4096  7\n")
+	// kernels older than 4.18 count neither
+	writeStat("sdb", "  8302  1523  613294  6209  4101  3982  126648  9212  0  9764  15421\n")
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), EmitTotal: true}
+	cfg.Metrics.SystemDiskDiscardIo.Enabled = true
+	cfg.Metrics.SystemDiskDiscardOperations.Enabled = true
+	cfg.Metrics.SystemDiskFlushOperations.Enabled = true
+	cfg.Metrics.SystemDiskFlushTime.Enabled = true
+	cfg.EnvMap = common.EnvMap{common.HostSysEnvKey: sysPath}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"nvme0n1": {Name: "nvme0n1"},
+			"sda":     {Name: "sda"},
+			"sdb":     {Name: "sdb"},
+			"sdc":     {Name: "sdc"},
+		}, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	values := map[string]float64{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		switch metrics.At(i).Name() {
+		case "system.disk.discard.io", "system.disk.discard.operations", "system.disk.flush.operations", "system.disk.flush.time":
+		default:
+			continue
+		}
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			device, _ := dps.At(j).Attributes().Get("device")
+			value := dps.At(j).DoubleValue()
+			if dps.At(j).ValueType() == pmetric.NumberDataPointValueTypeInt {
+				value = float64(dps.At(j).IntValue())
+			}
+			values[metrics.At(i).Name()+"/"+device.Str()] = value
+		}
+	}
+	// missing counters and the total device are skipped
+	assert.Equal(t, map[string]float64{
+		"system.disk.discard.io/nvme0n1":         8388608 * 512,
+		"system.disk.discard.operations/nvme0n1": 4125,
+		"system.disk.flush.operations/nvme0n1":   87312,
+		"system.disk.flush.time/nvme0n1":         80.27,
+		"system.disk.discard.io/sda":             4096 * 512,
+		"system.disk.discard.operations/sda":     12,
+	}, values)
+}
+
+func TestScrape_CgroupPath(t *testing.T) {
+	sysPath, cgroupPath := t.TempDir(), t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "dev", "block"), 0o755))
+	require.NoError(t, os.Symlink("../../devices/pci0000:00/0000:00:1f.2/block/sda", filepath.Join(sysPath, "dev", "block", "8:0")))
+	ioStat := "8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0\n" +
+		"259:0 rbytes=100 wbytes=200 rios=1 wios=2 dbytes=0 dios=0\n"
+	require.NoError(t, os.WriteFile(filepath.Join(cgroupPath, "io.stat"), []byte(ioStat), 0o600))
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), CgroupPath: cgroupPath}
+	cfg.Metrics.SystemDiskOperationLatency.Enabled = true
+	cfg.EnvMap = common.EnvMap{common.HostSysEnvKey: sysPath}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	for i := 0; i < 2; i++ {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		values := map[string]int64{}
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			dps := metrics.At(j).Sum().DataPoints()
+			for k := 0; k < dps.Len(); k++ {
+				device, _ := dps.At(k).Attributes().Get("device")
+				direction, _ := dps.At(k).Attributes().Get("direction")
+				values[metrics.At(j).Name()+"/"+device.Str()+"/"+direction.Str()] = dps.At(k).IntValue()
+			}
+		}
+		// the device whose major:minor number cannot be resolved keeps it as its name
+		assert.Equal(t, map[string]int64{
+			"system.disk.io/sda/read":            1459200,
+			"system.disk.io/sda/write":           314773504,
+			"system.disk.operations/sda/read":    192,
+			"system.disk.operations/sda/write":   353,
+			"system.disk.io/259:0/read":          100,
+			"system.disk.io/259:0/write":         200,
+			"system.disk.operations/259:0/read":  1,
+			"system.disk.operations/259:0/write": 2,
+		}, values)
+	}
+}
+
+func TestScrape_CgroupPathError(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), CgroupPath: t.TempDir()}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	_, err = scraper.scrape(context.Background())
+	assert.ErrorContains(t, err, "io.stat")
+}
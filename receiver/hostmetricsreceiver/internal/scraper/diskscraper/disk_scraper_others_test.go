@@ -8,14 +8,21 @@ package diskscraper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper/internal/metadata"
 )
 
 func TestScrape_Others(t *testing.T) {
@@ -60,3 +67,653 @@ func TestScrape_Others(t *testing.T) {
 		})
 	}
 }
+
+func TestScrape_SuppressIdleDevices(t *testing.T) {
+	counters := map[string]disk.IOCountersStat{
+		"idle":   {Name: "idle", ReadCount: 10, WriteCount: 10, ReadBytes: 100, WriteBytes: 100},
+		"active": {Name: "active", ReadCount: 10, WriteCount: 10, ReadBytes: 100, WriteBytes: 100},
+	}
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), SuppressIdleDevices: true}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		result := make(map[string]disk.IOCountersStat, len(counters))
+		for device, ioCounter := range counters {
+			result[device] = ioCounter
+		}
+		return result, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	// The first scrape reports every device.
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"active", "idle"}, reportedDevices(md))
+
+	// Only the device whose counters changed is reported.
+	active := counters["active"]
+	active.ReadCount++
+	active.ReadBytes += 4096
+	counters["active"] = active
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"active"}, reportedDevices(md))
+
+	// An idle device reappears once it resumes activity.
+	idle := counters["idle"]
+	idle.WriteCount++
+	counters["idle"] = idle
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"idle"}, reportedDevices(md))
+}
+
+func TestScrape_EmitTotal(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), EmitTotal: true}
+	cfg.Exclude = MatchConfig{Config: filterset.Config{MatchType: filterset.Strict}, Devices: []string{"sdc"}}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"sda":  {Name: "sda", ReadCount: 10, WriteCount: 20, ReadBytes: 1000, WriteBytes: 2000},
+			"sda1": {Name: "sda1", ReadCount: 8, WriteCount: 15, ReadBytes: 800, WriteBytes: 1500},
+			"sdb":  {Name: "sdb", ReadCount: 1, WriteCount: 2, ReadBytes: 100, WriteBytes: 200},
+			"sdc":  {Name: "sdc", ReadCount: 1000, WriteCount: 1000, ReadBytes: 1000, WriteBytes: 1000},
+		}, nil
+	}
+	scraper.isWholeDisk = func(_ context.Context, device string) bool {
+		return device != "sda1"
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"sda", "sda1", "sdb", totalDevice}, reportedDevices(md))
+
+	// the total only accounts for the included whole disks
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() != "system.disk.io" {
+			continue
+		}
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			if device, _ := dps.At(j).Attributes().Get("device"); device.Str() != totalDevice {
+				continue
+			}
+			direction, _ := dps.At(j).Attributes().Get("direction")
+			switch direction.Str() {
+			case "read":
+				assert.Equal(t, int64(1100), dps.At(j).IntValue())
+			case "write":
+				assert.Equal(t, int64(2200), dps.At(j).IntValue())
+			}
+		}
+	}
+}
+
+func TestScrape_ReportPartitions(t *testing.T) {
+	testCases := []struct {
+		reportPartitions string
+		expectedDevices  []string
+	}{
+		{reportPartitions: "", expectedDevices: []string{"sda", "sda1", "sda2", "dm-0", totalDevice}},
+		{reportPartitions: reportPartitionsAll, expectedDevices: []string{"sda", "sda1", "sda2", "dm-0", totalDevice}},
+		{reportPartitions: reportPartitionsNone, expectedDevices: []string{"sda", "dm-0", totalDevice}},
+		{reportPartitions: reportPartitionsOnly, expectedDevices: []string{"sda1", "sda2", totalDevice}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.reportPartitions, func(t *testing.T) {
+			cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), EmitTotal: true, ReportPartitions: tc.reportPartitions}
+			scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err, "Failed to create disk scraper: %v", err)
+			scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+				return map[string]disk.IOCountersStat{
+					"sda":  {Name: "sda", ReadBytes: 1000},
+					"sda1": {Name: "sda1", ReadBytes: 600},
+					"sda2": {Name: "sda2", ReadBytes: 400},
+					"dm-0": {Name: "dm-0", ReadBytes: 600},
+				}, nil
+			}
+			scraper.isPartition = func(_ context.Context, device string) bool {
+				return device == "sda1" || device == "sda2"
+			}
+			scraper.isWholeDisk = func(_ context.Context, device string) bool {
+				return device == "sda"
+			}
+
+			err = scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expectedDevices, reportedDevices(md))
+
+			// the total is the same whatever the reported devices
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				if metrics.At(i).Name() != "system.disk.io" {
+					continue
+				}
+				dps := metrics.At(i).Sum().DataPoints()
+				for j := 0; j < dps.Len(); j++ {
+					device, _ := dps.At(j).Attributes().Get("device")
+					direction, _ := dps.At(j).Attributes().Get("direction")
+					if device.Str() == totalDevice && direction.Str() == "read" {
+						assert.Equal(t, int64(1000), dps.At(j).IntValue())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewDiskScraper_InvalidReportPartitions(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), ReportPartitions: "some"}
+	_, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	assert.EqualError(t, err, `invalid report_partitions "some", must be one of "all", "none" or "only"`)
+}
+
+func TestNewDiskScraper_InvalidTopProcesses(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.Metrics.SystemDiskProcessIo.Enabled = true
+	_, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	assert.EqualError(t, err, "invalid top_processes 0, must be positive")
+}
+
+func TestScrape_TopProcesses(t *testing.T) {
+	cfg := (&Factory{}).CreateDefaultConfig().(*Config)
+	cfg.Metrics.SystemDiskProcessIo.Enabled = true
+	cfg.TopProcesses = 2
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{"sda": {Name: "sda"}}, nil
+	}
+	procs := map[int32]processIO{
+		1:   {name: "systemd", readBytes: 1000, writeBytes: 100},
+		100: {name: "postgres", readBytes: 500, writeBytes: 300},
+		200: {name: "rsync", readBytes: 100},
+		300: {name: "sleep"},
+	}
+	scraper.processIO = func(context.Context) (map[int32]processIO, error) {
+		return procs, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	topProcesses := func() map[string]int64 {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		values := map[string]int64{}
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			if metrics.At(i).Name() != "system.disk.process.io" {
+				continue
+			}
+			dps := metrics.At(i).Sum().DataPoints()
+			for j := 0; j < dps.Len(); j++ {
+				attrs := dps.At(j).Attributes().AsRaw()
+				values[fmt.Sprintf("%d/%s/%s", attrs["process.pid"], attrs["process.executable.name"], attrs["direction"])] = dps.At(j).IntValue()
+			}
+		}
+		return values
+	}
+
+	// processes seen for the first time are ranked by the bytes transferred since they started
+	assert.Equal(t, map[string]int64{
+		"1/systemd/read":     1000,
+		"1/systemd/write":    100,
+		"100/postgres/read":  500,
+		"100/postgres/write": 300,
+	}, topProcesses())
+
+	// then by the bytes transferred since the previous scrape
+	procs = map[int32]processIO{
+		1:   {name: "systemd", readBytes: 1000, writeBytes: 110},
+		100: {name: "postgres", readBytes: 900, writeBytes: 300},
+		200: {name: "rsync", readBytes: 100, writeBytes: 4096},
+		300: {name: "sleep"},
+	}
+	assert.Equal(t, map[string]int64{
+		"200/rsync/read":     100,
+		"200/rsync/write":    4096,
+		"100/postgres/read":  900,
+		"100/postgres/write": 300,
+	}, topProcesses())
+
+	// idle processes are not reported
+	assert.Empty(t, topProcesses())
+}
+
+func TestScrape_DeviceMetadataCache(t *testing.T) {
+	devices := []string{"sda", "dm-0"}
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.ResourceAttributes.DiskDmName.Enabled = true
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		ioCounters := make(map[string]disk.IOCountersStat, len(devices))
+		for _, device := range devices {
+			ioCounters[device] = disk.IOCountersStat{Name: device}
+		}
+		return ioCounters, nil
+	}
+	lookups := map[string]int{}
+	scraper.deviceMetadata = func(_ context.Context, device string) deviceMetadata {
+		lookups[device]++
+		return deviceMetadata{}
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	for _, scrapeDevices := range [][]string{{"sda", "dm-0"}, {"sda", "dm-0"}, {"sda"}, {"sda", "dm-0"}} {
+		devices = scrapeDevices
+		_, err = scraper.scrape(context.Background())
+		require.NoError(t, err)
+	}
+
+	// the metadata of dm-0 is read again after it was removed and then recreated
+	assert.Equal(t, map[string]int{"sda": 1, "dm-0": 2}, lookups)
+}
+
+func TestScrape_MinDeviceLifetime(t *testing.T) {
+	devices := []string{"sda"}
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), MinDeviceLifetime: time.Hour}
+	cfg.Metrics.SystemDiskOperationLatency.Enabled = true
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		ioCounters := make(map[string]disk.IOCountersStat, len(devices))
+		for _, device := range devices {
+			ioCounters[device] = disk.IOCountersStat{Name: device}
+		}
+		return ioCounters, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	scrape := func(scrapeDevices ...string) []string {
+		devices = scrapeDevices
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		return reportedDevices(md)
+	}
+
+	// devices present at the first scrape are reported right away
+	assert.Equal(t, []string{"sda"}, scrape("sda"))
+	// a new device is held back until it has been present for the minimum lifetime
+	assert.Equal(t, []string{"sda"}, scrape("sda", "sdb"))
+	scraper.firstSeen["sdb"] = pcommon.NewTimestampFromTime(time.Now().Add(-2 * time.Hour))
+	assert.ElementsMatch(t, []string{"sda", "sdb"}, scrape("sda", "sdb"))
+	// a removed device is forgotten, along with its operation counters
+	assert.Equal(t, []string{"sda"}, scrape("sda"))
+	for key := range scraper.prevOperations {
+		assert.Equal(t, "sda", key.device)
+	}
+	// and is handled as a new device when it comes back
+	assert.Equal(t, []string{"sda"}, scrape("sda", "sdb"))
+}
+
+func TestScrape_ReportAsRate(t *testing.T) {
+	counters := map[string]disk.IOCountersStat{
+		"sda": {Name: "sda", ReadBytes: 1000, WriteBytes: 5000, ReadCount: 10, WriteCount: 20, IoTime: 1000},
+	}
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), ReportAsRate: true}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		result := make(map[string]disk.IOCountersStat, len(counters))
+		for device, ioCounter := range counters {
+			result[device] = ioCounter
+		}
+		return result, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	// no rate can be computed on the first scrape
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		assert.False(t, rateMetrics[metrics.At(i).Name()], metrics.At(i).Name())
+	}
+
+	// the previous samples are taken 2s before the next scrape
+	for key, sample := range scraper.prevValues {
+		sample.ts = pcommon.NewTimestampFromTime(sample.ts.AsTime().Add(-2 * time.Second))
+		scraper.prevValues[key] = sample
+	}
+	counters["sda"] = disk.IOCountersStat{Name: "sda", ReadBytes: 3000, WriteBytes: 5000, ReadCount: 30, WriteCount: 20, IoTime: 2000}
+
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	rates := map[string]float64{}
+	units := map[string]string{}
+	metrics = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if !rateMetrics[metric.Name()] {
+			continue
+		}
+		require.Equal(t, pmetric.MetricTypeGauge, metric.Type(), metric.Name())
+		units[metric.Name()] = metric.Unit()
+		dps := metric.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			direction, _ := dps.At(j).Attributes().Get("direction")
+			rates[metric.Name()+"/"+direction.Str()] = dps.At(j).DoubleValue()
+		}
+	}
+	assert.InDelta(t, 1000, rates["system.disk.io/read"], 50)
+	assert.Equal(t, 0.0, rates["system.disk.io/write"])
+	assert.InDelta(t, 10, rates["system.disk.operations/read"], 0.5)
+	assert.InDelta(t, 0.5, rates["system.disk.io_time/"], 0.05)
+	assert.Equal(t, "By/s", units["system.disk.io"])
+	assert.Equal(t, "{operations}/s", units["system.disk.operations"])
+}
+
+func TestScrape_TotalOnly(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), TotalOnly: true, SuppressIdleDevices: true}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"nvme0n1": {Name: "nvme0n1", ReadCount: 10, WriteCount: 20},
+			"nvme0n2": {Name: "nvme0n2", ReadCount: 1, WriteCount: 2},
+		}, nil
+	}
+	scraper.isWholeDisk = func(context.Context, string) bool {
+		return true
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	for i := 0; i < 2; i++ {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{totalDevice}, reportedDevices(md))
+
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			if metrics.At(j).Name() != "system.disk.operations" {
+				continue
+			}
+			dps := metrics.At(j).Sum().DataPoints()
+			for k := 0; k < dps.Len(); k++ {
+				direction, _ := dps.At(k).Attributes().Get("direction")
+				switch direction.Str() {
+				case "read":
+					assert.Equal(t, int64(11), dps.At(k).IntValue())
+				case "write":
+					assert.Equal(t, int64(22), dps.At(k).IntValue())
+				}
+			}
+		}
+	}
+}
+
+func TestScrape_Utilization(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.Metrics.SystemDiskUtilization.Enabled = true
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"half":  {Name: "half", IoTime: 2000},
+			"full":  {Name: "full", IoTime: 10000},
+			"reset": {Name: "reset", IoTime: 10},
+			"new":   {Name: "new", IoTime: 5000},
+		}, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	// samples taken 2s before the scrape
+	prevTS := pcommon.NewTimestampFromTime(time.Now().Add(-2 * time.Second))
+	scraper.prevIOTime = map[string]ioTimeSample{
+		"half":  {ioTime: 1000, ts: prevTS},
+		"full":  {ioTime: 1000, ts: prevTS},
+		"reset": {ioTime: 1000, ts: prevTS},
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	utilization := map[string]float64{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() != "system.disk.utilization" {
+			continue
+		}
+		dps := metrics.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			device, _ := dps.At(j).Attributes().Get("device")
+			utilization[device.Str()] = dps.At(j).DoubleValue()
+		}
+	}
+	require.Len(t, utilization, 2)
+	assert.InDelta(t, 0.5, utilization["half"], 0.05)
+	// the io time delta exceeds the elapsed time, so the utilization is capped
+	assert.Equal(t, 1.0, utilization["full"])
+
+	// every device has a sample for the next scrape
+	assert.Len(t, scraper.prevIOTime, 4)
+}
+
+func TestScrape_OperationLatency(t *testing.T) {
+	counters := map[string]disk.IOCountersStat{
+		"sda": {Name: "sda", ReadCount: 10, ReadTime: 100, WriteCount: 4, WriteTime: 40},
+	}
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.Metrics.SystemDiskOperationLatency.Enabled = true
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		result := make(map[string]disk.IOCountersStat, len(counters))
+		for device, ioCounter := range counters {
+			result[device] = ioCounter
+		}
+		return result, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	latencies := func() map[string]float64 {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		values := map[string]float64{}
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			if metrics.At(i).Name() != "system.disk.operation.latency" {
+				continue
+			}
+			dps := metrics.At(i).Gauge().DataPoints()
+			for j := 0; j < dps.Len(); j++ {
+				device, _ := dps.At(j).Attributes().Get("device")
+				direction, _ := dps.At(j).Attributes().Get("direction")
+				values[device.Str()+"/"+direction.Str()] = dps.At(j).DoubleValue()
+			}
+		}
+		return values
+	}
+
+	// the first scrape only records the counters
+	assert.Empty(t, latencies())
+
+	// 10 reads in 50ms, and 5 writes completing instantly
+	counters["sda"] = disk.IOCountersStat{Name: "sda", ReadCount: 20, ReadTime: 150, WriteCount: 9, WriteTime: 40}
+	values := latencies()
+	assert.Len(t, values, 2)
+	assert.InDelta(t, 0.005, values["sda/read"], 1e-9)
+	assert.Equal(t, float64(0), values["sda/write"])
+
+	// no operation completed, and the write counters were reset
+	counters["sda"] = disk.IOCountersStat{Name: "sda", ReadCount: 20, ReadTime: 150, WriteCount: 1, WriteTime: 2}
+	assert.Empty(t, latencies())
+}
+
+func TestScrape_SMART(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.Metrics.SystemDiskSmartTemperature.Enabled = true
+	cfg.Metrics.SystemDiskSmartPercentageUsed.Enabled = true
+	cfg.Metrics.SystemDiskSmartMediaErrors.Enabled = true
+	cfg.Metrics.SystemDiskSmartUnsafeShutdowns.Enabled = true
+	cfg.Exclude = MatchConfig{Config: filterset.Config{MatchType: filterset.Strict}, Devices: []string{"nvme2"}}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{}, nil
+	}
+	scraper.smartLogs = func(context.Context) (map[string]smartLog, error) {
+		return map[string]smartLog{
+			"nvme0": {temperature: 36.85, percentageUsed: 3, mediaErrors: 1, unsafeShutdowns: 12},
+			"nvme2": {temperature: 40},
+		}, errors.New("failed to read SMART log of nvme1: operation not permitted")
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+
+	values := map[string]float64{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		var dps pmetric.NumberDataPointSlice
+		if metrics.At(i).Type() == pmetric.MetricTypeGauge {
+			dps = metrics.At(i).Gauge().DataPoints()
+		} else {
+			dps = metrics.At(i).Sum().DataPoints()
+		}
+		require.Equal(t, 1, dps.Len())
+		device, _ := dps.At(0).Attributes().Get("device")
+		assert.Equal(t, "nvme0", device.Str())
+		if dps.At(0).ValueType() == pmetric.NumberDataPointValueTypeDouble {
+			values[metrics.At(i).Name()] = dps.At(0).DoubleValue()
+		} else {
+			values[metrics.At(i).Name()] = float64(dps.At(0).IntValue())
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"system.disk.smart.temperature":      36.85,
+		"system.disk.smart.percentage_used":  3,
+		"system.disk.smart.media_errors":     1,
+		"system.disk.smart.unsafe_shutdowns": 12,
+	}, values)
+}
+
+func TestScrape_Zpool(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.Metrics.SystemDiskZpoolHealth.Enabled = true
+	cfg.Metrics.SystemDiskZpoolIo.Enabled = true
+	cfg.Metrics.SystemDiskZpoolOperations.Enabled = true
+	cfg.Exclude = MatchConfig{Config: filterset.Config{MatchType: filterset.Strict}, Devices: []string{"scratch"}}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{}, nil
+	}
+	scraper.zpoolStats = func(context.Context) (map[string]zpoolStat, error) {
+		return map[string]zpoolStat{
+			"tank":    {state: "DEGRADED", reads: 10, writes: 20, readBytes: 4096, writeBytes: 8192},
+			"scratch": {state: "ONLINE"},
+		}, errors.New("failed to read stats of ZFS pool backup: permission denied")
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+
+	values := map[string]int64{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		var dps pmetric.NumberDataPointSlice
+		if metrics.At(i).Type() == pmetric.MetricTypeGauge {
+			dps = metrics.At(i).Gauge().DataPoints()
+		} else {
+			dps = metrics.At(i).Sum().DataPoints()
+		}
+		for j := 0; j < dps.Len(); j++ {
+			device, _ := dps.At(j).Attributes().Get("device")
+			assert.Equal(t, "tank", device.Str())
+			key := metrics.At(i).Name()
+			if state, ok := dps.At(j).Attributes().Get("state"); ok {
+				key += "/" + state.Str()
+			}
+			if direction, ok := dps.At(j).Attributes().Get("direction"); ok {
+				key += "/" + direction.Str()
+			}
+			values[key] = dps.At(j).IntValue()
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"system.disk.zpool.health/DEGRADED":  1,
+		"system.disk.zpool.io/read":          4096,
+		"system.disk.zpool.io/write":         8192,
+		"system.disk.zpool.operations/read":  10,
+		"system.disk.zpool.operations/write": 20,
+	}, values)
+}
+
+func TestScrape_ExcludeDevicesRegexp(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.Exclude = MatchConfig{Config: filterset.Config{MatchType: filterset.Regexp}, Devices: []string{`^loop\d+$`, `^dm-\d+$`, `^sr\d+$`}}
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.ioCounters = func(context.Context, ...string) (map[string]disk.IOCountersStat, error) {
+		return map[string]disk.IOCountersStat{
+			"sda":     {Name: "sda"},
+			"nvme0n1": {Name: "nvme0n1"},
+			"loop0":   {Name: "loop0"},
+			"loop12":  {Name: "loop12"},
+			"dm-0":    {Name: "dm-0"},
+			"sr0":     {Name: "sr0"},
+		}, nil
+	}
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"sda", "nvme0n1"}, reportedDevices(md))
+}
+
+// reportedDevices returns the devices that have a system.disk.io data point.
+func reportedDevices(md pmetric.Metrics) []string {
+	var devices []string
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() != "system.disk.io" {
+			continue
+		}
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			if dir, _ := dps.At(j).Attributes().Get("direction"); dir.Str() != "read" {
+				continue
+			}
+			device, _ := dps.At(j).Attributes().Get("device")
+			devices = append(devices, device.Str())
+		}
+	}
+	return devices
+}
@@ -24,7 +24,11 @@ import (
 const (
 	metricsLen = 5
 
-	logicalDisk = "LogicalDisk"
+	logicalDisk  = "LogicalDisk"
+	physicalDisk = "PhysicalDisk"
+
+	// diskTypeAttribute is the data point attribute added when `scrape_physical_disks` is enabled.
+	diskTypeAttribute = "disk.type"
 
 	readsPerSec  = "Disk Reads/sec"
 	writesPerSec = "Disk Writes/sec"
@@ -38,6 +42,8 @@ const (
 	avgDiskSecsPerWrite = "Avg. Disk sec/Write"
 
 	queueLength = "Current Disk Queue Length"
+
+	splitIOPerSec = "Split IO/Sec"
 )
 
 // scraper for Disk Metrics
@@ -88,7 +94,11 @@ func (s *scraper) start(ctx context.Context, _ component.Host) error {
 	s.startTime = pcommon.Timestamp(bootTime * 1e9)
 	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(s.startTime))
 
-	if err = s.perfCounterScraper.Initialize(logicalDisk); err != nil {
+	objects := []string{logicalDisk}
+	if s.config.ScrapePhysicalDisks {
+		objects = append(objects, physicalDisk)
+	}
+	if err = s.perfCounterScraper.Initialize(objects...); err != nil {
 		s.settings.Logger.Error("Failed to initialize performance counter, disk metrics will not be scraped", zap.Error(err))
 		s.skipScrape = true
 	}
@@ -108,28 +118,55 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
 
-	logicalDiskObject, err := counters.GetObject(logicalDisk)
-	if err != nil {
+	if err = s.recordObject(now, counters, logicalDisk); err != nil {
 		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
+	md := s.mb.Emit()
+	if !s.config.ScrapePhysicalDisks {
+		return md, nil
+	}
 
-	// filter devices by name
-	logicalDiskObject.Filter(s.includeFS, s.excludeFS, false)
+	// physical disks are told apart from logical disks by an attribute, as they share the same metrics
+	setDiskType(md, logicalDisk)
+	if err = s.recordObject(now, counters, physicalDisk); err != nil {
+		return md, scrapererror.NewPartialScrapeError(err, metricsLen)
+	}
+	physicalMD := s.mb.Emit()
+	setDiskType(physicalMD, physicalDisk)
+	mergeMetrics(md, physicalMD)
+	return md, nil
+}
 
-	logicalDiskCounterValues, err := logicalDiskObject.GetValues(readsPerSec, writesPerSec, readBytesPerSec, writeBytesPerSec, idleTime, avgDiskSecsPerRead, avgDiskSecsPerWrite, queueLength)
+// recordObject records the data points of the instances of the LogicalDisk or PhysicalDisk object.
+func (s *scraper) recordObject(now pcommon.Timestamp, counters perfcounters.PerfDataCollection, object string) error {
+	diskObject, err := counters.GetObject(object)
 	if err != nil {
-		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+		return err
 	}
 
-	if len(logicalDiskCounterValues) > 0 {
-		s.recordDiskIOMetric(now, logicalDiskCounterValues)
-		s.recordDiskOperationsMetric(now, logicalDiskCounterValues)
-		s.recordDiskIOTimeMetric(now, logicalDiskCounterValues)
-		s.recordDiskOperationTimeMetric(now, logicalDiskCounterValues)
-		s.recordDiskPendingOperationsMetric(now, logicalDiskCounterValues)
+	// filter devices by name
+	diskObject.Filter(s.includeFS, s.excludeFS, false)
+
+	counterNames := []string{readsPerSec, writesPerSec, readBytesPerSec, writeBytesPerSec, idleTime, avgDiskSecsPerRead, avgDiskSecsPerWrite, queueLength}
+	if s.config.Metrics.SystemDiskSplitOperations.Enabled {
+		counterNames = append(counterNames, splitIOPerSec)
+	}
+	diskCounterValues, err := diskObject.GetValues(counterNames...)
+	if err != nil {
+		return err
 	}
 
-	return s.mb.Emit(), nil
+	if len(diskCounterValues) > 0 {
+		s.recordDiskIOMetric(now, diskCounterValues)
+		s.recordDiskOperationsMetric(now, diskCounterValues)
+		s.recordDiskIOTimeMetric(now, diskCounterValues)
+		s.recordDiskOperationTimeMetric(now, diskCounterValues)
+		s.recordDiskPendingOperationsMetric(now, diskCounterValues)
+		if s.config.Metrics.SystemDiskSplitOperations.Enabled {
+			s.recordDiskSplitOperationsMetric(now, diskCounterValues)
+		}
+	}
+	return nil
 }
 
 func (s *scraper) recordDiskIOMetric(now pcommon.Timestamp, logicalDiskCounterValues []*perfcounters.CounterValues) {
@@ -165,3 +202,75 @@ func (s *scraper) recordDiskPendingOperationsMetric(now pcommon.Timestamp, logic
 		s.mb.RecordSystemDiskPendingOperationsDataPoint(now, logicalDiskCounter.Values[queueLength], logicalDiskCounter.InstanceName)
 	}
 }
+
+func (s *scraper) recordDiskSplitOperationsMetric(now pcommon.Timestamp, logicalDiskCounterValues []*perfcounters.CounterValues) {
+	for _, logicalDiskCounter := range logicalDiskCounterValues {
+		s.mb.RecordSystemDiskSplitOperationsDataPoint(now, logicalDiskCounter.Values[splitIOPerSec], logicalDiskCounter.InstanceName)
+	}
+}
+
+// setDiskType sets the `disk.type` attribute of every data point of md to `logical` or `physical`,
+// depending on the object the data points were scraped from.
+func setDiskType(md pmetric.Metrics, object string) {
+	diskType := "logical"
+	if object == physicalDisk {
+		diskType = "physical"
+	}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				var dps pmetric.NumberDataPointSlice
+				switch metrics.At(k).Type() {
+				case pmetric.MetricTypeGauge:
+					dps = metrics.At(k).Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					dps = metrics.At(k).Sum().DataPoints()
+				default:
+					continue
+				}
+				for l := 0; l < dps.Len(); l++ {
+					dps.At(l).Attributes().PutStr(diskTypeAttribute, diskType)
+				}
+			}
+		}
+	}
+}
+
+// mergeMetrics moves the data points of the metrics of src to the metrics of the same name of dst,
+// which are appended to dst when missing. Both must hold a single resource and scope, as emitted
+// by the metrics builder.
+func mergeMetrics(dst, src pmetric.Metrics) {
+	if src.ResourceMetrics().Len() == 0 {
+		return
+	}
+	if dst.ResourceMetrics().Len() == 0 {
+		src.ResourceMetrics().MoveAndAppendTo(dst.ResourceMetrics())
+		return
+	}
+	dstMetrics := dst.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	srcMetrics := src.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < srcMetrics.Len(); i++ {
+		srcMetric := srcMetrics.At(i)
+		merged := false
+		for j := 0; j < dstMetrics.Len() && !merged; j++ {
+			dstMetric := dstMetrics.At(j)
+			if dstMetric.Name() != srcMetric.Name() || dstMetric.Type() != srcMetric.Type() {
+				continue
+			}
+			switch srcMetric.Type() {
+			case pmetric.MetricTypeGauge:
+				srcMetric.Gauge().DataPoints().MoveAndAppendTo(dstMetric.Gauge().DataPoints())
+				merged = true
+			case pmetric.MetricTypeSum:
+				srcMetric.Sum().DataPoints().MoveAndAppendTo(dstMetric.Sum().DataPoints())
+				merged = true
+			}
+		}
+		if !merged {
+			srcMetric.CopyTo(dstMetrics.AppendEmpty())
+		}
+	}
+}
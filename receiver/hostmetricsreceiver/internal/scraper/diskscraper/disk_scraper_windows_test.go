@@ -17,6 +17,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/perfcounters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper/internal/metadata"
 )
 
 func TestScrape_Error(t *testing.T) {
@@ -104,3 +105,58 @@ func TestStart_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestScrape_PhysicalDisks(t *testing.T) {
+	counterValues := func(value int64) map[string][]int64 {
+		return map[string][]int64{
+			readsPerSec:         {value},
+			writesPerSec:        {value},
+			readBytesPerSec:     {value},
+			writeBytesPerSec:    {value},
+			idleTime:            {value},
+			avgDiskSecsPerRead:  {value},
+			avgDiskSecsPerWrite: {value},
+			queueLength:         {value},
+			splitIOPerSec:       {value},
+		}
+	}
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(), ScrapePhysicalDisks: true}
+	cfg.Metrics.SystemDiskSplitOperations.Enabled = true
+	scraper, err := newDiskScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err, "Failed to create disk scraper: %v", err)
+	scraper.perfCounterScraper = perfcounters.NewMockPerfCounterScraper(map[string]map[string][]int64{
+		logicalDisk:  counterValues(1),
+		physicalDisk: counterValues(2),
+	})
+
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize disk scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, metricsLen+1, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		dps := metrics.At(i).Sum().DataPoints()
+		diskTypes := map[string]int{}
+		for j := 0; j < dps.Len(); j++ {
+			diskType, ok := dps.At(j).Attributes().Get(diskTypeAttribute)
+			require.True(t, ok)
+			diskTypes[diskType.Str()]++
+		}
+		assert.Equal(t, diskTypes["logical"], diskTypes["physical"], metrics.At(i).Name())
+		assert.Positive(t, diskTypes["physical"], metrics.At(i).Name())
+	}
+
+	split := metrics.At(metrics.Len() - 1)
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "system.disk.split_operations" {
+			split = metrics.At(i)
+		}
+	}
+	require.Equal(t, 2, split.Sum().DataPoints().Len())
+	assert.Equal(t, int64(1), split.Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(2), split.Sum().DataPoints().At(1).IntValue())
+}
@@ -5,6 +5,8 @@ package diskscraper // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"errors"
+	"runtime"
 
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -18,6 +20,9 @@ import (
 const (
 	// TypeStr the value of "type" key in configuration.
 	TypeStr = "disk"
+
+	// defaultTopProcesses is the default number of processes reported by the system.disk.process.io metric.
+	defaultTopProcesses = 10
 )
 
 // Factory is the Factory for scraper.
@@ -28,6 +33,7 @@ type Factory struct {
 func (f *Factory) CreateDefaultConfig() internal.Config {
 	return &Config{
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		TopProcesses:         defaultTopProcesses,
 	}
 }
 
@@ -37,6 +43,11 @@ func (f *Factory) CreateMetricsScraper(
 	settings receiver.CreateSettings,
 	config internal.Config,
 ) (scraperhelper.Scraper, error) {
+	// the I/O counters of the disks are not implemented on AIX
+	if runtime.GOOS == "aix" {
+		return nil, errors.New("disk scraper not available on AIX")
+	}
+
 	cfg := config.(*Config)
 	s, err := newDiskScraper(ctx, settings, cfg)
 	if err != nil {
@@ -4,6 +4,7 @@ package metadata
 
 import (
 	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/filter"
 )
 
 // MetricConfig provides common config for a particular metric.
@@ -27,17 +28,48 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for hostmetricsreceiver/disk metrics.
 type MetricsConfig struct {
-	SystemDiskIo                MetricConfig `mapstructure:"system.disk.io"`
-	SystemDiskIoTime            MetricConfig `mapstructure:"system.disk.io_time"`
-	SystemDiskMerged            MetricConfig `mapstructure:"system.disk.merged"`
-	SystemDiskOperationTime     MetricConfig `mapstructure:"system.disk.operation_time"`
-	SystemDiskOperations        MetricConfig `mapstructure:"system.disk.operations"`
-	SystemDiskPendingOperations MetricConfig `mapstructure:"system.disk.pending_operations"`
-	SystemDiskWeightedIoTime    MetricConfig `mapstructure:"system.disk.weighted_io_time"`
+	SystemDiskDiscardIo            MetricConfig `mapstructure:"system.disk.discard.io"`
+	SystemDiskDiscardOperations    MetricConfig `mapstructure:"system.disk.discard.operations"`
+	SystemDiskFlushOperations      MetricConfig `mapstructure:"system.disk.flush.operations"`
+	SystemDiskFlushTime            MetricConfig `mapstructure:"system.disk.flush.time"`
+	SystemDiskInflight             MetricConfig `mapstructure:"system.disk.inflight"`
+	SystemDiskIo                   MetricConfig `mapstructure:"system.disk.io"`
+	SystemDiskIoTime               MetricConfig `mapstructure:"system.disk.io_time"`
+	SystemDiskMerged               MetricConfig `mapstructure:"system.disk.merged"`
+	SystemDiskOperationLatency     MetricConfig `mapstructure:"system.disk.operation.latency"`
+	SystemDiskOperationTime        MetricConfig `mapstructure:"system.disk.operation_time"`
+	SystemDiskOperations           MetricConfig `mapstructure:"system.disk.operations"`
+	SystemDiskPendingOperations    MetricConfig `mapstructure:"system.disk.pending_operations"`
+	SystemDiskProcessIo            MetricConfig `mapstructure:"system.disk.process.io"`
+	SystemDiskSmartMediaErrors     MetricConfig `mapstructure:"system.disk.smart.media_errors"`
+	SystemDiskSmartPercentageUsed  MetricConfig `mapstructure:"system.disk.smart.percentage_used"`
+	SystemDiskSmartTemperature     MetricConfig `mapstructure:"system.disk.smart.temperature"`
+	SystemDiskSmartUnsafeShutdowns MetricConfig `mapstructure:"system.disk.smart.unsafe_shutdowns"`
+	SystemDiskSplitOperations      MetricConfig `mapstructure:"system.disk.split_operations"`
+	SystemDiskUtilization          MetricConfig `mapstructure:"system.disk.utilization"`
+	SystemDiskWeightedIoTime       MetricConfig `mapstructure:"system.disk.weighted_io_time"`
+	SystemDiskZpoolHealth          MetricConfig `mapstructure:"system.disk.zpool.health"`
+	SystemDiskZpoolIo              MetricConfig `mapstructure:"system.disk.zpool.io"`
+	SystemDiskZpoolOperations      MetricConfig `mapstructure:"system.disk.zpool.operations"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		SystemDiskDiscardIo: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskDiscardOperations: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskFlushOperations: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskFlushTime: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskInflight: MetricConfig{
+			Enabled: false,
+		},
 		SystemDiskIo: MetricConfig{
 			Enabled: true,
 		},
@@ -47,6 +79,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		SystemDiskMerged: MetricConfig{
 			Enabled: true,
 		},
+		SystemDiskOperationLatency: MetricConfig{
+			Enabled: false,
+		},
 		SystemDiskOperationTime: MetricConfig{
 			Enabled: true,
 		},
@@ -56,19 +91,118 @@ func DefaultMetricsConfig() MetricsConfig {
 		SystemDiskPendingOperations: MetricConfig{
 			Enabled: true,
 		},
+		SystemDiskProcessIo: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskSmartMediaErrors: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskSmartPercentageUsed: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskSmartTemperature: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskSmartUnsafeShutdowns: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskSplitOperations: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskUtilization: MetricConfig{
+			Enabled: false,
+		},
 		SystemDiskWeightedIoTime: MetricConfig{
 			Enabled: true,
 		},
+		SystemDiskZpoolHealth: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskZpoolIo: MetricConfig{
+			Enabled: false,
+		},
+		SystemDiskZpoolOperations: MetricConfig{
+			Enabled: false,
+		},
+	}
+}
+
+// ResourceAttributeConfig provides common config for a particular resource attribute.
+type ResourceAttributeConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Experimental: MetricsInclude defines a list of filters for attribute values.
+	// If the list is not empty, only metrics with matching resource attribute values will be emitted.
+	MetricsInclude []filter.Config `mapstructure:"metrics_include"`
+	// Experimental: MetricsExclude defines a list of filters for attribute values.
+	// If the list is not empty, metrics with matching resource attribute values will not be emitted.
+	// MetricsInclude has higher priority than MetricsExclude.
+	MetricsExclude []filter.Config `mapstructure:"metrics_exclude"`
+
+	enabledSetByUser bool
+}
+
+func (rac *ResourceAttributeConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+	err := parser.Unmarshal(rac)
+	if err != nil {
+		return err
+	}
+	rac.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// ResourceAttributesConfig provides config for hostmetricsreceiver/disk resource attributes.
+type ResourceAttributesConfig struct {
+	DiskDmName     ResourceAttributeConfig `mapstructure:"disk.dm_name"`
+	DiskModel      ResourceAttributeConfig `mapstructure:"disk.model"`
+	DiskRotational ResourceAttributeConfig `mapstructure:"disk.rotational"`
+	DiskScheduler  ResourceAttributeConfig `mapstructure:"disk.scheduler"`
+	DiskSerial     ResourceAttributeConfig `mapstructure:"disk.serial"`
+	DiskTransport  ResourceAttributeConfig `mapstructure:"disk.transport"`
+	Mountpoint     ResourceAttributeConfig `mapstructure:"mountpoint"`
+	RaidArray      ResourceAttributeConfig `mapstructure:"raid.array"`
+}
+
+func DefaultResourceAttributesConfig() ResourceAttributesConfig {
+	return ResourceAttributesConfig{
+		DiskDmName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		DiskModel: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		DiskRotational: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		DiskScheduler: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		DiskSerial: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		DiskTransport: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		Mountpoint: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		RaidArray: ResourceAttributeConfig{
+			Enabled: false,
+		},
 	}
 }
 
 // MetricsBuilderConfig is a configuration for hostmetricsreceiver/disk metrics builder.
 type MetricsBuilderConfig struct {
-	Metrics MetricsConfig `mapstructure:"metrics"`
+	Metrics            MetricsConfig            `mapstructure:"metrics"`
+	ResourceAttributes ResourceAttributesConfig `mapstructure:"resource_attributes"`
 }
 
 func DefaultMetricsBuilderConfig() MetricsBuilderConfig {
 	return MetricsBuilderConfig{
-		Metrics: DefaultMetricsConfig(),
+		Metrics:            DefaultMetricsConfig(),
+		ResourceAttributes: DefaultResourceAttributesConfig(),
 	}
 }
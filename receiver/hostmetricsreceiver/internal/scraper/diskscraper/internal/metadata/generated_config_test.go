@@ -26,13 +26,39 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemDiskIo:                MetricConfig{Enabled: true},
-					SystemDiskIoTime:            MetricConfig{Enabled: true},
-					SystemDiskMerged:            MetricConfig{Enabled: true},
-					SystemDiskOperationTime:     MetricConfig{Enabled: true},
-					SystemDiskOperations:        MetricConfig{Enabled: true},
-					SystemDiskPendingOperations: MetricConfig{Enabled: true},
-					SystemDiskWeightedIoTime:    MetricConfig{Enabled: true},
+					SystemDiskDiscardIo:            MetricConfig{Enabled: true},
+					SystemDiskDiscardOperations:    MetricConfig{Enabled: true},
+					SystemDiskFlushOperations:      MetricConfig{Enabled: true},
+					SystemDiskFlushTime:            MetricConfig{Enabled: true},
+					SystemDiskInflight:             MetricConfig{Enabled: true},
+					SystemDiskIo:                   MetricConfig{Enabled: true},
+					SystemDiskIoTime:               MetricConfig{Enabled: true},
+					SystemDiskMerged:               MetricConfig{Enabled: true},
+					SystemDiskOperationLatency:     MetricConfig{Enabled: true},
+					SystemDiskOperationTime:        MetricConfig{Enabled: true},
+					SystemDiskOperations:           MetricConfig{Enabled: true},
+					SystemDiskPendingOperations:    MetricConfig{Enabled: true},
+					SystemDiskProcessIo:            MetricConfig{Enabled: true},
+					SystemDiskSmartMediaErrors:     MetricConfig{Enabled: true},
+					SystemDiskSmartPercentageUsed:  MetricConfig{Enabled: true},
+					SystemDiskSmartTemperature:     MetricConfig{Enabled: true},
+					SystemDiskSmartUnsafeShutdowns: MetricConfig{Enabled: true},
+					SystemDiskSplitOperations:      MetricConfig{Enabled: true},
+					SystemDiskUtilization:          MetricConfig{Enabled: true},
+					SystemDiskWeightedIoTime:       MetricConfig{Enabled: true},
+					SystemDiskZpoolHealth:          MetricConfig{Enabled: true},
+					SystemDiskZpoolIo:              MetricConfig{Enabled: true},
+					SystemDiskZpoolOperations:      MetricConfig{Enabled: true},
+				},
+				ResourceAttributes: ResourceAttributesConfig{
+					DiskDmName:     ResourceAttributeConfig{Enabled: true},
+					DiskModel:      ResourceAttributeConfig{Enabled: true},
+					DiskRotational: ResourceAttributeConfig{Enabled: true},
+					DiskScheduler:  ResourceAttributeConfig{Enabled: true},
+					DiskSerial:     ResourceAttributeConfig{Enabled: true},
+					DiskTransport:  ResourceAttributeConfig{Enabled: true},
+					Mountpoint:     ResourceAttributeConfig{Enabled: true},
+					RaidArray:      ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -40,13 +66,39 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemDiskIo:                MetricConfig{Enabled: false},
-					SystemDiskIoTime:            MetricConfig{Enabled: false},
-					SystemDiskMerged:            MetricConfig{Enabled: false},
-					SystemDiskOperationTime:     MetricConfig{Enabled: false},
-					SystemDiskOperations:        MetricConfig{Enabled: false},
-					SystemDiskPendingOperations: MetricConfig{Enabled: false},
-					SystemDiskWeightedIoTime:    MetricConfig{Enabled: false},
+					SystemDiskDiscardIo:            MetricConfig{Enabled: false},
+					SystemDiskDiscardOperations:    MetricConfig{Enabled: false},
+					SystemDiskFlushOperations:      MetricConfig{Enabled: false},
+					SystemDiskFlushTime:            MetricConfig{Enabled: false},
+					SystemDiskInflight:             MetricConfig{Enabled: false},
+					SystemDiskIo:                   MetricConfig{Enabled: false},
+					SystemDiskIoTime:               MetricConfig{Enabled: false},
+					SystemDiskMerged:               MetricConfig{Enabled: false},
+					SystemDiskOperationLatency:     MetricConfig{Enabled: false},
+					SystemDiskOperationTime:        MetricConfig{Enabled: false},
+					SystemDiskOperations:           MetricConfig{Enabled: false},
+					SystemDiskPendingOperations:    MetricConfig{Enabled: false},
+					SystemDiskProcessIo:            MetricConfig{Enabled: false},
+					SystemDiskSmartMediaErrors:     MetricConfig{Enabled: false},
+					SystemDiskSmartPercentageUsed:  MetricConfig{Enabled: false},
+					SystemDiskSmartTemperature:     MetricConfig{Enabled: false},
+					SystemDiskSmartUnsafeShutdowns: MetricConfig{Enabled: false},
+					SystemDiskSplitOperations:      MetricConfig{Enabled: false},
+					SystemDiskUtilization:          MetricConfig{Enabled: false},
+					SystemDiskWeightedIoTime:       MetricConfig{Enabled: false},
+					SystemDiskZpoolHealth:          MetricConfig{Enabled: false},
+					SystemDiskZpoolIo:              MetricConfig{Enabled: false},
+					SystemDiskZpoolOperations:      MetricConfig{Enabled: false},
+				},
+				ResourceAttributes: ResourceAttributesConfig{
+					DiskDmName:     ResourceAttributeConfig{Enabled: false},
+					DiskModel:      ResourceAttributeConfig{Enabled: false},
+					DiskRotational: ResourceAttributeConfig{Enabled: false},
+					DiskScheduler:  ResourceAttributeConfig{Enabled: false},
+					DiskSerial:     ResourceAttributeConfig{Enabled: false},
+					DiskTransport:  ResourceAttributeConfig{Enabled: false},
+					Mountpoint:     ResourceAttributeConfig{Enabled: false},
+					RaidArray:      ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -54,7 +106,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := loadMetricsBuilderConfig(t, tt.name)
-			if diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(MetricConfig{})); diff != "" {
+			if diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(MetricConfig{}, ResourceAttributeConfig{})); diff != "" {
 				t.Errorf("Config mismatch (-expected +actual):\n%s", diff)
 			}
 		})
@@ -70,3 +122,61 @@ func loadMetricsBuilderConfig(t *testing.T, name string) MetricsBuilderConfig {
 	require.NoError(t, component.UnmarshalConfig(sub, &cfg))
 	return cfg
 }
+
+func TestResourceAttributesConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		want ResourceAttributesConfig
+	}{
+		{
+			name: "default",
+			want: DefaultResourceAttributesConfig(),
+		},
+		{
+			name: "all_set",
+			want: ResourceAttributesConfig{
+				DiskDmName:     ResourceAttributeConfig{Enabled: true},
+				DiskModel:      ResourceAttributeConfig{Enabled: true},
+				DiskRotational: ResourceAttributeConfig{Enabled: true},
+				DiskScheduler:  ResourceAttributeConfig{Enabled: true},
+				DiskSerial:     ResourceAttributeConfig{Enabled: true},
+				DiskTransport:  ResourceAttributeConfig{Enabled: true},
+				Mountpoint:     ResourceAttributeConfig{Enabled: true},
+				RaidArray:      ResourceAttributeConfig{Enabled: true},
+			},
+		},
+		{
+			name: "none_set",
+			want: ResourceAttributesConfig{
+				DiskDmName:     ResourceAttributeConfig{Enabled: false},
+				DiskModel:      ResourceAttributeConfig{Enabled: false},
+				DiskRotational: ResourceAttributeConfig{Enabled: false},
+				DiskScheduler:  ResourceAttributeConfig{Enabled: false},
+				DiskSerial:     ResourceAttributeConfig{Enabled: false},
+				DiskTransport:  ResourceAttributeConfig{Enabled: false},
+				Mountpoint:     ResourceAttributeConfig{Enabled: false},
+				RaidArray:      ResourceAttributeConfig{Enabled: false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadResourceAttributesConfig(t, tt.name)
+			if diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(ResourceAttributeConfig{})); diff != "" {
+				t.Errorf("Config mismatch (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func loadResourceAttributesConfig(t *testing.T, name string) ResourceAttributesConfig {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	sub, err := cm.Sub(name)
+	require.NoError(t, err)
+	sub, err = sub.Sub("resource_attributes")
+	require.NoError(t, err)
+	cfg := DefaultResourceAttributesConfig()
+	require.NoError(t, component.UnmarshalConfig(sub, &cfg))
+	return cfg
+}
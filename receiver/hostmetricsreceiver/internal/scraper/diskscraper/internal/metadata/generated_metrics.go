@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/filter"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
@@ -38,53 +39,796 @@ var MapAttributeDirection = map[string]AttributeDirection{
 	"write": AttributeDirectionWrite,
 }
 
+type metricSystemDiskDiscardIo struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.discard.io metric with initial data.
+func (m *metricSystemDiskDiscardIo) init() {
+	m.data.SetName("system.disk.discard.io")
+	m.data.SetDescription("Bytes discarded, for example by TRIM commands issued to SSDs.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskDiscardIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskDiscardIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskDiscardIo) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskDiscardIo(cfg MetricConfig) metricSystemDiskDiscardIo {
+	m := metricSystemDiskDiscardIo{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskDiscardOperations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.discard.operations metric with initial data.
+func (m *metricSystemDiskDiscardOperations) init() {
+	m.data.SetName("system.disk.discard.operations")
+	m.data.SetDescription("Discard operations count.")
+	m.data.SetUnit("{operations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskDiscardOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskDiscardOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskDiscardOperations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskDiscardOperations(cfg MetricConfig) metricSystemDiskDiscardOperations {
+	m := metricSystemDiskDiscardOperations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskFlushOperations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.flush.operations metric with initial data.
+func (m *metricSystemDiskFlushOperations) init() {
+	m.data.SetName("system.disk.flush.operations")
+	m.data.SetDescription("Cache flush operations count.")
+	m.data.SetUnit("{operations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskFlushOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskFlushOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskFlushOperations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskFlushOperations(cfg MetricConfig) metricSystemDiskFlushOperations {
+	m := metricSystemDiskFlushOperations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskFlushTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.flush.time metric with initial data.
+func (m *metricSystemDiskFlushTime) init() {
+	m.data.SetName("system.disk.flush.time")
+	m.data.SetDescription("Time spent in cache flush operations.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskFlushTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskFlushTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskFlushTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskFlushTime(cfg MetricConfig) metricSystemDiskFlushTime {
+	m := metricSystemDiskFlushTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskInflight struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.inflight metric with initial data.
+func (m *metricSystemDiskInflight) init() {
+	m.data.SetName("system.disk.inflight")
+	m.data.SetDescription("Number of I/O requests issued to the device driver that have not completed yet.")
+	m.data.SetUnit("{operations}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskInflight) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskInflight) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskInflight) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskInflight(cfg MetricConfig) metricSystemDiskInflight {
+	m := metricSystemDiskInflight{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSystemDiskIo struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills system.disk.io metric with initial data.
-func (m *metricSystemDiskIo) init() {
-	m.data.SetName("system.disk.io")
-	m.data.SetDescription("Disk bytes transferred.")
-	m.data.SetUnit("By")
-	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+// init fills system.disk.io metric with initial data.
+func (m *metricSystemDiskIo) init() {
+	m.data.SetName("system.disk.io")
+	m.data.SetDescription("Disk bytes transferred.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskIo) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskIo(cfg MetricConfig) metricSystemDiskIo {
+	m := metricSystemDiskIo{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskIoTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.io_time metric with initial data.
+func (m *metricSystemDiskIoTime) init() {
+	m.data.SetName("system.disk.io_time")
+	m.data.SetDescription("Time disk spent activated. On Windows, this is calculated as the inverse of disk idle time.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskIoTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskIoTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskIoTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskIoTime(cfg MetricConfig) metricSystemDiskIoTime {
+	m := metricSystemDiskIoTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskMerged struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.merged metric with initial data.
+func (m *metricSystemDiskMerged) init() {
+	m.data.SetName("system.disk.merged")
+	m.data.SetDescription("The number of disk reads/writes merged into single physical disk access operations.")
+	m.data.SetUnit("{operations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskMerged) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskMerged) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskMerged) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskMerged(cfg MetricConfig) metricSystemDiskMerged {
+	m := metricSystemDiskMerged{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskOperationLatency struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.operation.latency metric with initial data.
+func (m *metricSystemDiskOperationLatency) init() {
+	m.data.SetName("system.disk.operation.latency")
+	m.data.SetDescription("Average latency of the disk operations completed since the previous scrape.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskOperationLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskOperationLatency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskOperationLatency) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskOperationLatency(cfg MetricConfig) metricSystemDiskOperationLatency {
+	m := metricSystemDiskOperationLatency{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskOperationTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.operation_time metric with initial data.
+func (m *metricSystemDiskOperationTime) init() {
+	m.data.SetName("system.disk.operation_time")
+	m.data.SetDescription("Time spent in disk operations.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskOperationTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskOperationTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskOperationTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskOperationTime(cfg MetricConfig) metricSystemDiskOperationTime {
+	m := metricSystemDiskOperationTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskOperations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.operations metric with initial data.
+func (m *metricSystemDiskOperations) init() {
+	m.data.SetName("system.disk.operations")
+	m.data.SetDescription("Disk operations count.")
+	m.data.SetUnit("{operations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskOperations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskOperations(cfg MetricConfig) metricSystemDiskOperations {
+	m := metricSystemDiskOperations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskPendingOperations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.pending_operations metric with initial data.
+func (m *metricSystemDiskPendingOperations) init() {
+	m.data.SetName("system.disk.pending_operations")
+	m.data.SetDescription("The queue size of pending I/O operations.")
+	m.data.SetUnit("{operations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskPendingOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskPendingOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskPendingOperations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskPendingOperations(cfg MetricConfig) metricSystemDiskPendingOperations {
+	m := metricSystemDiskPendingOperations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskProcessIo struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.process.io metric with initial data.
+func (m *metricSystemDiskProcessIo) init() {
+	m.data.SetName("system.disk.process.io")
+	m.data.SetDescription("Disk bytes transferred by the processes that transferred the most bytes since the previous scrape.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskProcessIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, processPidAttributeValue int64, processExecutableNameAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutInt("process.pid", processPidAttributeValue)
+	dp.Attributes().PutStr("process.executable.name", processExecutableNameAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskProcessIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskProcessIo) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskProcessIo(cfg MetricConfig) metricSystemDiskProcessIo {
+	m := metricSystemDiskProcessIo{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskSmartMediaErrors struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.smart.media_errors metric with initial data.
+func (m *metricSystemDiskSmartMediaErrors) init() {
+	m.data.SetName("system.disk.smart.media_errors")
+	m.data.SetDescription("Number of unrecovered data integrity errors reported by the NVMe controller.")
+	m.data.SetUnit("{errors}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskSmartMediaErrors) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskSmartMediaErrors) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskSmartMediaErrors) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskSmartMediaErrors(cfg MetricConfig) metricSystemDiskSmartMediaErrors {
+	m := metricSystemDiskSmartMediaErrors{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskSmartPercentageUsed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.smart.percentage_used metric with initial data.
+func (m *metricSystemDiskSmartPercentageUsed) init() {
+	m.data.SetName("system.disk.smart.percentage_used")
+	m.data.SetDescription("Vendor estimate of the percentage of the NVMe device life used, which may exceed 100.")
+	m.data.SetUnit("%")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSystemDiskIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+func (m *metricSystemDiskSmartPercentageUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
 	dp.Attributes().PutStr("device", deviceAttributeValue)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSystemDiskIo) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricSystemDiskSmartPercentageUsed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSystemDiskIo) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricSystemDiskSmartPercentageUsed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricSystemDiskIo(cfg MetricConfig) metricSystemDiskIo {
-	m := metricSystemDiskIo{config: cfg}
+func newMetricSystemDiskSmartPercentageUsed(cfg MetricConfig) metricSystemDiskSmartPercentageUsed {
+	m := metricSystemDiskSmartPercentageUsed{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -92,43 +836,94 @@ func newMetricSystemDiskIo(cfg MetricConfig) metricSystemDiskIo {
 	return m
 }
 
-type metricSystemDiskIoTime struct {
+type metricSystemDiskSmartTemperature struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills system.disk.io_time metric with initial data.
-func (m *metricSystemDiskIoTime) init() {
-	m.data.SetName("system.disk.io_time")
-	m.data.SetDescription("Time disk spent activated. On Windows, this is calculated as the inverse of disk idle time.")
-	m.data.SetUnit("s")
+// init fills system.disk.smart.temperature metric with initial data.
+func (m *metricSystemDiskSmartTemperature) init() {
+	m.data.SetName("system.disk.smart.temperature")
+	m.data.SetDescription("Composite temperature of the NVMe controller.")
+	m.data.SetUnit("Cel")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskSmartTemperature) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskSmartTemperature) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskSmartTemperature) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskSmartTemperature(cfg MetricConfig) metricSystemDiskSmartTemperature {
+	m := metricSystemDiskSmartTemperature{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskSmartUnsafeShutdowns struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.smart.unsafe_shutdowns metric with initial data.
+func (m *metricSystemDiskSmartUnsafeShutdowns) init() {
+	m.data.SetName("system.disk.smart.unsafe_shutdowns")
+	m.data.SetDescription("Number of unsafe shutdowns of the NVMe controller.")
+	m.data.SetUnit("{shutdowns}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSystemDiskIoTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+func (m *metricSystemDiskSmartUnsafeShutdowns) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
+	dp.SetIntValue(val)
 	dp.Attributes().PutStr("device", deviceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSystemDiskIoTime) updateCapacity() {
+func (m *metricSystemDiskSmartUnsafeShutdowns) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSystemDiskIoTime) emit(metrics pmetric.MetricSlice) {
+func (m *metricSystemDiskSmartUnsafeShutdowns) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -136,8 +931,8 @@ func (m *metricSystemDiskIoTime) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSystemDiskIoTime(cfg MetricConfig) metricSystemDiskIoTime {
-	m := metricSystemDiskIoTime{config: cfg}
+func newMetricSystemDiskSmartUnsafeShutdowns(cfg MetricConfig) metricSystemDiskSmartUnsafeShutdowns {
+	m := metricSystemDiskSmartUnsafeShutdowns{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -145,16 +940,16 @@ func newMetricSystemDiskIoTime(cfg MetricConfig) metricSystemDiskIoTime {
 	return m
 }
 
-type metricSystemDiskMerged struct {
+type metricSystemDiskSplitOperations struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills system.disk.merged metric with initial data.
-func (m *metricSystemDiskMerged) init() {
-	m.data.SetName("system.disk.merged")
-	m.data.SetDescription("The number of disk reads/writes merged into single physical disk access operations.")
+// init fills system.disk.split_operations metric with initial data.
+func (m *metricSystemDiskSplitOperations) init() {
+	m.data.SetName("system.disk.split_operations")
+	m.data.SetDescription("The number of I/O requests split into multiple requests because of fragmentation or their size.")
 	m.data.SetUnit("{operations}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
@@ -162,7 +957,7 @@ func (m *metricSystemDiskMerged) init() {
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSystemDiskMerged) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+func (m *metricSystemDiskSplitOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -171,18 +966,17 @@ func (m *metricSystemDiskMerged) recordDataPoint(start pcommon.Timestamp, ts pco
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
 	dp.Attributes().PutStr("device", deviceAttributeValue)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSystemDiskMerged) updateCapacity() {
+func (m *metricSystemDiskSplitOperations) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSystemDiskMerged) emit(metrics pmetric.MetricSlice) {
+func (m *metricSystemDiskSplitOperations) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -190,8 +984,8 @@ func (m *metricSystemDiskMerged) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSystemDiskMerged(cfg MetricConfig) metricSystemDiskMerged {
-	m := metricSystemDiskMerged{config: cfg}
+func newMetricSystemDiskSplitOperations(cfg MetricConfig) metricSystemDiskSplitOperations {
+	m := metricSystemDiskSplitOperations{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -199,16 +993,67 @@ func newMetricSystemDiskMerged(cfg MetricConfig) metricSystemDiskMerged {
 	return m
 }
 
-type metricSystemDiskOperationTime struct {
+type metricSystemDiskUtilization struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills system.disk.operation_time metric with initial data.
-func (m *metricSystemDiskOperationTime) init() {
-	m.data.SetName("system.disk.operation_time")
-	m.data.SetDescription("Time spent in disk operations.")
+// init fills system.disk.utilization metric with initial data.
+func (m *metricSystemDiskUtilization) init() {
+	m.data.SetName("system.disk.utilization")
+	m.data.SetDescription("Fraction of time the disk was busy serving I/O since the previous scrape.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemDiskUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemDiskUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemDiskUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemDiskUtilization(cfg MetricConfig) metricSystemDiskUtilization {
+	m := metricSystemDiskUtilization{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemDiskWeightedIoTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.disk.weighted_io_time metric with initial data.
+func (m *metricSystemDiskWeightedIoTime) init() {
+	m.data.SetName("system.disk.weighted_io_time")
+	m.data.SetDescription("Time disk spent activated multiplied by the queue length.")
 	m.data.SetUnit("s")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
@@ -216,7 +1061,7 @@ func (m *metricSystemDiskOperationTime) init() {
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSystemDiskOperationTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string, directionAttributeValue string) {
+func (m *metricSystemDiskWeightedIoTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -225,18 +1070,17 @@ func (m *metricSystemDiskOperationTime) recordDataPoint(start pcommon.Timestamp,
 	dp.SetTimestamp(ts)
 	dp.SetDoubleValue(val)
 	dp.Attributes().PutStr("device", deviceAttributeValue)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSystemDiskOperationTime) updateCapacity() {
+func (m *metricSystemDiskWeightedIoTime) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSystemDiskOperationTime) emit(metrics pmetric.MetricSlice) {
+func (m *metricSystemDiskWeightedIoTime) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -244,8 +1088,8 @@ func (m *metricSystemDiskOperationTime) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSystemDiskOperationTime(cfg MetricConfig) metricSystemDiskOperationTime {
-	m := metricSystemDiskOperationTime{config: cfg}
+func newMetricSystemDiskWeightedIoTime(cfg MetricConfig) metricSystemDiskWeightedIoTime {
+	m := metricSystemDiskWeightedIoTime{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -253,53 +1097,51 @@ func newMetricSystemDiskOperationTime(cfg MetricConfig) metricSystemDiskOperatio
 	return m
 }
 
-type metricSystemDiskOperations struct {
+type metricSystemDiskZpoolHealth struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills system.disk.operations metric with initial data.
-func (m *metricSystemDiskOperations) init() {
-	m.data.SetName("system.disk.operations")
-	m.data.SetDescription("Disk operations count.")
-	m.data.SetUnit("{operations}")
-	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+// init fills system.disk.zpool.health metric with initial data.
+func (m *metricSystemDiskZpoolHealth) init() {
+	m.data.SetName("system.disk.zpool.health")
+	m.data.SetDescription("Health state of the ZFS pool. The data point of the current state is set to 1.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSystemDiskOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+func (m *metricSystemDiskZpoolHealth) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, stateAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
 	dp.Attributes().PutStr("device", deviceAttributeValue)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
+	dp.Attributes().PutStr("state", stateAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSystemDiskOperations) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricSystemDiskZpoolHealth) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSystemDiskOperations) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricSystemDiskZpoolHealth) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricSystemDiskOperations(cfg MetricConfig) metricSystemDiskOperations {
-	m := metricSystemDiskOperations{config: cfg}
+func newMetricSystemDiskZpoolHealth(cfg MetricConfig) metricSystemDiskZpoolHealth {
+	m := metricSystemDiskZpoolHealth{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -307,24 +1149,24 @@ func newMetricSystemDiskOperations(cfg MetricConfig) metricSystemDiskOperations
 	return m
 }
 
-type metricSystemDiskPendingOperations struct {
+type metricSystemDiskZpoolIo struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills system.disk.pending_operations metric with initial data.
-func (m *metricSystemDiskPendingOperations) init() {
-	m.data.SetName("system.disk.pending_operations")
-	m.data.SetDescription("The queue size of pending I/O operations.")
-	m.data.SetUnit("{operations}")
+// init fills system.disk.zpool.io metric with initial data.
+func (m *metricSystemDiskZpoolIo) init() {
+	m.data.SetName("system.disk.zpool.io")
+	m.data.SetDescription("Bytes transferred by the datasets of the ZFS pool.")
+	m.data.SetUnit("By")
 	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSystemDiskPendingOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+func (m *metricSystemDiskZpoolIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -333,17 +1175,18 @@ func (m *metricSystemDiskPendingOperations) recordDataPoint(start pcommon.Timest
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
 	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSystemDiskPendingOperations) updateCapacity() {
+func (m *metricSystemDiskZpoolIo) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSystemDiskPendingOperations) emit(metrics pmetric.MetricSlice) {
+func (m *metricSystemDiskZpoolIo) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -351,8 +1194,8 @@ func (m *metricSystemDiskPendingOperations) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSystemDiskPendingOperations(cfg MetricConfig) metricSystemDiskPendingOperations {
-	m := metricSystemDiskPendingOperations{config: cfg}
+func newMetricSystemDiskZpoolIo(cfg MetricConfig) metricSystemDiskZpoolIo {
+	m := metricSystemDiskZpoolIo{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -360,43 +1203,44 @@ func newMetricSystemDiskPendingOperations(cfg MetricConfig) metricSystemDiskPend
 	return m
 }
 
-type metricSystemDiskWeightedIoTime struct {
+type metricSystemDiskZpoolOperations struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills system.disk.weighted_io_time metric with initial data.
-func (m *metricSystemDiskWeightedIoTime) init() {
-	m.data.SetName("system.disk.weighted_io_time")
-	m.data.SetDescription("Time disk spent activated multiplied by the queue length.")
-	m.data.SetUnit("s")
+// init fills system.disk.zpool.operations metric with initial data.
+func (m *metricSystemDiskZpoolOperations) init() {
+	m.data.SetName("system.disk.zpool.operations")
+	m.data.SetDescription("Operations performed by the datasets of the ZFS pool.")
+	m.data.SetUnit("{operations}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSystemDiskWeightedIoTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+func (m *metricSystemDiskZpoolOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
+	dp.SetIntValue(val)
 	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSystemDiskWeightedIoTime) updateCapacity() {
+func (m *metricSystemDiskZpoolOperations) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSystemDiskWeightedIoTime) emit(metrics pmetric.MetricSlice) {
+func (m *metricSystemDiskZpoolOperations) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -404,8 +1248,8 @@ func (m *metricSystemDiskWeightedIoTime) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSystemDiskWeightedIoTime(cfg MetricConfig) metricSystemDiskWeightedIoTime {
-	m := metricSystemDiskWeightedIoTime{config: cfg}
+func newMetricSystemDiskZpoolOperations(cfg MetricConfig) metricSystemDiskZpoolOperations {
+	m := metricSystemDiskZpoolOperations{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -416,18 +1260,36 @@ func newMetricSystemDiskWeightedIoTime(cfg MetricConfig) metricSystemDiskWeighte
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                            MetricsBuilderConfig // config of the metrics builder.
-	startTime                         pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                   int                  // maximum observed number of metrics per resource.
-	metricsBuffer                     pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                         component.BuildInfo  // contains version information.
-	metricSystemDiskIo                metricSystemDiskIo
-	metricSystemDiskIoTime            metricSystemDiskIoTime
-	metricSystemDiskMerged            metricSystemDiskMerged
-	metricSystemDiskOperationTime     metricSystemDiskOperationTime
-	metricSystemDiskOperations        metricSystemDiskOperations
-	metricSystemDiskPendingOperations metricSystemDiskPendingOperations
-	metricSystemDiskWeightedIoTime    metricSystemDiskWeightedIoTime
+	config                               MetricsBuilderConfig // config of the metrics builder.
+	startTime                            pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                      int                  // maximum observed number of metrics per resource.
+	metricsBuffer                        pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                            component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter       map[string]filter.Filter
+	resourceAttributeExcludeFilter       map[string]filter.Filter
+	metricSystemDiskDiscardIo            metricSystemDiskDiscardIo
+	metricSystemDiskDiscardOperations    metricSystemDiskDiscardOperations
+	metricSystemDiskFlushOperations      metricSystemDiskFlushOperations
+	metricSystemDiskFlushTime            metricSystemDiskFlushTime
+	metricSystemDiskInflight             metricSystemDiskInflight
+	metricSystemDiskIo                   metricSystemDiskIo
+	metricSystemDiskIoTime               metricSystemDiskIoTime
+	metricSystemDiskMerged               metricSystemDiskMerged
+	metricSystemDiskOperationLatency     metricSystemDiskOperationLatency
+	metricSystemDiskOperationTime        metricSystemDiskOperationTime
+	metricSystemDiskOperations           metricSystemDiskOperations
+	metricSystemDiskPendingOperations    metricSystemDiskPendingOperations
+	metricSystemDiskProcessIo            metricSystemDiskProcessIo
+	metricSystemDiskSmartMediaErrors     metricSystemDiskSmartMediaErrors
+	metricSystemDiskSmartPercentageUsed  metricSystemDiskSmartPercentageUsed
+	metricSystemDiskSmartTemperature     metricSystemDiskSmartTemperature
+	metricSystemDiskSmartUnsafeShutdowns metricSystemDiskSmartUnsafeShutdowns
+	metricSystemDiskSplitOperations      metricSystemDiskSplitOperations
+	metricSystemDiskUtilization          metricSystemDiskUtilization
+	metricSystemDiskWeightedIoTime       metricSystemDiskWeightedIoTime
+	metricSystemDiskZpoolHealth          metricSystemDiskZpoolHealth
+	metricSystemDiskZpoolIo              metricSystemDiskZpoolIo
+	metricSystemDiskZpoolOperations      metricSystemDiskZpoolOperations
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -442,17 +1304,83 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                            mbc,
-		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                     pmetric.NewMetrics(),
-		buildInfo:                         settings.BuildInfo,
-		metricSystemDiskIo:                newMetricSystemDiskIo(mbc.Metrics.SystemDiskIo),
-		metricSystemDiskIoTime:            newMetricSystemDiskIoTime(mbc.Metrics.SystemDiskIoTime),
-		metricSystemDiskMerged:            newMetricSystemDiskMerged(mbc.Metrics.SystemDiskMerged),
-		metricSystemDiskOperationTime:     newMetricSystemDiskOperationTime(mbc.Metrics.SystemDiskOperationTime),
-		metricSystemDiskOperations:        newMetricSystemDiskOperations(mbc.Metrics.SystemDiskOperations),
-		metricSystemDiskPendingOperations: newMetricSystemDiskPendingOperations(mbc.Metrics.SystemDiskPendingOperations),
-		metricSystemDiskWeightedIoTime:    newMetricSystemDiskWeightedIoTime(mbc.Metrics.SystemDiskWeightedIoTime),
+		config:                               mbc,
+		startTime:                            pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                        pmetric.NewMetrics(),
+		buildInfo:                            settings.BuildInfo,
+		metricSystemDiskDiscardIo:            newMetricSystemDiskDiscardIo(mbc.Metrics.SystemDiskDiscardIo),
+		metricSystemDiskDiscardOperations:    newMetricSystemDiskDiscardOperations(mbc.Metrics.SystemDiskDiscardOperations),
+		metricSystemDiskFlushOperations:      newMetricSystemDiskFlushOperations(mbc.Metrics.SystemDiskFlushOperations),
+		metricSystemDiskFlushTime:            newMetricSystemDiskFlushTime(mbc.Metrics.SystemDiskFlushTime),
+		metricSystemDiskInflight:             newMetricSystemDiskInflight(mbc.Metrics.SystemDiskInflight),
+		metricSystemDiskIo:                   newMetricSystemDiskIo(mbc.Metrics.SystemDiskIo),
+		metricSystemDiskIoTime:               newMetricSystemDiskIoTime(mbc.Metrics.SystemDiskIoTime),
+		metricSystemDiskMerged:               newMetricSystemDiskMerged(mbc.Metrics.SystemDiskMerged),
+		metricSystemDiskOperationLatency:     newMetricSystemDiskOperationLatency(mbc.Metrics.SystemDiskOperationLatency),
+		metricSystemDiskOperationTime:        newMetricSystemDiskOperationTime(mbc.Metrics.SystemDiskOperationTime),
+		metricSystemDiskOperations:           newMetricSystemDiskOperations(mbc.Metrics.SystemDiskOperations),
+		metricSystemDiskPendingOperations:    newMetricSystemDiskPendingOperations(mbc.Metrics.SystemDiskPendingOperations),
+		metricSystemDiskProcessIo:            newMetricSystemDiskProcessIo(mbc.Metrics.SystemDiskProcessIo),
+		metricSystemDiskSmartMediaErrors:     newMetricSystemDiskSmartMediaErrors(mbc.Metrics.SystemDiskSmartMediaErrors),
+		metricSystemDiskSmartPercentageUsed:  newMetricSystemDiskSmartPercentageUsed(mbc.Metrics.SystemDiskSmartPercentageUsed),
+		metricSystemDiskSmartTemperature:     newMetricSystemDiskSmartTemperature(mbc.Metrics.SystemDiskSmartTemperature),
+		metricSystemDiskSmartUnsafeShutdowns: newMetricSystemDiskSmartUnsafeShutdowns(mbc.Metrics.SystemDiskSmartUnsafeShutdowns),
+		metricSystemDiskSplitOperations:      newMetricSystemDiskSplitOperations(mbc.Metrics.SystemDiskSplitOperations),
+		metricSystemDiskUtilization:          newMetricSystemDiskUtilization(mbc.Metrics.SystemDiskUtilization),
+		metricSystemDiskWeightedIoTime:       newMetricSystemDiskWeightedIoTime(mbc.Metrics.SystemDiskWeightedIoTime),
+		metricSystemDiskZpoolHealth:          newMetricSystemDiskZpoolHealth(mbc.Metrics.SystemDiskZpoolHealth),
+		metricSystemDiskZpoolIo:              newMetricSystemDiskZpoolIo(mbc.Metrics.SystemDiskZpoolIo),
+		metricSystemDiskZpoolOperations:      newMetricSystemDiskZpoolOperations(mbc.Metrics.SystemDiskZpoolOperations),
+		resourceAttributeIncludeFilter:       make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:       make(map[string]filter.Filter),
+	}
+	if mbc.ResourceAttributes.DiskDmName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["disk.dm_name"] = filter.CreateFilter(mbc.ResourceAttributes.DiskDmName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.DiskDmName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["disk.dm_name"] = filter.CreateFilter(mbc.ResourceAttributes.DiskDmName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.DiskModel.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["disk.model"] = filter.CreateFilter(mbc.ResourceAttributes.DiskModel.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.DiskModel.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["disk.model"] = filter.CreateFilter(mbc.ResourceAttributes.DiskModel.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.DiskRotational.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["disk.rotational"] = filter.CreateFilter(mbc.ResourceAttributes.DiskRotational.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.DiskRotational.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["disk.rotational"] = filter.CreateFilter(mbc.ResourceAttributes.DiskRotational.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.DiskScheduler.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["disk.scheduler"] = filter.CreateFilter(mbc.ResourceAttributes.DiskScheduler.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.DiskScheduler.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["disk.scheduler"] = filter.CreateFilter(mbc.ResourceAttributes.DiskScheduler.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.DiskSerial.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["disk.serial"] = filter.CreateFilter(mbc.ResourceAttributes.DiskSerial.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.DiskSerial.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["disk.serial"] = filter.CreateFilter(mbc.ResourceAttributes.DiskSerial.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.DiskTransport.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["disk.transport"] = filter.CreateFilter(mbc.ResourceAttributes.DiskTransport.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.DiskTransport.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["disk.transport"] = filter.CreateFilter(mbc.ResourceAttributes.DiskTransport.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.Mountpoint.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["mountpoint"] = filter.CreateFilter(mbc.ResourceAttributes.Mountpoint.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.Mountpoint.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["mountpoint"] = filter.CreateFilter(mbc.ResourceAttributes.Mountpoint.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.RaidArray.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["raid.array"] = filter.CreateFilter(mbc.ResourceAttributes.RaidArray.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.RaidArray.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["raid.array"] = filter.CreateFilter(mbc.ResourceAttributes.RaidArray.MetricsExclude)
 	}
 
 	for _, op := range options {
@@ -461,6 +1389,11 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 	return mb
 }
 
+// NewResourceBuilder returns a new resource builder that should be used to build a resource associated with for the emitted metrics.
+func (mb *MetricsBuilder) NewResourceBuilder() *ResourceBuilder {
+	return NewResourceBuilder(mb.config.ResourceAttributes)
+}
+
 // updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
 func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
 	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
@@ -511,17 +1444,43 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/hostmetricsreceiver/disk")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSystemDiskDiscardIo.emit(ils.Metrics())
+	mb.metricSystemDiskDiscardOperations.emit(ils.Metrics())
+	mb.metricSystemDiskFlushOperations.emit(ils.Metrics())
+	mb.metricSystemDiskFlushTime.emit(ils.Metrics())
+	mb.metricSystemDiskInflight.emit(ils.Metrics())
 	mb.metricSystemDiskIo.emit(ils.Metrics())
 	mb.metricSystemDiskIoTime.emit(ils.Metrics())
 	mb.metricSystemDiskMerged.emit(ils.Metrics())
+	mb.metricSystemDiskOperationLatency.emit(ils.Metrics())
 	mb.metricSystemDiskOperationTime.emit(ils.Metrics())
 	mb.metricSystemDiskOperations.emit(ils.Metrics())
 	mb.metricSystemDiskPendingOperations.emit(ils.Metrics())
+	mb.metricSystemDiskProcessIo.emit(ils.Metrics())
+	mb.metricSystemDiskSmartMediaErrors.emit(ils.Metrics())
+	mb.metricSystemDiskSmartPercentageUsed.emit(ils.Metrics())
+	mb.metricSystemDiskSmartTemperature.emit(ils.Metrics())
+	mb.metricSystemDiskSmartUnsafeShutdowns.emit(ils.Metrics())
+	mb.metricSystemDiskSplitOperations.emit(ils.Metrics())
+	mb.metricSystemDiskUtilization.emit(ils.Metrics())
 	mb.metricSystemDiskWeightedIoTime.emit(ils.Metrics())
+	mb.metricSystemDiskZpoolHealth.emit(ils.Metrics())
+	mb.metricSystemDiskZpoolIo.emit(ils.Metrics())
+	mb.metricSystemDiskZpoolOperations.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
 	}
+	for attr, filter := range mb.resourceAttributeIncludeFilter {
+		if val, ok := rm.Resource().Attributes().Get(attr); ok && !filter.Matches(val.AsString()) {
+			return
+		}
+	}
+	for attr, filter := range mb.resourceAttributeExcludeFilter {
+		if val, ok := rm.Resource().Attributes().Get(attr); ok && filter.Matches(val.AsString()) {
+			return
+		}
+	}
 
 	if ils.Metrics().Len() > 0 {
 		mb.updateCapacity(rm)
@@ -539,6 +1498,31 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordSystemDiskDiscardIoDataPoint adds a data point to system.disk.discard.io metric.
+func (mb *MetricsBuilder) RecordSystemDiskDiscardIoDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemDiskDiscardIo.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskDiscardOperationsDataPoint adds a data point to system.disk.discard.operations metric.
+func (mb *MetricsBuilder) RecordSystemDiskDiscardOperationsDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemDiskDiscardOperations.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskFlushOperationsDataPoint adds a data point to system.disk.flush.operations metric.
+func (mb *MetricsBuilder) RecordSystemDiskFlushOperationsDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemDiskFlushOperations.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskFlushTimeDataPoint adds a data point to system.disk.flush.time metric.
+func (mb *MetricsBuilder) RecordSystemDiskFlushTimeDataPoint(ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+	mb.metricSystemDiskFlushTime.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskInflightDataPoint adds a data point to system.disk.inflight metric.
+func (mb *MetricsBuilder) RecordSystemDiskInflightDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricSystemDiskInflight.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
+}
+
 // RecordSystemDiskIoDataPoint adds a data point to system.disk.io metric.
 func (mb *MetricsBuilder) RecordSystemDiskIoDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
 	mb.metricSystemDiskIo.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
@@ -554,6 +1538,11 @@ func (mb *MetricsBuilder) RecordSystemDiskMergedDataPoint(ts pcommon.Timestamp,
 	mb.metricSystemDiskMerged.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordSystemDiskOperationLatencyDataPoint adds a data point to system.disk.operation.latency metric.
+func (mb *MetricsBuilder) RecordSystemDiskOperationLatencyDataPoint(ts pcommon.Timestamp, val float64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricSystemDiskOperationLatency.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
+}
+
 // RecordSystemDiskOperationTimeDataPoint adds a data point to system.disk.operation_time metric.
 func (mb *MetricsBuilder) RecordSystemDiskOperationTimeDataPoint(ts pcommon.Timestamp, val float64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
 	mb.metricSystemDiskOperationTime.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
@@ -569,11 +1558,61 @@ func (mb *MetricsBuilder) RecordSystemDiskPendingOperationsDataPoint(ts pcommon.
 	mb.metricSystemDiskPendingOperations.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
 }
 
+// RecordSystemDiskProcessIoDataPoint adds a data point to system.disk.process.io metric.
+func (mb *MetricsBuilder) RecordSystemDiskProcessIoDataPoint(ts pcommon.Timestamp, val int64, processPidAttributeValue int64, processExecutableNameAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricSystemDiskProcessIo.recordDataPoint(mb.startTime, ts, val, processPidAttributeValue, processExecutableNameAttributeValue, directionAttributeValue.String())
+}
+
+// RecordSystemDiskSmartMediaErrorsDataPoint adds a data point to system.disk.smart.media_errors metric.
+func (mb *MetricsBuilder) RecordSystemDiskSmartMediaErrorsDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemDiskSmartMediaErrors.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskSmartPercentageUsedDataPoint adds a data point to system.disk.smart.percentage_used metric.
+func (mb *MetricsBuilder) RecordSystemDiskSmartPercentageUsedDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemDiskSmartPercentageUsed.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskSmartTemperatureDataPoint adds a data point to system.disk.smart.temperature metric.
+func (mb *MetricsBuilder) RecordSystemDiskSmartTemperatureDataPoint(ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+	mb.metricSystemDiskSmartTemperature.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskSmartUnsafeShutdownsDataPoint adds a data point to system.disk.smart.unsafe_shutdowns metric.
+func (mb *MetricsBuilder) RecordSystemDiskSmartUnsafeShutdownsDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemDiskSmartUnsafeShutdowns.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskSplitOperationsDataPoint adds a data point to system.disk.split_operations metric.
+func (mb *MetricsBuilder) RecordSystemDiskSplitOperationsDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemDiskSplitOperations.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemDiskUtilizationDataPoint adds a data point to system.disk.utilization metric.
+func (mb *MetricsBuilder) RecordSystemDiskUtilizationDataPoint(ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
+	mb.metricSystemDiskUtilization.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
 // RecordSystemDiskWeightedIoTimeDataPoint adds a data point to system.disk.weighted_io_time metric.
 func (mb *MetricsBuilder) RecordSystemDiskWeightedIoTimeDataPoint(ts pcommon.Timestamp, val float64, deviceAttributeValue string) {
 	mb.metricSystemDiskWeightedIoTime.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
 }
 
+// RecordSystemDiskZpoolHealthDataPoint adds a data point to system.disk.zpool.health metric.
+func (mb *MetricsBuilder) RecordSystemDiskZpoolHealthDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, stateAttributeValue string) {
+	mb.metricSystemDiskZpoolHealth.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, stateAttributeValue)
+}
+
+// RecordSystemDiskZpoolIoDataPoint adds a data point to system.disk.zpool.io metric.
+func (mb *MetricsBuilder) RecordSystemDiskZpoolIoDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricSystemDiskZpoolIo.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
+}
+
+// RecordSystemDiskZpoolOperationsDataPoint adds a data point to system.disk.zpool.operations metric.
+func (mb *MetricsBuilder) RecordSystemDiskZpoolOperationsDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricSystemDiskZpoolOperations.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
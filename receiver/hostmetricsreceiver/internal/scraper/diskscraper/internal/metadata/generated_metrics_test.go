@@ -42,6 +42,15 @@ func TestMetricsBuilder(t *testing.T) {
 			resAttrsSet: testDataSetNone,
 			expectEmpty: true,
 		},
+		{
+			name:        "filter_set_include",
+			resAttrsSet: testDataSetAll,
+		},
+		{
+			name:        "filter_set_exclude",
+			resAttrsSet: testDataSetAll,
+			expectEmpty: true,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -59,6 +68,21 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordSystemDiskDiscardIoDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskDiscardOperationsDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskFlushOperationsDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskFlushTimeDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskInflightDataPoint(ts, 1, "device-val", AttributeDirectionRead)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSystemDiskIoDataPoint(ts, 1, "device-val", AttributeDirectionRead)
@@ -71,6 +95,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSystemDiskMergedDataPoint(ts, 1, "device-val", AttributeDirectionRead)
 
+			allMetricsCount++
+			mb.RecordSystemDiskOperationLatencyDataPoint(ts, 1, "device-val", AttributeDirectionRead)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSystemDiskOperationTimeDataPoint(ts, 1, "device-val", AttributeDirectionRead)
@@ -83,11 +110,50 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSystemDiskPendingOperationsDataPoint(ts, 1, "device-val")
 
+			allMetricsCount++
+			mb.RecordSystemDiskProcessIoDataPoint(ts, 1, 11, "process.executable.name-val", AttributeDirectionRead)
+
+			allMetricsCount++
+			mb.RecordSystemDiskSmartMediaErrorsDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskSmartPercentageUsedDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskSmartTemperatureDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskSmartUnsafeShutdownsDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskSplitOperationsDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskUtilizationDataPoint(ts, 1, "device-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSystemDiskWeightedIoTimeDataPoint(ts, 1, "device-val")
 
-			res := pcommon.NewResource()
+			allMetricsCount++
+			mb.RecordSystemDiskZpoolHealthDataPoint(ts, 1, "device-val", "state-val")
+
+			allMetricsCount++
+			mb.RecordSystemDiskZpoolIoDataPoint(ts, 1, "device-val", AttributeDirectionRead)
+
+			allMetricsCount++
+			mb.RecordSystemDiskZpoolOperationsDataPoint(ts, 1, "device-val", AttributeDirectionRead)
+
+			rb := mb.NewResourceBuilder()
+			rb.SetDiskDmName("disk.dm_name-val")
+			rb.SetDiskModel("disk.model-val")
+			rb.SetDiskRotational(false)
+			rb.SetDiskScheduler("disk.scheduler-val")
+			rb.SetDiskSerial("disk.serial-val")
+			rb.SetDiskTransport("disk.transport-val")
+			rb.SetMountpoint("mountpoint-val")
+			rb.SetRaidArray("raid.array-val")
+			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
 
 			if test.expectEmpty {
@@ -109,6 +175,92 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "system.disk.discard.io":
+					assert.False(t, validatedMetrics["system.disk.discard.io"], "Found a duplicate in the metrics slice: system.disk.discard.io")
+					validatedMetrics["system.disk.discard.io"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes discarded, for example by TRIM commands issued to SSDs.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.discard.operations":
+					assert.False(t, validatedMetrics["system.disk.discard.operations"], "Found a duplicate in the metrics slice: system.disk.discard.operations")
+					validatedMetrics["system.disk.discard.operations"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Discard operations count.", ms.At(i).Description())
+					assert.Equal(t, "{operations}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.flush.operations":
+					assert.False(t, validatedMetrics["system.disk.flush.operations"], "Found a duplicate in the metrics slice: system.disk.flush.operations")
+					validatedMetrics["system.disk.flush.operations"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Cache flush operations count.", ms.At(i).Description())
+					assert.Equal(t, "{operations}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.flush.time":
+					assert.False(t, validatedMetrics["system.disk.flush.time"], "Found a duplicate in the metrics slice: system.disk.flush.time")
+					validatedMetrics["system.disk.flush.time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Time spent in cache flush operations.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.inflight":
+					assert.False(t, validatedMetrics["system.disk.inflight"], "Found a duplicate in the metrics slice: system.disk.inflight")
+					validatedMetrics["system.disk.inflight"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of I/O requests issued to the device driver that have not completed yet.", ms.At(i).Description())
+					assert.Equal(t, "{operations}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "read", attrVal.Str())
 				case "system.disk.io":
 					assert.False(t, validatedMetrics["system.disk.io"], "Found a duplicate in the metrics slice: system.disk.io")
 					validatedMetrics["system.disk.io"] = true
@@ -166,6 +318,24 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("direction")
 					assert.True(t, ok)
 					assert.EqualValues(t, "read", attrVal.Str())
+				case "system.disk.operation.latency":
+					assert.False(t, validatedMetrics["system.disk.operation.latency"], "Found a duplicate in the metrics slice: system.disk.operation.latency")
+					validatedMetrics["system.disk.operation.latency"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Average latency of the disk operations completed since the previous scrape.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "read", attrVal.Str())
 				case "system.disk.operation_time":
 					assert.False(t, validatedMetrics["system.disk.operation_time"], "Found a duplicate in the metrics slice: system.disk.operation_time")
 					validatedMetrics["system.disk.operation_time"] = true
@@ -223,6 +393,125 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("device")
 					assert.True(t, ok)
 					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.process.io":
+					assert.False(t, validatedMetrics["system.disk.process.io"], "Found a duplicate in the metrics slice: system.disk.process.io")
+					validatedMetrics["system.disk.process.io"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Disk bytes transferred by the processes that transferred the most bytes since the previous scrape.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("process.pid")
+					assert.True(t, ok)
+					assert.EqualValues(t, 11, attrVal.Int())
+					attrVal, ok = dp.Attributes().Get("process.executable.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "process.executable.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "read", attrVal.Str())
+				case "system.disk.smart.media_errors":
+					assert.False(t, validatedMetrics["system.disk.smart.media_errors"], "Found a duplicate in the metrics slice: system.disk.smart.media_errors")
+					validatedMetrics["system.disk.smart.media_errors"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of unrecovered data integrity errors reported by the NVMe controller.", ms.At(i).Description())
+					assert.Equal(t, "{errors}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.smart.percentage_used":
+					assert.False(t, validatedMetrics["system.disk.smart.percentage_used"], "Found a duplicate in the metrics slice: system.disk.smart.percentage_used")
+					validatedMetrics["system.disk.smart.percentage_used"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Vendor estimate of the percentage of the NVMe device life used, which may exceed 100.", ms.At(i).Description())
+					assert.Equal(t, "%", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.smart.temperature":
+					assert.False(t, validatedMetrics["system.disk.smart.temperature"], "Found a duplicate in the metrics slice: system.disk.smart.temperature")
+					validatedMetrics["system.disk.smart.temperature"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Composite temperature of the NVMe controller.", ms.At(i).Description())
+					assert.Equal(t, "Cel", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.smart.unsafe_shutdowns":
+					assert.False(t, validatedMetrics["system.disk.smart.unsafe_shutdowns"], "Found a duplicate in the metrics slice: system.disk.smart.unsafe_shutdowns")
+					validatedMetrics["system.disk.smart.unsafe_shutdowns"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of unsafe shutdowns of the NVMe controller.", ms.At(i).Description())
+					assert.Equal(t, "{shutdowns}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.split_operations":
+					assert.False(t, validatedMetrics["system.disk.split_operations"], "Found a duplicate in the metrics slice: system.disk.split_operations")
+					validatedMetrics["system.disk.split_operations"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of I/O requests split into multiple requests because of fragmentation or their size.", ms.At(i).Description())
+					assert.Equal(t, "{operations}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.utilization":
+					assert.False(t, validatedMetrics["system.disk.utilization"], "Found a duplicate in the metrics slice: system.disk.utilization")
+					validatedMetrics["system.disk.utilization"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Fraction of time the disk was busy serving I/O since the previous scrape.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
 				case "system.disk.weighted_io_time":
 					assert.False(t, validatedMetrics["system.disk.weighted_io_time"], "Found a duplicate in the metrics slice: system.disk.weighted_io_time")
 					validatedMetrics["system.disk.weighted_io_time"] = true
@@ -240,6 +529,64 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("device")
 					assert.True(t, ok)
 					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.disk.zpool.health":
+					assert.False(t, validatedMetrics["system.disk.zpool.health"], "Found a duplicate in the metrics slice: system.disk.zpool.health")
+					validatedMetrics["system.disk.zpool.health"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Health state of the ZFS pool. The data point of the current state is set to 1.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "state-val", attrVal.Str())
+				case "system.disk.zpool.io":
+					assert.False(t, validatedMetrics["system.disk.zpool.io"], "Found a duplicate in the metrics slice: system.disk.zpool.io")
+					validatedMetrics["system.disk.zpool.io"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes transferred by the datasets of the ZFS pool.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "read", attrVal.Str())
+				case "system.disk.zpool.operations":
+					assert.False(t, validatedMetrics["system.disk.zpool.operations"], "Found a duplicate in the metrics slice: system.disk.zpool.operations")
+					validatedMetrics["system.disk.zpool.operations"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Operations performed by the datasets of the ZFS pool.", ms.At(i).Description())
+					assert.Equal(t, "{operations}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "read", attrVal.Str())
 				}
 			}
 		})
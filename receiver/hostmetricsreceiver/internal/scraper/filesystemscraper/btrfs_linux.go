@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package filesystemscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/disk"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+)
+
+// btrfsAllocation holds the space allocated to a type of block group (data, metadata or system).
+type btrfsAllocation struct {
+	totalBytes uint64 // logical size of the allocated chunks
+	bytesUsed  uint64 // logical size of the used space in the allocated chunks
+	diskTotal  uint64 // raw size of the allocated chunks, including the copies of the RAID profile
+}
+
+// readBtrfsUsage estimates the usage of the btrfs filesystem holding device from its sysfs entries, as
+// `btrfs filesystem usage` does. The space that is not allocated to chunks yet is counted as free once
+// divided by the ratio of the data profile, e.g. halved for RAID1, and the free space of the metadata
+// chunks is not counted, as it cannot hold data.
+func readBtrfsUsage(ctx context.Context, device string) (*disk.UsageStat, error) {
+	if resolved, err := filepath.EvalSymlinks(device); err == nil {
+		device = resolved
+	}
+	fsPaths, err := filepath.Glob(filepath.Join(internal.SysPath(ctx), "fs", "btrfs", "*", "devices", filepath.Base(device)))
+	if err != nil {
+		return nil, err
+	}
+	if len(fsPaths) == 0 {
+		return nil, fmt.Errorf("no btrfs filesystem found for %s", device)
+	}
+	fsPath := filepath.Dir(filepath.Dir(fsPaths[0]))
+
+	devicePaths, err := filepath.Glob(filepath.Join(fsPath, "devices", "*"))
+	if err != nil {
+		return nil, err
+	}
+	var rawSize uint64
+	for _, devicePath := range devicePaths {
+		sectors, err := readUint(filepath.Join(devicePath, "size"))
+		if err != nil {
+			return nil, err
+		}
+		rawSize += sectors * 512
+	}
+
+	var used, rawAllocated uint64
+	var data btrfsAllocation
+	for _, group := range []string{"data", "metadata", "system"} {
+		allocation, err := readBtrfsAllocation(filepath.Join(fsPath, "allocation", group))
+		if err != nil {
+			return nil, err
+		}
+		used += allocation.bytesUsed
+		rawAllocated += allocation.diskTotal
+		if group == "data" {
+			data = allocation
+		}
+	}
+
+	free := data.totalBytes - data.bytesUsed
+	if rawSize > rawAllocated {
+		dataRatio := 1.0
+		if data.totalBytes > 0 {
+			dataRatio = float64(data.diskTotal) / float64(data.totalBytes)
+		}
+		free += uint64(float64(rawSize-rawAllocated) / dataRatio)
+	}
+	return &disk.UsageStat{Path: device, Fstype: "btrfs", Total: used + free, Used: used, Free: free}, nil
+}
+
+func readBtrfsAllocation(path string) (btrfsAllocation, error) {
+	var allocation btrfsAllocation
+	var err error
+	if allocation.totalBytes, err = readUint(filepath.Join(path, "total_bytes")); err != nil {
+		return allocation, err
+	}
+	if allocation.bytesUsed, err = readUint(filepath.Join(path, "bytes_used")); err != nil {
+		return allocation, err
+	}
+	allocation.diskTotal, err = readUint(filepath.Join(path, "disk_total"))
+	return allocation, err
+}
+
+func readUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
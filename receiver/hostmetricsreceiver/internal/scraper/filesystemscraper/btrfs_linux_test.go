@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package filesystemscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBtrfsUsage(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+	fsPath := filepath.Join(sysPath, "fs", "btrfs", "0f3c1a9e-6a5e-4f0b-9d43-5e2b0d1c7a11")
+	writeFile := func(path, value string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(value+"\n"), 0o600))
+	}
+
+	_, err := readBtrfsUsage(ctx, "/dev/sdb")
+	assert.EqualError(t, err, "no btrfs filesystem found for /dev/sdb")
+
+	// RAID1 data and metadata over two 10 GiB devices
+	writeFile(filepath.Join(fsPath, "devices", "sdb", "size"), "20971520")
+	writeFile(filepath.Join(fsPath, "devices", "sdc", "size"), "20971520")
+	writeFile(filepath.Join(fsPath, "allocation", "data", "total_bytes"), "4294967296")
+	writeFile(filepath.Join(fsPath, "allocation", "data", "bytes_used"), "3221225472")
+	writeFile(filepath.Join(fsPath, "allocation", "data", "disk_total"), "8589934592")
+	writeFile(filepath.Join(fsPath, "allocation", "metadata", "total_bytes"), "1073741824")
+	writeFile(filepath.Join(fsPath, "allocation", "metadata", "bytes_used"), "268435456")
+	writeFile(filepath.Join(fsPath, "allocation", "metadata", "disk_total"), "2147483648")
+	writeFile(filepath.Join(fsPath, "allocation", "system", "total_bytes"), "8388608")
+	writeFile(filepath.Join(fsPath, "allocation", "system", "bytes_used"), "16384")
+	writeFile(filepath.Join(fsPath, "allocation", "system", "disk_total"), "16777216")
+
+	usage, err := readBtrfsUsage(ctx, "/dev/sdc")
+	require.NoError(t, err)
+	// 1 GiB free in the data chunks and half of the 9.98 GiB not allocated yet
+	free := uint64(1073741824 + (21474836480-8589934592-2147483648-16777216)/2)
+	used := uint64(3221225472 + 268435456 + 16384)
+	assert.Equal(t, &disk.UsageStat{Path: "/dev/sdc", Fstype: "btrfs", Total: used + free, Used: used, Free: free}, usage)
+
+	writeFile(filepath.Join(fsPath, "allocation", "system", "disk_total"), "garbage")
+	_, err = readBtrfsUsage(ctx, "/dev/sdc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package filesystemscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shirou/gopsutil/v3/disk"
+)
+
+func readBtrfsUsage(context.Context, string) (*disk.UsageStat, error) {
+	return nil, errors.New("btrfs usage accounting is only supported on Linux")
+}
@@ -5,6 +5,7 @@ package filesystemscraper // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
@@ -37,6 +38,20 @@ type Config struct {
 	// ExcludeMountPoints specifies a filter on the mount points that should be excluded from the generated metrics.
 	// When `root_path` is set, the mount points must be from the host's perspective.
 	ExcludeMountPoints MountPointMatchConfig `mapstructure:"exclude_mount_points"`
+
+	// StatTimeout bounds the time spent reading the usage of each filesystem, so that a hung network
+	// filesystem, such as an unresponsive NFS or CIFS mount, does not block the whole scrape. A filesystem
+	// whose usage cannot be read in time is skipped and reported as a partial scrape error. The blocked call
+	// cannot be interrupted: it is left running, and no new call is made for the mount point until it returns.
+	// Zero, the default, waits for every call to return.
+	StatTimeout time.Duration `mapstructure:"stat_timeout"`
+
+	// AccurateUsage replaces the usage reported by statfs with filesystem-specific accounting for the filesystems
+	// where statfs is misleading. For btrfs, the free space is estimated from the allocation profile of the data,
+	// as `btrfs filesystem usage` does, so that RAID profiles are taken into account. This is only supported on Linux.
+	// For ZFS, the capacity of the pool is reported for each of its datasets, as read with `zpool list`, which must
+	// be in the PATH of the collector.
+	AccurateUsage bool `mapstructure:"accurate_usage"`
 }
 
 type DeviceMatchConfig struct {
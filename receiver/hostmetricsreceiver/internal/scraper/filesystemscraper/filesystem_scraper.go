@@ -6,7 +6,9 @@ package filesystemscraper // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"context"
 	"fmt"
+	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper/internal/metadata"
 )
 
@@ -35,10 +38,32 @@ type scraper struct {
 	mb       *metadata.MetricsBuilder
 	fsFilter fsFilter
 
+	filterTelemetry *internal.FilterTelemetry
+
 	// for mocking gopsutil disk.Partitions & disk.Usage
 	bootTime   func(context.Context) (uint64, error)
 	partitions func(context.Context, bool) ([]disk.PartitionStat, error)
 	usage      func(context.Context, string) (*disk.UsageStat, error)
+	btrfsUsage func(ctx context.Context, device string) (*disk.UsageStat, error)
+	zpoolUsage func(context.Context) (map[string]*disk.UsageStat, error)
+
+	// pendingUsages holds the calls that timed out and are still running, by mount point
+	pendingUsages map[string]chan usageResult
+	// statErrors holds the number of failed calls, by filesystem
+	statErrors map[filesystemKey]int64
+}
+
+// filesystemKey holds the attributes of the data points of a filesystem.
+type filesystemKey struct {
+	device     string
+	mode       string
+	mountpoint string
+	fsType     string
+}
+
+type usageResult struct {
+	usage *disk.UsageStat
+	err   error
 }
 
 type deviceUsage struct {
@@ -48,12 +73,19 @@ type deviceUsage struct {
 
 // newFileSystemScraper creates a FileSystem Scraper
 func newFileSystemScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) (*scraper, error) {
+	if cfg.StatTimeout < 0 {
+		return nil, fmt.Errorf("invalid stat_timeout %s, must not be negative", cfg.StatTimeout)
+	}
 	fsFilter, err := cfg.createFilter()
 	if err != nil {
 		return nil, err
 	}
 
-	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, partitions: disk.PartitionsWithContext, usage: disk.UsageWithContext, fsFilter: *fsFilter}
+	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, partitions: disk.PartitionsWithContext, usage: disk.UsageWithContext, btrfsUsage: readBtrfsUsage, zpoolUsage: readZpoolUsage, fsFilter: *fsFilter,
+		pendingUsages: make(map[string]chan usageResult), statErrors: make(map[filesystemKey]int64)}
+	if scraper.filterTelemetry, err = internal.NewFilterTelemetry(settings.TelemetrySettings, TypeStr); err != nil {
+		return nil, err
+	}
 	return scraper, nil
 }
 
@@ -90,25 +122,57 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	}
 
 	usages := make([]*deviceUsage, 0, len(partitions))
+	var zpoolUsages map[string]*disk.UsageStat
+	filtered := 0
 	for _, partition := range partitions {
 		if !s.fsFilter.includePartition(partition) {
+			filtered++
 			continue
 		}
 		translatedMountpoint := translateMountpoint(s.config.RootPath, partition.Mountpoint)
-		usage, usageErr := s.usage(ctx, translatedMountpoint)
+		usage, usageErr := s.usageWithTimeout(ctx, translatedMountpoint)
 		if usageErr != nil {
 			errors.AddPartial(0, fmt.Errorf("failed to read usage at %s: %w", translatedMountpoint, usageErr))
+			s.statErrors[filesystemKey{partition.Device, getMountMode(partition.Opts), partition.Mountpoint, partition.Fstype}]++
 			continue
 		}
 
+		if s.config.AccurateUsage {
+			switch partition.Fstype {
+			case "btrfs":
+				btrfsUsage, btrfsErr := s.btrfsUsage(ctx, translateMountpoint(s.config.RootPath, partition.Device))
+				if btrfsErr != nil {
+					errors.AddPartial(0, fmt.Errorf("failed to read btrfs usage of %s: %w", partition.Device, btrfsErr))
+					break
+				}
+				setUsage(usage, btrfsUsage)
+			case "zfs":
+				if zpoolUsages == nil {
+					if zpoolUsages, err = s.zpoolUsage(ctx); err != nil {
+						errors.AddPartial(0, fmt.Errorf("failed to read zpool usage: %w", err))
+						zpoolUsages = map[string]*disk.UsageStat{}
+					}
+				}
+				pool, _, _ := strings.Cut(partition.Device, "/")
+				if poolUsage, ok := zpoolUsages[pool]; ok {
+					setUsage(usage, poolUsage)
+				}
+			}
+		}
+
 		usages = append(usages, &deviceUsage{partition, usage})
 	}
+	s.filterTelemetry.RecordFiltered(ctx, filtered)
 
 	if len(usages) > 0 {
 		s.recordFileSystemUsageMetric(now, usages)
 		s.recordSystemSpecificMetrics(now, usages)
 	}
 
+	for fs, count := range s.statErrors {
+		s.mb.RecordSystemFilesystemStatErrorsDataPoint(now, count, fs.device, fs.mode, fs.mountpoint, fs.fsType)
+	}
+
 	err = errors.Combine()
 	if err != nil && len(usages) == 0 {
 		err = scrapererror.NewPartialScrapeError(err, metricsLen)
@@ -117,6 +181,79 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	return s.mb.Emit(), err
 }
 
+// usageWithTimeout reads the usage of the filesystem mounted at mountpoint, giving up after the configured
+// stat timeout. A call that timed out is left running, as calls blocked on a hung network filesystem
+// cannot be interrupted, and later scrapes wait for it instead of making a new call.
+func (s *scraper) usageWithTimeout(ctx context.Context, mountpoint string) (*disk.UsageStat, error) {
+	if s.config.StatTimeout == 0 {
+		return s.usage(ctx, mountpoint)
+	}
+
+	result, ok := s.pendingUsages[mountpoint]
+	if !ok {
+		result = make(chan usageResult, 1)
+		go func() {
+			usage, err := s.usage(ctx, mountpoint)
+			result <- usageResult{usage: usage, err: err}
+		}()
+	}
+
+	timer := time.NewTimer(s.config.StatTimeout)
+	defer timer.Stop()
+	select {
+	case r := <-result:
+		delete(s.pendingUsages, mountpoint)
+		return r.usage, r.err
+	case <-timer.C:
+		s.pendingUsages[mountpoint] = result
+		return nil, fmt.Errorf("timed out after %s", s.config.StatTimeout)
+	}
+}
+
+// setUsage replaces the space usage of dst with the one of src, keeping the inode usage of dst.
+func setUsage(dst, src *disk.UsageStat) {
+	dst.Total = src.Total
+	dst.Used = src.Used
+	dst.Free = src.Free
+	dst.UsedPercent = 0
+	if src.Total > 0 {
+		dst.UsedPercent = float64(src.Used) / float64(src.Total) * 100
+	}
+}
+
+// readZpoolUsage reads the capacity of the ZFS pools with `zpool list`, by pool name.
+func readZpoolUsage(ctx context.Context) (map[string]*disk.UsageStat, error) {
+	out, err := exec.CommandContext(ctx, "zpool", "list", "-Hp", "-o", "name,size,allocated,free").Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseZpoolList(string(out))
+}
+
+// parseZpoolList parses the tab-separated output of `zpool list -Hp -o name,size,allocated,free`.
+func parseZpoolList(out string) (map[string]*disk.UsageStat, error) {
+	usages := make(map[string]*disk.UsageStat)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("unexpected zpool list output: %q", line)
+		}
+		var values [3]uint64
+		for i, field := range fields[1:] {
+			value, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected zpool list output: %q: %w", line, err)
+			}
+			values[i] = value
+		}
+		usages[fields[0]] = &disk.UsageStat{Path: fields[0], Fstype: "zfs", Total: values[0], Used: values[1], Free: values[2]}
+	}
+	return usages, nil
+}
+
 func getMountMode(opts []string) string {
 	if exists(opts, "rw") {
 		return "rw"
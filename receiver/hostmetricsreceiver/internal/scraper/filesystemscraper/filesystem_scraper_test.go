@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/stretchr/testify/assert"
@@ -195,6 +197,14 @@ func TestScrape(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Invalid Stat Timeout",
+			config: Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				StatTimeout:          -time.Second,
+			},
+			newErrRegex: "^invalid stat_timeout -1s, must not be negative$",
+		},
 		{
 			name: "Invalid Include Device Filter",
 			config: Config{
@@ -397,6 +407,60 @@ func TestScrape(t *testing.T) {
 	}
 }
 
+func TestScrape_StatTimeout(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.SystemFilesystemStatErrors.Enabled = true
+	scraper, err := newFileSystemScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: mbc,
+		StatTimeout:          10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	scraper.partitions = func(context.Context, bool) ([]disk.PartitionStat, error) {
+		return []disk.PartitionStat{
+			{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4", Opts: []string{"rw"}},
+			{Device: "server:/export", Mountpoint: "/mnt/nfs", Fstype: "nfs4", Opts: []string{"rw"}},
+		}, nil
+	}
+	release := make(chan struct{})
+	var hungCalls atomic.Int32
+	scraper.usage = func(_ context.Context, mountpoint string) (*disk.UsageStat, error) {
+		if mountpoint == "/mnt/nfs" {
+			hungCalls.Add(1)
+			<-release
+		}
+		return &disk.UsageStat{Total: 100, Used: 40, Free: 60}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	statErrors := func(md pmetric.Metrics) pmetric.NumberDataPointSlice {
+		m, err := findMetricByName(md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics(), "system.filesystem.stat.errors")
+		require.NoError(t, err)
+		return m.Sum().DataPoints()
+	}
+
+	for i := 1; i <= 2; i++ {
+		md, err := scraper.scrape(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read usage at /mnt/nfs: timed out after 10ms")
+		assert.True(t, scrapererror.IsPartialScrapeError(err))
+
+		m, err := findMetricByName(md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics(), "system.filesystem.usage")
+		require.NoError(t, err)
+		internal.AssertSumMetricHasAttributeValue(t, m, 0, "mountpoint", pcommon.NewValueStr("/"))
+		dps := statErrors(md)
+		require.Equal(t, 1, dps.Len())
+		assert.Equal(t, int64(i), dps.At(0).IntValue())
+		assert.Equal(t, "/mnt/nfs", dps.At(0).Attributes().AsRaw()["mountpoint"])
+	}
+	// the hung call is not made again while it is still running
+	assert.Equal(t, int32(1), hungCalls.Load())
+
+	close(release)
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), statErrors(md).At(0).IntValue())
+}
+
 func findMetricByName(metrics pmetric.MetricSlice, name string) (pmetric.Metric, error) {
 	for i := 0; i < metrics.Len(); i++ {
 		if metrics.At(i).Name() == name {
@@ -448,7 +512,7 @@ func assertFileSystemUsageMetricHasUnixSpecificStateLabels(t *testing.T, metric
 }
 
 func isUnix() bool {
-	for _, unixOS := range []string{"linux", "darwin", "freebsd", "openbsd", "solaris"} {
+	for _, unixOS := range []string{"linux", "darwin", "freebsd", "openbsd", "solaris", "aix"} {
 		if runtime.GOOS == unixOS {
 			return true
 		}
@@ -456,3 +520,80 @@ func isUnix() bool {
 
 	return false
 }
+
+func TestScrape_AccurateUsage(t *testing.T) {
+	scraper, err := newFileSystemScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		AccurateUsage:        true,
+	})
+	require.NoError(t, err)
+	scraper.partitions = func(context.Context, bool) ([]disk.PartitionStat, error) {
+		return []disk.PartitionStat{
+			{Device: "/dev/sda1", Mountpoint: "/", Fstype: "btrfs", Opts: []string{"rw"}},
+			{Device: "tank/home", Mountpoint: "/home", Fstype: "zfs", Opts: []string{"rw"}},
+			{Device: "tank/var", Mountpoint: "/var", Fstype: "zfs", Opts: []string{"rw"}},
+		}, nil
+	}
+	scraper.usage = func(context.Context, string) (*disk.UsageStat, error) {
+		return &disk.UsageStat{Total: 100, Used: 40, Free: 60}, nil
+	}
+	scraper.btrfsUsage = func(_ context.Context, device string) (*disk.UsageStat, error) {
+		assert.Equal(t, "/dev/sda1", device)
+		return &disk.UsageStat{Total: 1000, Used: 300, Free: 700}, nil
+	}
+	var zpoolCalls int
+	scraper.zpoolUsage = func(context.Context) (map[string]*disk.UsageStat, error) {
+		zpoolCalls++
+		return map[string]*disk.UsageStat{"tank": {Total: 5000, Used: 1000, Free: 4000}}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, zpoolCalls)
+	m, err := findMetricByName(md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics(), "system.filesystem.usage")
+	require.NoError(t, err)
+	usages := make(map[string]int64)
+	for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+		attrs := m.Sum().DataPoints().At(i).Attributes().AsRaw()
+		usages[attrs["mountpoint"].(string)+" "+attrs["state"].(string)] = m.Sum().DataPoints().At(i).IntValue()
+	}
+	assert.Equal(t, int64(300), usages["/ used"])
+	assert.Equal(t, int64(700), usages["/ free"])
+	assert.Equal(t, int64(1000), usages["/home used"])
+	assert.Equal(t, int64(4000), usages["/var free"])
+
+	scraper.btrfsUsage = func(context.Context, string) (*disk.UsageStat, error) {
+		return nil, errors.New("err1")
+	}
+	scraper.zpoolUsage = func(context.Context) (map[string]*disk.UsageStat, error) {
+		return nil, errors.New("err2")
+	}
+	md, err = scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "failed to read btrfs usage of /dev/sda1: err1")
+	assert.Contains(t, err.Error(), "failed to read zpool usage: err2")
+	// the usage reported by statfs is kept
+	m, err = findMetricByName(md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics(), "system.filesystem.usage")
+	require.NoError(t, err)
+	assert.Equal(t, 3*fileSystemStatesLen, m.Sum().DataPoints().Len())
+}
+
+func TestParseZpoolList(t *testing.T) {
+	usages, err := parseZpoolList("rpool\t1000\t400\t600\ntank\t5000\t1000\t4000\n")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*disk.UsageStat{
+		"rpool": {Path: "rpool", Fstype: "zfs", Total: 1000, Used: 400, Free: 600},
+		"tank":  {Path: "tank", Fstype: "zfs", Total: 5000, Used: 1000, Free: 4000},
+	}, usages)
+
+	usages, err = parseZpoolList("")
+	require.NoError(t, err)
+	assert.Empty(t, usages)
+
+	_, err = parseZpoolList("rpool\t1000\t400\n")
+	assert.EqualError(t, err, `unexpected zpool list output: "rpool\t1000\t400"`)
+	_, err = parseZpoolList("rpool\t1000\t-\t600\n")
+	assert.Error(t, err)
+}
@@ -28,6 +28,7 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 // MetricsConfig provides config for hostmetricsreceiver/filesystem metrics.
 type MetricsConfig struct {
 	SystemFilesystemInodesUsage MetricConfig `mapstructure:"system.filesystem.inodes.usage"`
+	SystemFilesystemStatErrors  MetricConfig `mapstructure:"system.filesystem.stat.errors"`
 	SystemFilesystemUsage       MetricConfig `mapstructure:"system.filesystem.usage"`
 	SystemFilesystemUtilization MetricConfig `mapstructure:"system.filesystem.utilization"`
 }
@@ -37,6 +38,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		SystemFilesystemInodesUsage: MetricConfig{
 			Enabled: true,
 		},
+		SystemFilesystemStatErrors: MetricConfig{
+			Enabled: false,
+		},
 		SystemFilesystemUsage: MetricConfig{
 			Enabled: true,
 		},
@@ -27,6 +27,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					SystemFilesystemInodesUsage: MetricConfig{Enabled: true},
+					SystemFilesystemStatErrors:  MetricConfig{Enabled: true},
 					SystemFilesystemUsage:       MetricConfig{Enabled: true},
 					SystemFilesystemUtilization: MetricConfig{Enabled: true},
 				},
@@ -37,6 +38,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					SystemFilesystemInodesUsage: MetricConfig{Enabled: false},
+					SystemFilesystemStatErrors:  MetricConfig{Enabled: false},
 					SystemFilesystemUsage:       MetricConfig{Enabled: false},
 					SystemFilesystemUtilization: MetricConfig{Enabled: false},
 				},
@@ -63,6 +63,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSystemFilesystemInodesUsageDataPoint(ts, 1, "device-val", "mode-val", "mountpoint-val", "type-val", AttributeStateFree)
 
+			allMetricsCount++
+			mb.RecordSystemFilesystemStatErrorsDataPoint(ts, 1, "device-val", "mode-val", "mountpoint-val", "type-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSystemFilesystemUsageDataPoint(ts, 1, "device-val", "mode-val", "mountpoint-val", "type-val", AttributeStateFree)
@@ -121,6 +124,32 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("state")
 					assert.True(t, ok)
 					assert.EqualValues(t, "free", attrVal.Str())
+				case "system.filesystem.stat.errors":
+					assert.False(t, validatedMetrics["system.filesystem.stat.errors"], "Found a duplicate in the metrics slice: system.filesystem.stat.errors")
+					validatedMetrics["system.filesystem.stat.errors"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of times the usage of the filesystem could not be read, because the call failed or timed out.", ms.At(i).Description())
+					assert.Equal(t, "{errors}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("mode")
+					assert.True(t, ok)
+					assert.EqualValues(t, "mode-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("mountpoint")
+					assert.True(t, ok)
+					assert.EqualValues(t, "mountpoint-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "type-val", attrVal.Str())
 				case "system.filesystem.usage":
 					assert.False(t, validatedMetrics["system.filesystem.usage"], "Found a duplicate in the metrics slice: system.filesystem.usage")
 					validatedMetrics["system.filesystem.usage"] = true
@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+// Config relating to GPU Metric Scraper.
+type Config struct {
+	// MetricsBuilderConfig allows to customize scraped metrics/attributes representation.
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	internal.ScraperConfig
+
+	// NvidiaSMIPath is the path of the nvidia-smi utility installed with the NVIDIA driver, which is run twice at
+	// every scrape to query the GPUs. It must be set to enable the scraper.
+	NvidiaSMIPath string `mapstructure:"nvidia_smi_path"`
+}
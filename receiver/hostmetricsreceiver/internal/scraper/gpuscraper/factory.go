@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"context"
+	"errors"
+	"runtime"
+
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+// This file implements Factory for GPU scraper.
+
+const (
+	// TypeStr the value of "type" key in configuration.
+	TypeStr = "gpu"
+)
+
+// Factory is the Factory for scraper.
+type Factory struct {
+}
+
+// CreateDefaultConfig creates the default configuration for the Scraper.
+func (f *Factory) CreateDefaultConfig() internal.Config {
+	return &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	}
+}
+
+// CreateMetricsScraper creates a scraper based on provided config.
+func (f *Factory) CreateMetricsScraper(
+	ctx context.Context,
+	settings receiver.CreateSettings,
+	config internal.Config,
+) (scraperhelper.Scraper, error) {
+	if runtime.GOOS != "linux" && runtime.GOOS != "windows" {
+		return nil, errors.New("gpu scraper only available on Linux and Windows")
+	}
+
+	cfg := config.(*Config)
+	if cfg.NvidiaSMIPath == "" {
+		return nil, errors.New("nvidia_smi_path must be set, GPU metrics are read by running nvidia-smi at every scrape")
+	}
+	s := newGPUScraper(ctx, settings, cfg)
+
+	return scraperhelper.NewScraper(
+		TypeStr, s.scrape, scraperhelper.WithStart(s.start))
+}
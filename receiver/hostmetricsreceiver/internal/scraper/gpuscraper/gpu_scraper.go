@@ -0,0 +1,269 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+const (
+	gpuMetricsLen     = 5
+	processMetricsLen = 1
+
+	mebibyte = 1 << 20
+)
+
+// gpuStat holds the state of a GPU, as read with `nvidia-smi --query-gpu`. The readings the GPU does not support
+// are nil.
+type gpuStat struct {
+	index             string
+	uuid              string
+	utilization       *float64
+	memoryUtilization *float64
+	memoryUsed        *int64
+	memoryFree        *int64
+	temperature       *float64
+	powerDraw         *float64
+}
+
+// gpuProcess holds the GPU memory used by a compute process, as read with `nvidia-smi --query-compute-apps`.
+type gpuProcess struct {
+	gpuUUID    string
+	pid        int64
+	name       string
+	memoryUsed *int64
+}
+
+// scraper for GPU Metrics
+type scraper struct {
+	settings   receiver.CreateSettings
+	config     *Config
+	mb         *metadata.MetricsBuilder
+	skipScrape bool
+
+	// for mocking
+	bootTime  func(context.Context) (uint64, error)
+	lookPath  func(string) (string, error)
+	gpus      func(ctx context.Context, nvidiaSMI string) ([]gpuStat, error)
+	processes func(ctx context.Context, nvidiaSMI string) ([]gpuProcess, error)
+}
+
+// newGPUScraper creates a GPU Scraper
+func newGPUScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, lookPath: exec.LookPath, gpus: readGPUs, processes: readGPUProcesses}
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+
+	// Hosts without an NVIDIA driver have no GPU to report on: rather than failing every scrape, skip scraping.
+	if _, err = s.lookPath(s.config.NvidiaSMIPath); err != nil {
+		s.settings.Logger.Warn("The NVIDIA driver utilities were not found, GPU metrics will not be scraped", zap.Error(err))
+		s.skipScrape = true
+	}
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.skipScrape {
+		return pmetric.NewMetrics(), nil
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errors scrapererror.ScrapeErrors
+
+	gpus, err := s.gpus(ctx, s.config.NvidiaSMIPath)
+	if err != nil {
+		errors.AddPartial(gpuMetricsLen+processMetricsLen, fmt.Errorf("failed to read GPU stats: %w", err))
+		return s.mb.Emit(), errors.Combine()
+	}
+
+	indexes := make(map[string]string, len(gpus))
+	for _, gpu := range gpus {
+		indexes[gpu.uuid] = gpu.index
+		s.recordGPUDataPoints(now, gpu)
+	}
+
+	if s.config.MetricsBuilderConfig.Metrics.SystemGpuProcessMemoryUsage.Enabled && len(gpus) > 0 {
+		processes, err := s.processes(ctx, s.config.NvidiaSMIPath)
+		if err != nil {
+			errors.AddPartial(processMetricsLen, fmt.Errorf("failed to read GPU processes: %w", err))
+		}
+		for _, process := range processes {
+			index, ok := indexes[process.gpuUUID]
+			if !ok || process.memoryUsed == nil {
+				continue
+			}
+			s.mb.RecordSystemGpuProcessMemoryUsageDataPoint(now, *process.memoryUsed, index, process.pid, process.name)
+		}
+	}
+
+	return s.mb.Emit(), errors.Combine()
+}
+
+func (s *scraper) recordGPUDataPoints(now pcommon.Timestamp, gpu gpuStat) {
+	if gpu.utilization != nil {
+		s.mb.RecordSystemGpuUtilizationDataPoint(now, *gpu.utilization, gpu.index)
+	}
+	if gpu.memoryUsed != nil {
+		s.mb.RecordSystemGpuMemoryUsageDataPoint(now, *gpu.memoryUsed, gpu.index, metadata.AttributeStateUsed)
+	}
+	if gpu.memoryFree != nil {
+		s.mb.RecordSystemGpuMemoryUsageDataPoint(now, *gpu.memoryFree, gpu.index, metadata.AttributeStateFree)
+	}
+	if gpu.memoryUtilization != nil {
+		s.mb.RecordSystemGpuMemoryUtilizationDataPoint(now, *gpu.memoryUtilization, gpu.index)
+	}
+	if gpu.temperature != nil {
+		s.mb.RecordSystemGpuTemperatureDataPoint(now, *gpu.temperature, gpu.index)
+	}
+	if gpu.powerDraw != nil {
+		s.mb.RecordSystemGpuPowerUsageDataPoint(now, *gpu.powerDraw, gpu.index)
+	}
+}
+
+// readGPUs reads the state of the GPUs with nvidia-smi.
+func readGPUs(ctx context.Context, nvidiaSMI string) ([]gpuStat, error) {
+	out, err := exec.CommandContext(ctx, nvidiaSMI,
+		"--query-gpu=index,uuid,utilization.gpu,utilization.memory,memory.used,memory.free,temperature.gpu,power.draw",
+		"--format=csv,noheader,nounits").Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseGPUs(out)
+}
+
+// parseGPUs parses the output of `nvidia-smi --query-gpu=index,uuid,utilization.gpu,utilization.memory,memory.used,
+// memory.free,temperature.gpu,power.draw --format=csv,noheader,nounits`, where utilizations are in percent and
+// memory in MiB.
+func parseGPUs(out []byte) ([]gpuStat, error) {
+	records, err := parseCSV(out, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	gpus := make([]gpuStat, 0, len(records))
+	for _, record := range records {
+		gpu := gpuStat{index: record[0], uuid: record[1]}
+		if gpu.utilization, err = parseFloat(record[2], 0.01); err != nil {
+			return nil, err
+		}
+		if gpu.memoryUtilization, err = parseFloat(record[3], 0.01); err != nil {
+			return nil, err
+		}
+		if gpu.memoryUsed, err = parseMiB(record[4]); err != nil {
+			return nil, err
+		}
+		if gpu.memoryFree, err = parseMiB(record[5]); err != nil {
+			return nil, err
+		}
+		if gpu.temperature, err = parseFloat(record[6], 1); err != nil {
+			return nil, err
+		}
+		if gpu.powerDraw, err = parseFloat(record[7], 1); err != nil {
+			return nil, err
+		}
+		gpus = append(gpus, gpu)
+	}
+	return gpus, nil
+}
+
+// readGPUProcesses reads the GPU memory used by the compute processes with nvidia-smi.
+func readGPUProcesses(ctx context.Context, nvidiaSMI string) ([]gpuProcess, error) {
+	out, err := exec.CommandContext(ctx, nvidiaSMI,
+		"--query-compute-apps=gpu_uuid,pid,process_name,used_memory",
+		"--format=csv,noheader,nounits").Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseGPUProcesses(out)
+}
+
+// parseGPUProcesses parses the output of `nvidia-smi --query-compute-apps=gpu_uuid,pid,process_name,used_memory
+// --format=csv,noheader,nounits`, where memory is in MiB.
+func parseGPUProcesses(out []byte) ([]gpuProcess, error) {
+	records, err := parseCSV(out, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	processes := make([]gpuProcess, 0, len(records))
+	for _, record := range records {
+		pid, err := strconv.ParseInt(record[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pid %q: %w", record[1], err)
+		}
+		process := gpuProcess{gpuUUID: record[0], pid: pid, name: filepath.Base(record[2])}
+		if process.memoryUsed, err = parseMiB(record[3]); err != nil {
+			return nil, err
+		}
+		processes = append(processes, process)
+	}
+	return processes, nil
+}
+
+func parseCSV(out []byte, fields int) ([][]string, error) {
+	reader := csv.NewReader(bytes.NewReader(out))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = fields
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected nvidia-smi output: %w", err)
+	}
+	return records, nil
+}
+
+// notAvailable reports whether nvidia-smi returned no reading for a field, such as `[N/A]` or `[Not Supported]`.
+func notAvailable(value string) bool {
+	return strings.HasPrefix(value, "[") || value == "N/A"
+}
+
+func parseFloat(value string, scale float64) (*float64, error) {
+	if notAvailable(value) {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected nvidia-smi value %q: %w", value, err)
+	}
+	f *= scale
+	return &f, nil
+}
+
+func parseMiB(value string) (*int64, error) {
+	if notAvailable(value) {
+		return nil, nil
+	}
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected nvidia-smi value %q: %w", value, err)
+	}
+	i *= mebibyte
+	return &i, nil
+}
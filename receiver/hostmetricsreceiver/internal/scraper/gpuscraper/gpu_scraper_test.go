@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+const (
+	gpusOutput = `0, GPU-5a3bd1e4-0d6f-4c1c-9c1e-3b2e5b7f0a11, 87, 41, 30512, 50048, 71, 288.45
+1, GPU-c2a4f0b1-8e3d-4a92-b1f7-6d0e2c9a4b22, 0, 0, 0, 81920, 34, [N/A]
+`
+	processesOutput = `GPU-5a3bd1e4-0d6f-4c1c-9c1e-3b2e5b7f0a11, 4242, /usr/bin/python3, 30000
+GPU-5a3bd1e4-0d6f-4c1c-9c1e-3b2e5b7f0a11, 4243, C:\Program Files\app.exe, [N/A]
+`
+)
+
+func newTestScraper(t *testing.T, mbc metadata.MetricsBuilderConfig) *scraper {
+	cfg := &Config{MetricsBuilderConfig: mbc, NvidiaSMIPath: "/usr/bin/nvidia-smi"}
+	scraper, err := (&Factory{}).CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	if err != nil {
+		t.Skip(err)
+	}
+	require.NotNil(t, scraper)
+
+	s := newGPUScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	s.bootTime = func(context.Context) (uint64, error) { return 100, nil }
+	s.lookPath = func(file string) (string, error) { return file, nil }
+	s.gpus = func(_ context.Context, nvidiaSMI string) ([]gpuStat, error) {
+		assert.Equal(t, "/usr/bin/nvidia-smi", nvidiaSMI)
+		return parseGPUs([]byte(gpusOutput))
+	}
+	s.processes = func(_ context.Context, nvidiaSMI string) ([]gpuProcess, error) {
+		assert.Equal(t, "/usr/bin/nvidia-smi", nvidiaSMI)
+		return parseGPUProcesses([]byte(processesOutput))
+	}
+	return s
+}
+
+func TestScrape(t *testing.T) {
+	s := newTestScraper(t, metadata.DefaultMetricsBuilderConfig())
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 5, metrics.Len())
+
+	byName := make(map[string]pmetric.Metric)
+	for i := 0; i < metrics.Len(); i++ {
+		byName[metrics.At(i).Name()] = metrics.At(i)
+	}
+
+	utilization := byName["system.gpu.utilization"].Gauge().DataPoints()
+	require.Equal(t, 2, utilization.Len())
+	assert.InDelta(t, 0.87, utilization.At(0).DoubleValue(), 1e-9)
+	assert.Equal(t, pcommon.Timestamp(100*1e9), utilization.At(0).StartTimestamp())
+	gpu, _ := utilization.At(1).Attributes().Get("gpu")
+	assert.Equal(t, "1", gpu.Str())
+
+	memory := byName["system.gpu.memory.usage"].Sum().DataPoints()
+	require.Equal(t, 4, memory.Len())
+	assert.Equal(t, int64(30512<<20), memory.At(0).IntValue())
+	state, _ := memory.At(0).Attributes().Get("state")
+	assert.Equal(t, "used", state.Str())
+
+	assert.Equal(t, 2, byName["system.gpu.temperature"].Gauge().DataPoints().Len())
+
+	// the second GPU does not support power readings
+	power := byName["system.gpu.power.usage"].Gauge().DataPoints()
+	require.Equal(t, 1, power.Len())
+	assert.Equal(t, 288.45, power.At(0).DoubleValue())
+
+	// the memory used by the second process is not available
+	processes := byName["system.gpu.process.memory.usage"].Sum().DataPoints()
+	require.Equal(t, 1, processes.Len())
+	assert.Equal(t, int64(30000<<20), processes.At(0).IntValue())
+	assert.Equal(t, map[string]any{"gpu": "0", "process.pid": int64(4242), "process.executable.name": "python3"}, processes.At(0).Attributes().AsRaw())
+}
+
+func TestScrape_Errors(t *testing.T) {
+	s := newTestScraper(t, metadata.DefaultMetricsBuilderConfig())
+	s.processes = func(context.Context, string) ([]gpuProcess, error) { return nil, errors.New("err1") }
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := s.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read GPU processes: err1")
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, processMetricsLen, partialErr.Failed)
+	assert.Equal(t, 4, md.MetricCount())
+
+	s.gpus = func(context.Context, string) ([]gpuStat, error) { return nil, errors.New("err2") }
+	md, err = s.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read GPU stats: err2")
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, gpuMetricsLen+processMetricsLen, partialErr.Failed)
+	assert.Equal(t, 0, md.MetricCount())
+}
+
+func TestScrape_NoDriver(t *testing.T) {
+	s := newTestScraper(t, metadata.DefaultMetricsBuilderConfig())
+	s.lookPath = func(file string) (string, error) { return "", errors.New(file + " not found") }
+	s.gpus = func(context.Context, string) ([]gpuStat, error) { return nil, errors.New("not called") }
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.MetricCount())
+}
+
+func TestParseGPUs(t *testing.T) {
+	gpus, err := parseGPUs([]byte(gpusOutput))
+	require.NoError(t, err)
+	require.Len(t, gpus, 2)
+	assert.Equal(t, "GPU-c2a4f0b1-8e3d-4a92-b1f7-6d0e2c9a4b22", gpus[1].uuid)
+	assert.Nil(t, gpus[1].powerDraw)
+	require.NotNil(t, gpus[1].memoryFree)
+	assert.Equal(t, int64(81920<<20), *gpus[1].memoryFree)
+
+	gpus, err = parseGPUs(nil)
+	require.NoError(t, err)
+	assert.Empty(t, gpus)
+
+	_, err = parseGPUs([]byte("0, GPU-1, 87\n"))
+	assert.Error(t, err)
+	_, err = parseGPUs([]byte("0, GPU-1, high, 41, 30512, 50048, 71, 288.45\n"))
+	assert.Error(t, err)
+}
+
+func TestParseGPUProcesses(t *testing.T) {
+	processes, err := parseGPUProcesses([]byte(processesOutput))
+	require.NoError(t, err)
+	require.Len(t, processes, 2)
+	assert.Equal(t, int64(4243), processes[1].pid)
+	assert.Nil(t, processes[1].memoryUsed)
+
+	_, err = parseGPUProcesses([]byte("GPU-1, init, python3, 300\n"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hwmonscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper/internal/metadata"
+)
+
+const metricsLen = 4
+
+type sensorKind int
+
+const (
+	temperature sensorKind = iota
+	fan
+	voltage
+	power
+)
+
+// sensorTypes lists the sensors read from each hwmon chip: the prefix of their files, the suffixes of the files
+// holding their reading, in order of preference, and the scale converting the reading to the unit of the metric.
+var sensorTypes = []struct {
+	kind     sensorKind
+	prefix   string
+	suffixes []string
+	scale    float64
+}{
+	// millidegree Celsius
+	{kind: temperature, prefix: "temp", suffixes: []string{"_input"}, scale: 1e-3},
+	// revolutions per minute
+	{kind: fan, prefix: "fan", suffixes: []string{"_input"}, scale: 1},
+	// millivolt
+	{kind: voltage, prefix: "in", suffixes: []string{"_input"}, scale: 1e-3},
+	// microwatt, averaged over an interval by the chips which do not report the instant power
+	{kind: power, prefix: "power", suffixes: []string{"_input", "_average"}, scale: 1e-6},
+}
+
+// sensorReading holds the reading of a sensor of a hwmon chip, converted to the unit of its metric.
+type sensorReading struct {
+	kind   sensorKind
+	chip   string
+	device string
+	sensor string
+	value  float64
+}
+
+// scraper for Hardware Monitoring Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking
+	bootTime func(context.Context) (uint64, error)
+	sensors  func(context.Context) ([]sensorReading, error)
+}
+
+// newHwmonScraper creates a Hwmon Scraper
+func newHwmonScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, sensors: readSensors}
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errors scrapererror.ScrapeErrors
+
+	// the readings of the chips which could be read are reported along with the error
+	readings, err := s.sensors(ctx)
+	if err != nil {
+		errors.AddPartial(metricsLen, fmt.Errorf("failed to read hwmon sensors: %w", err))
+	}
+
+	for _, reading := range readings {
+		switch reading.kind {
+		case temperature:
+			s.mb.RecordSystemHwmonTemperatureDataPoint(now, reading.value, reading.chip, reading.device, reading.sensor)
+		case fan:
+			s.mb.RecordSystemHwmonFanSpeedDataPoint(now, int64(reading.value), reading.chip, reading.device, reading.sensor)
+		case voltage:
+			s.mb.RecordSystemHwmonVoltageDataPoint(now, reading.value, reading.chip, reading.device, reading.sensor)
+		case power:
+			s.mb.RecordSystemHwmonPowerDataPoint(now, reading.value, reading.chip, reading.device, reading.sensor)
+		}
+	}
+
+	return s.mb.Emit(), errors.Combine()
+}
+
+// readSensors reads the sensors of every chip in /sys/class/hwmon.
+func readSensors(ctx context.Context) ([]sensorReading, error) {
+	chipPaths, err := filepath.Glob(filepath.Join(internal.SysPath(ctx), "class", "hwmon", "hwmon*"))
+	if err != nil {
+		return nil, err
+	}
+
+	var readings []sensorReading
+	var errs error
+	for _, chipPath := range chipPaths {
+		chipReadings, err := readChip(chipPath)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		readings = append(readings, chipReadings...)
+	}
+	return readings, errs
+}
+
+// readChip reads the sensors of the hwmon chip at chipPath.
+func readChip(chipPath string) ([]sensorReading, error) {
+	name, err := os.ReadFile(filepath.Join(chipPath, "name"))
+	if err != nil {
+		return nil, err
+	}
+	chip := strings.TrimSpace(string(name))
+
+	// chips with no underlying device, such as those registered by the thermal subsystem, have no device link
+	var device string
+	if devicePath, err := filepath.EvalSymlinks(filepath.Join(chipPath, "device")); err == nil {
+		device = filepath.Base(devicePath)
+	}
+
+	var readings []sensorReading
+	for _, sensorType := range sensorTypes {
+		var sensorPaths []string
+		var suffix string
+		for _, suffix = range sensorType.suffixes {
+			if sensorPaths, err = filepath.Glob(filepath.Join(chipPath, sensorType.prefix+"[0-9]*"+suffix)); err != nil {
+				return nil, err
+			}
+			if len(sensorPaths) > 0 {
+				break
+			}
+		}
+
+		for _, sensorPath := range sensorPaths {
+			sensor := strings.TrimSuffix(filepath.Base(sensorPath), suffix)
+
+			// faulty or disconnected sensors fail to read, and are not reported
+			value, err := readInt(sensorPath)
+			if err != nil {
+				continue
+			}
+			if label, err := os.ReadFile(filepath.Join(chipPath, sensor+"_label")); err == nil && strings.TrimSpace(string(label)) != "" {
+				sensor = strings.TrimSpace(string(label))
+			}
+
+			readings = append(readings, sensorReading{
+				kind:   sensorType.kind,
+				chip:   chip,
+				device: device,
+				sensor: sensor,
+				value:  float64(value) * sensorType.scale,
+			})
+		}
+	}
+	return readings, nil
+}
+
+func readInt(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hwmonscraper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	readings := []sensorReading{
+		{kind: temperature, chip: "coretemp", device: "coretemp.0", sensor: "Package id 0", value: 45.5},
+		{kind: fan, chip: "nct6775", device: "nct6775.656", sensor: "fan1", value: 1200},
+		{kind: voltage, chip: "nct6775", device: "nct6775.656", sensor: "Vcore", value: 1.2},
+		{kind: power, chip: "amdgpu", device: "0000:03:00.0", sensor: "PPT", value: 35.5},
+	}
+
+	tests := []struct {
+		name              string
+		sensorsFunc       func(context.Context) ([]sensorReading, error)
+		expectedDataPoint int
+		expectedErr       string
+	}{
+		{
+			name:              "Standard",
+			sensorsFunc:       func(context.Context) ([]sensorReading, error) { return readings, nil },
+			expectedDataPoint: 4,
+		},
+		{
+			name:              "Partial error",
+			sensorsFunc:       func(context.Context) ([]sensorReading, error) { return readings[:1], errors.New("err1") },
+			expectedDataPoint: 1,
+			expectedErr:       "failed to read hwmon sensors: err1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+			scraper, err := (&Factory{}).CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+			if runtime.GOOS != "linux" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, scraper)
+
+			s := newHwmonScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+			s.bootTime = func(context.Context) (uint64, error) { return 100, nil }
+			s.sensors = test.sensorsFunc
+			require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := s.scrape(context.Background())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				var partialErr scrapererror.PartialScrapeError
+				require.ErrorAs(t, err, &partialErr)
+				assert.Equal(t, metricsLen, partialErr.Failed)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedDataPoint, md.DataPointCount())
+
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			byName := make(map[string]pmetric.Metric)
+			for i := 0; i < metrics.Len(); i++ {
+				byName[metrics.At(i).Name()] = metrics.At(i)
+			}
+			temperature := byName["system.hwmon.temperature"].Gauge().DataPoints().At(0)
+			assert.Equal(t, 45.5, temperature.DoubleValue())
+			assert.Equal(t, map[string]any{"chip": "coretemp", "device": "coretemp.0", "sensor": "Package id 0"}, temperature.Attributes().AsRaw())
+			if test.expectedErr == "" {
+				assert.Equal(t, int64(1200), byName["system.hwmon.fan.speed"].Gauge().DataPoints().At(0).IntValue())
+			}
+		})
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+}
+
+func TestReadSensors(t *testing.T) {
+	root := t.TempDir()
+	hwmon := filepath.Join(root, "class", "hwmon")
+
+	device := filepath.Join(root, "devices", "platform", "coretemp.0")
+	require.NoError(t, os.MkdirAll(device, 0o700))
+	writeFiles(t, filepath.Join(hwmon, "hwmon0"), map[string]string{
+		"name":        "coretemp\n",
+		"temp1_input": "45500\n",
+		"temp1_label": "Package id 0\n",
+		"temp1_crit":  "100000\n",
+		"temp2_input": "41000\n",
+	})
+	require.NoError(t, os.Symlink(device, filepath.Join(hwmon, "hwmon0", "device")))
+
+	writeFiles(t, filepath.Join(hwmon, "hwmon1"), map[string]string{
+		"name":           "nct6775\n",
+		"fan1_input":     "1200\n",
+		"in0_input":      "1200\n",
+		"in0_label":      "Vcore\n",
+		"power1_average": "35500000\n",
+		// sensor of a disconnected fan
+		"fan2_input": "",
+	})
+
+	// chip with no name
+	writeFiles(t, filepath.Join(hwmon, "hwmon2"), map[string]string{"temp1_input": "40000\n"})
+
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: root})
+	readings, err := readSensors(ctx)
+	assert.ErrorContains(t, err, "hwmon2")
+	assert.ElementsMatch(t, []sensorReading{
+		{kind: temperature, chip: "coretemp", device: "coretemp.0", sensor: "Package id 0", value: 45.5},
+		{kind: temperature, chip: "coretemp", device: "coretemp.0", sensor: "temp2", value: 41},
+		{kind: fan, chip: "nct6775", sensor: "fan1", value: 1200},
+		{kind: voltage, chip: "nct6775", sensor: "Vcore", value: 1.2},
+		{kind: power, chip: "nct6775", sensor: "power1", value: 35.5},
+	}, readings)
+}
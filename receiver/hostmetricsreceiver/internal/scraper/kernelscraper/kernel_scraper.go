@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kernelscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/kernelscraper"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/kernelscraper/internal/metadata"
+)
+
+const (
+	filesMetricsLen  = 2
+	inodesMetricsLen = 1
+)
+
+// fileStat holds the file handles of the kernel, as read from /proc/sys/fs/file-nr.
+type fileStat struct {
+	allocated int64
+	unused    int64
+	max       int64
+}
+
+// inodeStat holds the inodes of the kernel, as read from /proc/sys/fs/inode-nr.
+type inodeStat struct {
+	allocated int64
+	free      int64
+}
+
+// scraper for Kernel Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking
+	bootTime func(context.Context) (uint64, error)
+	files    func(context.Context) (*fileStat, error)
+	inodes   func(context.Context) (*inodeStat, error)
+}
+
+// newKernelScraper creates a Kernel Scraper
+func newKernelScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, files: readFiles, inodes: readInodes}
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errors scrapererror.ScrapeErrors
+
+	files, err := s.files(ctx)
+	if err != nil {
+		errors.AddPartial(filesMetricsLen, fmt.Errorf("failed to read file handles: %w", err))
+	} else {
+		// the kernel frees unused file handles right away since Linux 2.6, but still reports them
+		used := files.allocated - files.unused
+		s.mb.RecordSystemKernelFilesUsageDataPoint(now, used, metadata.AttributeStateUsed)
+		s.mb.RecordSystemKernelFilesUsageDataPoint(now, files.max-used, metadata.AttributeStateFree)
+		if files.max > 0 {
+			s.mb.RecordSystemKernelFilesUtilizationDataPoint(now, float64(used)/float64(files.max))
+		}
+	}
+
+	inodes, err := s.inodes(ctx)
+	if err != nil {
+		errors.AddPartial(inodesMetricsLen, fmt.Errorf("failed to read inodes: %w", err))
+	} else {
+		s.mb.RecordSystemKernelInodesUsageDataPoint(now, inodes.allocated-inodes.free, metadata.AttributeStateUsed)
+		s.mb.RecordSystemKernelInodesUsageDataPoint(now, inodes.free, metadata.AttributeStateFree)
+	}
+
+	return s.mb.Emit(), errors.Combine()
+}
+
+// readFiles reads /proc/sys/fs/file-nr, which holds the number of allocated file handles, the number of unused
+// ones among them, and the maximum number of file handles, e.g. `12384	0	9223372036854775807`.
+func readFiles(ctx context.Context) (*fileStat, error) {
+	values, err := readInts(filepath.Join(internal.ProcPath(ctx), "sys", "fs", "file-nr"), 3)
+	if err != nil {
+		return nil, err
+	}
+	return &fileStat{allocated: values[0], unused: values[1], max: values[2]}, nil
+}
+
+// readInodes reads /proc/sys/fs/inode-nr, which holds the number of allocated inodes and the number of free ones
+// among them, e.g. `412307	9814`.
+func readInodes(ctx context.Context) (*inodeStat, error) {
+	values, err := readInts(filepath.Join(internal.ProcPath(ctx), "sys", "fs", "inode-nr"), 2)
+	if err != nil {
+		return nil, err
+	}
+	return &inodeStat{allocated: values[0], free: values[1]}, nil
+}
+
+func readInts(path string, count int) ([]int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) != count {
+		return nil, fmt.Errorf("unexpected content of %s: %q", path, data)
+	}
+	values := make([]int64, count)
+	for i, field := range fields {
+		if values[i], err = strconv.ParseInt(field, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid value in %s: %w", path, err)
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kernelscraper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/kernelscraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	type testCase struct {
+		name           string
+		filesErr       error
+		inodesErr      error
+		expectedMetric int
+		expectedErr    string
+		expectedFailed int
+	}
+
+	testCases := []testCase{
+		{
+			name:           "Standard",
+			expectedMetric: 3,
+		},
+		{
+			name:           "Files error",
+			filesErr:       errors.New("err1"),
+			expectedMetric: 1,
+			expectedErr:    "failed to read file handles: err1",
+			expectedFailed: filesMetricsLen,
+		},
+		{
+			name:           "Inodes error",
+			inodesErr:      errors.New("err2"),
+			expectedMetric: 2,
+			expectedErr:    "failed to read inodes: err2",
+			expectedFailed: inodesMetricsLen,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.SystemKernelFilesUtilization.Enabled = true
+			cfg := &Config{MetricsBuilderConfig: mbc}
+			scraper, err := (&Factory{}).CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+			if runtime.GOOS != "linux" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, scraper)
+
+			s := newKernelScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+			s.bootTime = func(context.Context) (uint64, error) { return 100, nil }
+			s.files = func(context.Context) (*fileStat, error) {
+				return &fileStat{allocated: 1000, unused: 0, max: 4000}, test.filesErr
+			}
+			s.inodes = func(context.Context) (*inodeStat, error) {
+				return &inodeStat{allocated: 500, free: 100}, test.inodesErr
+			}
+			require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := s.scrape(context.Background())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				var partialErr scrapererror.PartialScrapeError
+				require.ErrorAs(t, err, &partialErr)
+				assert.Equal(t, test.expectedFailed, partialErr.Failed)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.expectedMetric, md.MetricCount())
+
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				metric := metrics.At(i)
+				switch metric.Name() {
+				case "system.kernel.files.usage":
+					assertUsage(t, metric, 1000, 3000)
+				case "system.kernel.files.utilization":
+					assert.Equal(t, 0.25, metric.Gauge().DataPoints().At(0).DoubleValue())
+				case "system.kernel.inodes.usage":
+					assertUsage(t, metric, 400, 100)
+				}
+			}
+		})
+	}
+}
+
+func assertUsage(t *testing.T, metric pmetric.Metric, used, free int64) {
+	dps := metric.Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		state, _ := dps.At(i).Attributes().Get("state")
+		switch state.Str() {
+		case "used":
+			assert.Equal(t, used, dps.At(i).IntValue())
+		case "free":
+			assert.Equal(t, free, dps.At(i).IntValue())
+		}
+	}
+}
+
+func TestReadFilesAndInodes(t *testing.T) {
+	root := t.TempDir()
+	fs := filepath.Join(root, "sys", "fs")
+	require.NoError(t, os.MkdirAll(fs, 0o700))
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: root})
+
+	_, err := readFiles(ctx)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, os.WriteFile(filepath.Join(fs, "file-nr"), []byte("12384\t0\t9223372036854775807\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(fs, "inode-nr"), []byte("412307\t9814\n"), 0o600))
+
+	files, err := readFiles(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &fileStat{allocated: 12384, unused: 0, max: 9223372036854775807}, files)
+
+	inodes, err := readInodes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &inodeStat{allocated: 412307, free: 9814}, inodes)
+
+	require.NoError(t, os.WriteFile(filepath.Join(fs, "inode-nr"), []byte("412307\n"), 0o600))
+	_, err = readInodes(ctx)
+	assert.ErrorContains(t, err, "unexpected content")
+
+	require.NoError(t, os.WriteFile(filepath.Join(fs, "inode-nr"), []byte("412307\tmany\n"), 0o600))
+	_, err = readInodes(ctx)
+	assert.ErrorContains(t, err, "invalid value")
+}
@@ -12,6 +12,10 @@ import (
 type Config struct {
 	// If true, metrics will be average load per cpu
 	CPUAverage bool `mapstructure:"cpu_average"`
+	// Source selects the saturation signal reported: `load_average`, the default, reports the load averages, and
+	// `cpu_pressure` the averages of the CPU pressure stall information read from /proc/pressure/cpu instead.
+	// `cpu_pressure` is only supported on Linux.
+	Source string `mapstructure:"source"`
 	// MetricsBuilderConfig allows to customize scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	internal.ScraperConfig
@@ -5,6 +5,9 @@ package loadscraper // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"runtime"
 
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -38,6 +41,15 @@ func (f *Factory) CreateMetricsScraper(
 	config internal.Config,
 ) (scraperhelper.Scraper, error) {
 	cfg := config.(*Config)
+	switch cfg.Source {
+	case "", sourceLoadAverage:
+	case sourceCPUPressure:
+		if runtime.GOOS != "linux" {
+			return nil, errors.New("cpu_pressure source only available on Linux")
+		}
+	default:
+		return nil, fmt.Errorf("invalid source %q, must be load_average or cpu_pressure", cfg.Source)
+	}
 	s := newLoadScraper(ctx, settings, cfg)
 
 	return scraperhelper.NewScraper(
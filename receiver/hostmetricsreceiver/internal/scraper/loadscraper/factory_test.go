@@ -5,6 +5,7 @@ package loadscraper
 
 import (
 	"context"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,3 +27,19 @@ func TestCreateMetricsScraper(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, scraper)
 }
+
+func TestCreateMetricsScraper_Source(t *testing.T) {
+	factory := &Factory{}
+
+	scraper, err := factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{Source: "cpu_pressure"})
+	if runtime.GOOS == "linux" {
+		assert.NoError(t, err)
+		assert.NotNil(t, scraper)
+	} else {
+		assert.Error(t, err)
+		assert.Nil(t, scraper)
+	}
+
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{Source: "uptime"})
+	assert.EqualError(t, err, `invalid source "uptime", must be load_average or cpu_pressure`)
+}
@@ -30,6 +30,9 @@ type MetricsConfig struct {
 	SystemCPULoadAverage15m MetricConfig `mapstructure:"system.cpu.load_average.15m"`
 	SystemCPULoadAverage1m  MetricConfig `mapstructure:"system.cpu.load_average.1m"`
 	SystemCPULoadAverage5m  MetricConfig `mapstructure:"system.cpu.load_average.5m"`
+	SystemCPUPressureAvg10  MetricConfig `mapstructure:"system.cpu.pressure.avg10"`
+	SystemCPUPressureAvg300 MetricConfig `mapstructure:"system.cpu.pressure.avg300"`
+	SystemCPUPressureAvg60  MetricConfig `mapstructure:"system.cpu.pressure.avg60"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -43,6 +46,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		SystemCPULoadAverage5m: MetricConfig{
 			Enabled: true,
 		},
+		SystemCPUPressureAvg10: MetricConfig{
+			Enabled: true,
+		},
+		SystemCPUPressureAvg300: MetricConfig{
+			Enabled: true,
+		},
+		SystemCPUPressureAvg60: MetricConfig{
+			Enabled: true,
+		},
 	}
 }
 
@@ -29,6 +29,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SystemCPULoadAverage15m: MetricConfig{Enabled: true},
 					SystemCPULoadAverage1m:  MetricConfig{Enabled: true},
 					SystemCPULoadAverage5m:  MetricConfig{Enabled: true},
+					SystemCPUPressureAvg10:  MetricConfig{Enabled: true},
+					SystemCPUPressureAvg300: MetricConfig{Enabled: true},
+					SystemCPUPressureAvg60:  MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -39,6 +42,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SystemCPULoadAverage15m: MetricConfig{Enabled: false},
 					SystemCPULoadAverage1m:  MetricConfig{Enabled: false},
 					SystemCPULoadAverage5m:  MetricConfig{Enabled: false},
+					SystemCPUPressureAvg10:  MetricConfig{Enabled: false},
+					SystemCPUPressureAvg300: MetricConfig{Enabled: false},
+					SystemCPUPressureAvg60:  MetricConfig{Enabled: false},
 				},
 			},
 		},
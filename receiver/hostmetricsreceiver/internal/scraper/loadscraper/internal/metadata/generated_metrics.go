@@ -159,6 +159,153 @@ func newMetricSystemCPULoadAverage5m(cfg MetricConfig) metricSystemCPULoadAverag
 	return m
 }
 
+type metricSystemCPUPressureAvg10 struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.pressure.avg10 metric with initial data.
+func (m *metricSystemCPUPressureAvg10) init() {
+	m.data.SetName("system.cpu.pressure.avg10")
+	m.data.SetDescription("Percentage of the time some tasks were stalled waiting for a CPU over the last 10 seconds.")
+	m.data.SetUnit("%")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSystemCPUPressureAvg10) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUPressureAvg10) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUPressureAvg10) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUPressureAvg10(cfg MetricConfig) metricSystemCPUPressureAvg10 {
+	m := metricSystemCPUPressureAvg10{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCPUPressureAvg300 struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.pressure.avg300 metric with initial data.
+func (m *metricSystemCPUPressureAvg300) init() {
+	m.data.SetName("system.cpu.pressure.avg300")
+	m.data.SetDescription("Percentage of the time some tasks were stalled waiting for a CPU over the last 5 minutes.")
+	m.data.SetUnit("%")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSystemCPUPressureAvg300) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUPressureAvg300) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUPressureAvg300) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUPressureAvg300(cfg MetricConfig) metricSystemCPUPressureAvg300 {
+	m := metricSystemCPUPressureAvg300{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCPUPressureAvg60 struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cpu.pressure.avg60 metric with initial data.
+func (m *metricSystemCPUPressureAvg60) init() {
+	m.data.SetName("system.cpu.pressure.avg60")
+	m.data.SetDescription("Percentage of the time some tasks were stalled waiting for a CPU over the last minute.")
+	m.data.SetUnit("%")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSystemCPUPressureAvg60) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCPUPressureAvg60) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCPUPressureAvg60) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCPUPressureAvg60(cfg MetricConfig) metricSystemCPUPressureAvg60 {
+	m := metricSystemCPUPressureAvg60{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -170,6 +317,9 @@ type MetricsBuilder struct {
 	metricSystemCPULoadAverage15m metricSystemCPULoadAverage15m
 	metricSystemCPULoadAverage1m  metricSystemCPULoadAverage1m
 	metricSystemCPULoadAverage5m  metricSystemCPULoadAverage5m
+	metricSystemCPUPressureAvg10  metricSystemCPUPressureAvg10
+	metricSystemCPUPressureAvg300 metricSystemCPUPressureAvg300
+	metricSystemCPUPressureAvg60  metricSystemCPUPressureAvg60
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -191,6 +341,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSystemCPULoadAverage15m: newMetricSystemCPULoadAverage15m(mbc.Metrics.SystemCPULoadAverage15m),
 		metricSystemCPULoadAverage1m:  newMetricSystemCPULoadAverage1m(mbc.Metrics.SystemCPULoadAverage1m),
 		metricSystemCPULoadAverage5m:  newMetricSystemCPULoadAverage5m(mbc.Metrics.SystemCPULoadAverage5m),
+		metricSystemCPUPressureAvg10:  newMetricSystemCPUPressureAvg10(mbc.Metrics.SystemCPUPressureAvg10),
+		metricSystemCPUPressureAvg300: newMetricSystemCPUPressureAvg300(mbc.Metrics.SystemCPUPressureAvg300),
+		metricSystemCPUPressureAvg60:  newMetricSystemCPUPressureAvg60(mbc.Metrics.SystemCPUPressureAvg60),
 	}
 
 	for _, op := range options {
@@ -252,6 +405,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSystemCPULoadAverage15m.emit(ils.Metrics())
 	mb.metricSystemCPULoadAverage1m.emit(ils.Metrics())
 	mb.metricSystemCPULoadAverage5m.emit(ils.Metrics())
+	mb.metricSystemCPUPressureAvg10.emit(ils.Metrics())
+	mb.metricSystemCPUPressureAvg300.emit(ils.Metrics())
+	mb.metricSystemCPUPressureAvg60.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -288,6 +444,21 @@ func (mb *MetricsBuilder) RecordSystemCPULoadAverage5mDataPoint(ts pcommon.Times
 	mb.metricSystemCPULoadAverage5m.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSystemCPUPressureAvg10DataPoint adds a data point to system.cpu.pressure.avg10 metric.
+func (mb *MetricsBuilder) RecordSystemCPUPressureAvg10DataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSystemCPUPressureAvg10.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemCPUPressureAvg300DataPoint adds a data point to system.cpu.pressure.avg300 metric.
+func (mb *MetricsBuilder) RecordSystemCPUPressureAvg300DataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSystemCPUPressureAvg300.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemCPUPressureAvg60DataPoint adds a data point to system.cpu.pressure.avg60 metric.
+func (mb *MetricsBuilder) RecordSystemCPUPressureAvg60DataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSystemCPUPressureAvg60.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -20,10 +20,16 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/perfcounters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/psi"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper/internal/metadata"
 )
 
-const metricsLen = 3
+const (
+	metricsLen = 3
+
+	sourceLoadAverage = "load_average"
+	sourceCPUPressure = "cpu_pressure"
+)
 
 // scraper for Load Metrics
 type scraper struct {
@@ -35,11 +41,12 @@ type scraper struct {
 	// for mocking
 	bootTime func(context.Context) (uint64, error)
 	load     func(context.Context) (*load.AvgStat, error)
+	pressure func(context.Context, string) ([]psi.Stat, error)
 }
 
 // newLoadScraper creates a set of Load related metrics
 func newLoadScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
-	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, load: getSampledLoadAverages}
+	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, load: getSampledLoadAverages, pressure: psi.Read}
 }
 
 // start
@@ -87,6 +94,21 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	now := pcommon.NewTimestampFromTime(time.Now())
 	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
 
+	if s.config.Source == sourceCPUPressure {
+		stats, err := s.pressure(ctx, "cpu")
+		if err != nil {
+			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+		}
+		for _, stat := range stats {
+			if stat.Stall == psi.StallSome {
+				s.mb.RecordSystemCPUPressureAvg10DataPoint(now, stat.Avg10)
+				s.mb.RecordSystemCPUPressureAvg60DataPoint(now, stat.Avg60)
+				s.mb.RecordSystemCPUPressureAvg300DataPoint(now, stat.Avg300)
+			}
+		}
+		return s.mb.Emit(), nil
+	}
+
 	avgLoadValues, err := s.load(ctx)
 	if err != nil {
 		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/psi"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper/internal/metadata"
 )
 
@@ -163,6 +164,38 @@ func TestScrape(t *testing.T) {
 	}
 }
 
+func TestScrape_CPUPressure(t *testing.T) {
+	scraper := newLoadScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Source:               sourceCPUPressure,
+	})
+	scraper.pressure = func(_ context.Context, resource string) ([]psi.Stat, error) {
+		assert.Equal(t, "cpu", resource)
+		return []psi.Stat{
+			{Stall: psi.StallSome, Avg10: 1.53, Avg60: 0.87, Avg300: 0.3},
+			{Stall: psi.StallFull, Avg10: 0.2, Avg60: 0.1, Avg300: 0.05},
+		}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, scraper.shutdown(context.Background())) }()
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, md.MetricCount())
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assertMetricHasSingleDatapoint(t, metrics.At(0), "system.cpu.pressure.avg10")
+	assertMetricHasSingleDatapoint(t, metrics.At(1), "system.cpu.pressure.avg300")
+	assertMetricHasSingleDatapoint(t, metrics.At(2), "system.cpu.pressure.avg60")
+	assert.Equal(t, 1.53, metrics.At(0).Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, 0.3, metrics.At(1).Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, 0.87, metrics.At(2).Gauge().DataPoints().At(0).DoubleValue())
+
+	scraper.pressure = func(context.Context, string) ([]psi.Stat, error) { return nil, errors.New("err1") }
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
 func assertMetricHasSingleDatapoint(t *testing.T, metric pmetric.Metric, expectedName string) {
 	assert.Equal(t, expectedName, metric.Name())
 	assert.Equal(t, 1, metric.Gauge().DataPoints().Len())
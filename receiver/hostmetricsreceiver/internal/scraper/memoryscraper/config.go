@@ -12,4 +12,20 @@ import (
 type Config struct {
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	internal.ScraperConfig
+
+	// PerNUMANode reports the `system.memory.usage` and `system.memory.utilization` metrics per NUMA node,
+	// with a `numa.node` attribute, instead of for the whole host. The other metrics are still reported
+	// for the whole host. This option is only supported on Linux, and is ignored on hosts without NUMA
+	// information.
+	PerNUMANode bool `mapstructure:"per_numa_node"`
+
+	// UtilizationLimit is the capacity the system.memory.utilization metric is computed against: `host`, the
+	// default, computes it against the memory of the host, and `cgroup` against the memory limit of the cgroup of the
+	// collector, so that a collector running in a container reports the utilization of the container. `cgroup` is
+	// only supported on Linux, and cannot be combined with PerNUMANode.
+	UtilizationLimit string `mapstructure:"utilization_limit"`
+
+	// TopSlabCaches is the number of slab caches reported by the system.linux.memory.slab.usage metric: those that
+	// hold the most memory.
+	TopSlabCaches int `mapstructure:"top_slab_caches"`
 }
@@ -5,6 +5,9 @@ package memoryscraper // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"runtime"
 
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -18,6 +21,9 @@ import (
 const (
 	// TypeStr the value of "type" key in configuration.
 	TypeStr = "memory"
+
+	// defaultTopSlabCaches is the default number of slab caches reported by the system.linux.memory.slab.usage metric.
+	defaultTopSlabCaches = 10
 )
 
 // Factory is the Factory for scraper.
@@ -28,6 +34,7 @@ type Factory struct {
 func (f *Factory) CreateDefaultConfig() internal.Config {
 	return &Config{
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		TopSlabCaches:        defaultTopSlabCaches,
 	}
 }
 
@@ -38,6 +45,21 @@ func (f *Factory) CreateMetricsScraper(
 	config internal.Config,
 ) (scraperhelper.Scraper, error) {
 	cfg := config.(*Config)
+	switch cfg.UtilizationLimit {
+	case "", utilizationLimitHost:
+	case utilizationLimitCgroup:
+		if runtime.GOOS != "linux" {
+			return nil, errors.New("cgroup utilization limit only available on Linux")
+		}
+		if cfg.PerNUMANode {
+			return nil, errors.New("cgroup utilization limit cannot be combined with per_numa_node")
+		}
+	default:
+		return nil, fmt.Errorf("invalid utilization_limit %q, must be host or cgroup", cfg.UtilizationLimit)
+	}
+	if cfg.Metrics.SystemLinuxMemorySlabUsage.Enabled && cfg.TopSlabCaches <= 0 {
+		return nil, fmt.Errorf("invalid top_slab_caches %d, must be positive", cfg.TopSlabCaches)
+	}
 	s := newMemoryScraper(ctx, settings, cfg)
 
 	return scraperhelper.NewScraper(
@@ -5,6 +5,7 @@ package memoryscraper
 
 import (
 	"context"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,3 +27,35 @@ func TestCreateMetricsScraper(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, scraper)
 }
+
+func TestCreateMetricsScraper_UtilizationLimit(t *testing.T) {
+	factory := &Factory{}
+
+	_, err := factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{UtilizationLimit: "host", PerNUMANode: true})
+	assert.NoError(t, err)
+
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{UtilizationLimit: "cgroup"})
+	if runtime.GOOS == "linux" {
+		assert.NoError(t, err)
+		_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{UtilizationLimit: "cgroup", PerNUMANode: true})
+		assert.EqualError(t, err, "cgroup utilization limit cannot be combined with per_numa_node")
+	} else {
+		assert.EqualError(t, err, "cgroup utilization limit only available on Linux")
+	}
+
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{UtilizationLimit: "container"})
+	assert.EqualError(t, err, `invalid utilization_limit "container", must be host or cgroup`)
+}
+
+func TestCreateMetricsScraper_TopSlabCaches(t *testing.T) {
+	factory := &Factory{}
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Metrics.SystemLinuxMemorySlabUsage.Enabled = true
+
+	_, err := factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	assert.NoError(t, err)
+
+	cfg.TopSlabCaches = 0
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	assert.EqualError(t, err, "invalid top_slab_caches 0, must be positive")
+}
@@ -27,10 +27,17 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for hostmetricsreceiver/memory metrics.
 type MetricsConfig struct {
-	SystemLinuxMemoryAvailable MetricConfig `mapstructure:"system.linux.memory.available"`
-	SystemMemoryLimit          MetricConfig `mapstructure:"system.memory.limit"`
-	SystemMemoryUsage          MetricConfig `mapstructure:"system.memory.usage"`
-	SystemMemoryUtilization    MetricConfig `mapstructure:"system.memory.utilization"`
+	SystemLinuxMemoryAvailable                 MetricConfig `mapstructure:"system.linux.memory.available"`
+	SystemLinuxMemoryHugepagesLimit            MetricConfig `mapstructure:"system.linux.memory.hugepages.limit"`
+	SystemLinuxMemoryHugepagesPageSize         MetricConfig `mapstructure:"system.linux.memory.hugepages.page_size"`
+	SystemLinuxMemoryHugepagesReserved         MetricConfig `mapstructure:"system.linux.memory.hugepages.reserved"`
+	SystemLinuxMemoryHugepagesSurplus          MetricConfig `mapstructure:"system.linux.memory.hugepages.surplus"`
+	SystemLinuxMemoryHugepagesUsage            MetricConfig `mapstructure:"system.linux.memory.hugepages.usage"`
+	SystemLinuxMemorySlabUsage                 MetricConfig `mapstructure:"system.linux.memory.slab.usage"`
+	SystemLinuxMemoryTransparentHugepagesUsage MetricConfig `mapstructure:"system.linux.memory.transparent_hugepages.usage"`
+	SystemMemoryLimit                          MetricConfig `mapstructure:"system.memory.limit"`
+	SystemMemoryUsage                          MetricConfig `mapstructure:"system.memory.usage"`
+	SystemMemoryUtilization                    MetricConfig `mapstructure:"system.memory.utilization"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -38,6 +45,27 @@ func DefaultMetricsConfig() MetricsConfig {
 		SystemLinuxMemoryAvailable: MetricConfig{
 			Enabled: false,
 		},
+		SystemLinuxMemoryHugepagesLimit: MetricConfig{
+			Enabled: false,
+		},
+		SystemLinuxMemoryHugepagesPageSize: MetricConfig{
+			Enabled: false,
+		},
+		SystemLinuxMemoryHugepagesReserved: MetricConfig{
+			Enabled: false,
+		},
+		SystemLinuxMemoryHugepagesSurplus: MetricConfig{
+			Enabled: false,
+		},
+		SystemLinuxMemoryHugepagesUsage: MetricConfig{
+			Enabled: false,
+		},
+		SystemLinuxMemorySlabUsage: MetricConfig{
+			Enabled: false,
+		},
+		SystemLinuxMemoryTransparentHugepagesUsage: MetricConfig{
+			Enabled: false,
+		},
 		SystemMemoryLimit: MetricConfig{
 			Enabled: false,
 		},
@@ -26,10 +26,17 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemLinuxMemoryAvailable: MetricConfig{Enabled: true},
-					SystemMemoryLimit:          MetricConfig{Enabled: true},
-					SystemMemoryUsage:          MetricConfig{Enabled: true},
-					SystemMemoryUtilization:    MetricConfig{Enabled: true},
+					SystemLinuxMemoryAvailable:                 MetricConfig{Enabled: true},
+					SystemLinuxMemoryHugepagesLimit:            MetricConfig{Enabled: true},
+					SystemLinuxMemoryHugepagesPageSize:         MetricConfig{Enabled: true},
+					SystemLinuxMemoryHugepagesReserved:         MetricConfig{Enabled: true},
+					SystemLinuxMemoryHugepagesSurplus:          MetricConfig{Enabled: true},
+					SystemLinuxMemoryHugepagesUsage:            MetricConfig{Enabled: true},
+					SystemLinuxMemorySlabUsage:                 MetricConfig{Enabled: true},
+					SystemLinuxMemoryTransparentHugepagesUsage: MetricConfig{Enabled: true},
+					SystemMemoryLimit:                          MetricConfig{Enabled: true},
+					SystemMemoryUsage:                          MetricConfig{Enabled: true},
+					SystemMemoryUtilization:                    MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -37,10 +44,17 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemLinuxMemoryAvailable: MetricConfig{Enabled: false},
-					SystemMemoryLimit:          MetricConfig{Enabled: false},
-					SystemMemoryUsage:          MetricConfig{Enabled: false},
-					SystemMemoryUtilization:    MetricConfig{Enabled: false},
+					SystemLinuxMemoryAvailable:                 MetricConfig{Enabled: false},
+					SystemLinuxMemoryHugepagesLimit:            MetricConfig{Enabled: false},
+					SystemLinuxMemoryHugepagesPageSize:         MetricConfig{Enabled: false},
+					SystemLinuxMemoryHugepagesReserved:         MetricConfig{Enabled: false},
+					SystemLinuxMemoryHugepagesSurplus:          MetricConfig{Enabled: false},
+					SystemLinuxMemoryHugepagesUsage:            MetricConfig{Enabled: false},
+					SystemLinuxMemorySlabUsage:                 MetricConfig{Enabled: false},
+					SystemLinuxMemoryTransparentHugepagesUsage: MetricConfig{Enabled: false},
+					SystemMemoryLimit:                          MetricConfig{Enabled: false},
+					SystemMemoryUsage:                          MetricConfig{Enabled: false},
+					SystemMemoryUtilization:                    MetricConfig{Enabled: false},
 				},
 			},
 		},
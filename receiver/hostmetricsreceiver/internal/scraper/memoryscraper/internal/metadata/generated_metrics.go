@@ -109,6 +109,367 @@ func newMetricSystemLinuxMemoryAvailable(cfg MetricConfig) metricSystemLinuxMemo
 	return m
 }
 
+type metricSystemLinuxMemoryHugepagesLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.linux.memory.hugepages.limit metric with initial data.
+func (m *metricSystemLinuxMemoryHugepagesLimit) init() {
+	m.data.SetName("system.linux.memory.hugepages.limit")
+	m.data.SetDescription("Total number of huge pages in the pool. (Linux only)")
+	m.data.SetUnit("{pages}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemLinuxMemoryHugepagesLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemLinuxMemoryHugepagesLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemLinuxMemoryHugepagesLimit) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemLinuxMemoryHugepagesLimit(cfg MetricConfig) metricSystemLinuxMemoryHugepagesLimit {
+	m := metricSystemLinuxMemoryHugepagesLimit{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemLinuxMemoryHugepagesPageSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.linux.memory.hugepages.page_size metric with initial data.
+func (m *metricSystemLinuxMemoryHugepagesPageSize) init() {
+	m.data.SetName("system.linux.memory.hugepages.page_size")
+	m.data.SetDescription("Size of the huge pages. (Linux only)")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemLinuxMemoryHugepagesPageSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemLinuxMemoryHugepagesPageSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemLinuxMemoryHugepagesPageSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemLinuxMemoryHugepagesPageSize(cfg MetricConfig) metricSystemLinuxMemoryHugepagesPageSize {
+	m := metricSystemLinuxMemoryHugepagesPageSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemLinuxMemoryHugepagesReserved struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.linux.memory.hugepages.reserved metric with initial data.
+func (m *metricSystemLinuxMemoryHugepagesReserved) init() {
+	m.data.SetName("system.linux.memory.hugepages.reserved")
+	m.data.SetDescription("Number of huge pages reserved for an allocation that has not been faulted in yet. (Linux only)")
+	m.data.SetUnit("{pages}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemLinuxMemoryHugepagesReserved) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemLinuxMemoryHugepagesReserved) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemLinuxMemoryHugepagesReserved) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemLinuxMemoryHugepagesReserved(cfg MetricConfig) metricSystemLinuxMemoryHugepagesReserved {
+	m := metricSystemLinuxMemoryHugepagesReserved{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemLinuxMemoryHugepagesSurplus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.linux.memory.hugepages.surplus metric with initial data.
+func (m *metricSystemLinuxMemoryHugepagesSurplus) init() {
+	m.data.SetName("system.linux.memory.hugepages.surplus")
+	m.data.SetDescription("Number of huge pages allocated above the size of the pool, up to the overcommit limit. (Linux only)")
+	m.data.SetUnit("{pages}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemLinuxMemoryHugepagesSurplus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemLinuxMemoryHugepagesSurplus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemLinuxMemoryHugepagesSurplus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemLinuxMemoryHugepagesSurplus(cfg MetricConfig) metricSystemLinuxMemoryHugepagesSurplus {
+	m := metricSystemLinuxMemoryHugepagesSurplus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemLinuxMemoryHugepagesUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.linux.memory.hugepages.usage metric with initial data.
+func (m *metricSystemLinuxMemoryHugepagesUsage) init() {
+	m.data.SetName("system.linux.memory.hugepages.usage")
+	m.data.SetDescription("Number of huge pages of the pool in use. (Linux only)")
+	m.data.SetUnit("{pages}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemLinuxMemoryHugepagesUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, stateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", stateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemLinuxMemoryHugepagesUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemLinuxMemoryHugepagesUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemLinuxMemoryHugepagesUsage(cfg MetricConfig) metricSystemLinuxMemoryHugepagesUsage {
+	m := metricSystemLinuxMemoryHugepagesUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemLinuxMemorySlabUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.linux.memory.slab.usage metric with initial data.
+func (m *metricSystemLinuxMemorySlabUsage) init() {
+	m.data.SetName("system.linux.memory.slab.usage")
+	m.data.SetDescription("Bytes of memory held by the kernel slab caches that hold the most memory. (Linux only)")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemLinuxMemorySlabUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, slabCacheAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("slab.cache", slabCacheAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemLinuxMemorySlabUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemLinuxMemorySlabUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemLinuxMemorySlabUsage(cfg MetricConfig) metricSystemLinuxMemorySlabUsage {
+	m := metricSystemLinuxMemorySlabUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemLinuxMemoryTransparentHugepagesUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.linux.memory.transparent_hugepages.usage metric with initial data.
+func (m *metricSystemLinuxMemoryTransparentHugepagesUsage) init() {
+	m.data.SetName("system.linux.memory.transparent_hugepages.usage")
+	m.data.SetDescription("Bytes of anonymous memory backed by transparent huge pages. (Linux only)")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemLinuxMemoryTransparentHugepagesUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemLinuxMemoryTransparentHugepagesUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemLinuxMemoryTransparentHugepagesUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemLinuxMemoryTransparentHugepagesUsage(cfg MetricConfig) metricSystemLinuxMemoryTransparentHugepagesUsage {
+	m := metricSystemLinuxMemoryTransparentHugepagesUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSystemMemoryLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -267,15 +628,22 @@ func newMetricSystemMemoryUtilization(cfg MetricConfig) metricSystemMemoryUtiliz
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                           MetricsBuilderConfig // config of the metrics builder.
-	startTime                        pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                  int                  // maximum observed number of metrics per resource.
-	metricsBuffer                    pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                        component.BuildInfo  // contains version information.
-	metricSystemLinuxMemoryAvailable metricSystemLinuxMemoryAvailable
-	metricSystemMemoryLimit          metricSystemMemoryLimit
-	metricSystemMemoryUsage          metricSystemMemoryUsage
-	metricSystemMemoryUtilization    metricSystemMemoryUtilization
+	config                                           MetricsBuilderConfig // config of the metrics builder.
+	startTime                                        pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                                  int                  // maximum observed number of metrics per resource.
+	metricsBuffer                                    pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                        component.BuildInfo  // contains version information.
+	metricSystemLinuxMemoryAvailable                 metricSystemLinuxMemoryAvailable
+	metricSystemLinuxMemoryHugepagesLimit            metricSystemLinuxMemoryHugepagesLimit
+	metricSystemLinuxMemoryHugepagesPageSize         metricSystemLinuxMemoryHugepagesPageSize
+	metricSystemLinuxMemoryHugepagesReserved         metricSystemLinuxMemoryHugepagesReserved
+	metricSystemLinuxMemoryHugepagesSurplus          metricSystemLinuxMemoryHugepagesSurplus
+	metricSystemLinuxMemoryHugepagesUsage            metricSystemLinuxMemoryHugepagesUsage
+	metricSystemLinuxMemorySlabUsage                 metricSystemLinuxMemorySlabUsage
+	metricSystemLinuxMemoryTransparentHugepagesUsage metricSystemLinuxMemoryTransparentHugepagesUsage
+	metricSystemMemoryLimit                          metricSystemMemoryLimit
+	metricSystemMemoryUsage                          metricSystemMemoryUsage
+	metricSystemMemoryUtilization                    metricSystemMemoryUtilization
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -290,14 +658,21 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                           mbc,
-		startTime:                        pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                    pmetric.NewMetrics(),
-		buildInfo:                        settings.BuildInfo,
-		metricSystemLinuxMemoryAvailable: newMetricSystemLinuxMemoryAvailable(mbc.Metrics.SystemLinuxMemoryAvailable),
-		metricSystemMemoryLimit:          newMetricSystemMemoryLimit(mbc.Metrics.SystemMemoryLimit),
-		metricSystemMemoryUsage:          newMetricSystemMemoryUsage(mbc.Metrics.SystemMemoryUsage),
-		metricSystemMemoryUtilization:    newMetricSystemMemoryUtilization(mbc.Metrics.SystemMemoryUtilization),
+		config:                                           mbc,
+		startTime:                                        pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                                    pmetric.NewMetrics(),
+		buildInfo:                                        settings.BuildInfo,
+		metricSystemLinuxMemoryAvailable:                 newMetricSystemLinuxMemoryAvailable(mbc.Metrics.SystemLinuxMemoryAvailable),
+		metricSystemLinuxMemoryHugepagesLimit:            newMetricSystemLinuxMemoryHugepagesLimit(mbc.Metrics.SystemLinuxMemoryHugepagesLimit),
+		metricSystemLinuxMemoryHugepagesPageSize:         newMetricSystemLinuxMemoryHugepagesPageSize(mbc.Metrics.SystemLinuxMemoryHugepagesPageSize),
+		metricSystemLinuxMemoryHugepagesReserved:         newMetricSystemLinuxMemoryHugepagesReserved(mbc.Metrics.SystemLinuxMemoryHugepagesReserved),
+		metricSystemLinuxMemoryHugepagesSurplus:          newMetricSystemLinuxMemoryHugepagesSurplus(mbc.Metrics.SystemLinuxMemoryHugepagesSurplus),
+		metricSystemLinuxMemoryHugepagesUsage:            newMetricSystemLinuxMemoryHugepagesUsage(mbc.Metrics.SystemLinuxMemoryHugepagesUsage),
+		metricSystemLinuxMemorySlabUsage:                 newMetricSystemLinuxMemorySlabUsage(mbc.Metrics.SystemLinuxMemorySlabUsage),
+		metricSystemLinuxMemoryTransparentHugepagesUsage: newMetricSystemLinuxMemoryTransparentHugepagesUsage(mbc.Metrics.SystemLinuxMemoryTransparentHugepagesUsage),
+		metricSystemMemoryLimit:                          newMetricSystemMemoryLimit(mbc.Metrics.SystemMemoryLimit),
+		metricSystemMemoryUsage:                          newMetricSystemMemoryUsage(mbc.Metrics.SystemMemoryUsage),
+		metricSystemMemoryUtilization:                    newMetricSystemMemoryUtilization(mbc.Metrics.SystemMemoryUtilization),
 	}
 
 	for _, op := range options {
@@ -357,6 +732,13 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricSystemLinuxMemoryAvailable.emit(ils.Metrics())
+	mb.metricSystemLinuxMemoryHugepagesLimit.emit(ils.Metrics())
+	mb.metricSystemLinuxMemoryHugepagesPageSize.emit(ils.Metrics())
+	mb.metricSystemLinuxMemoryHugepagesReserved.emit(ils.Metrics())
+	mb.metricSystemLinuxMemoryHugepagesSurplus.emit(ils.Metrics())
+	mb.metricSystemLinuxMemoryHugepagesUsage.emit(ils.Metrics())
+	mb.metricSystemLinuxMemorySlabUsage.emit(ils.Metrics())
+	mb.metricSystemLinuxMemoryTransparentHugepagesUsage.emit(ils.Metrics())
 	mb.metricSystemMemoryLimit.emit(ils.Metrics())
 	mb.metricSystemMemoryUsage.emit(ils.Metrics())
 	mb.metricSystemMemoryUtilization.emit(ils.Metrics())
@@ -386,6 +768,41 @@ func (mb *MetricsBuilder) RecordSystemLinuxMemoryAvailableDataPoint(ts pcommon.T
 	mb.metricSystemLinuxMemoryAvailable.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSystemLinuxMemoryHugepagesLimitDataPoint adds a data point to system.linux.memory.hugepages.limit metric.
+func (mb *MetricsBuilder) RecordSystemLinuxMemoryHugepagesLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemLinuxMemoryHugepagesLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemLinuxMemoryHugepagesPageSizeDataPoint adds a data point to system.linux.memory.hugepages.page_size metric.
+func (mb *MetricsBuilder) RecordSystemLinuxMemoryHugepagesPageSizeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemLinuxMemoryHugepagesPageSize.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemLinuxMemoryHugepagesReservedDataPoint adds a data point to system.linux.memory.hugepages.reserved metric.
+func (mb *MetricsBuilder) RecordSystemLinuxMemoryHugepagesReservedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemLinuxMemoryHugepagesReserved.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemLinuxMemoryHugepagesSurplusDataPoint adds a data point to system.linux.memory.hugepages.surplus metric.
+func (mb *MetricsBuilder) RecordSystemLinuxMemoryHugepagesSurplusDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemLinuxMemoryHugepagesSurplus.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemLinuxMemoryHugepagesUsageDataPoint adds a data point to system.linux.memory.hugepages.usage metric.
+func (mb *MetricsBuilder) RecordSystemLinuxMemoryHugepagesUsageDataPoint(ts pcommon.Timestamp, val int64, stateAttributeValue AttributeState) {
+	mb.metricSystemLinuxMemoryHugepagesUsage.recordDataPoint(mb.startTime, ts, val, stateAttributeValue.String())
+}
+
+// RecordSystemLinuxMemorySlabUsageDataPoint adds a data point to system.linux.memory.slab.usage metric.
+func (mb *MetricsBuilder) RecordSystemLinuxMemorySlabUsageDataPoint(ts pcommon.Timestamp, val int64, slabCacheAttributeValue string) {
+	mb.metricSystemLinuxMemorySlabUsage.recordDataPoint(mb.startTime, ts, val, slabCacheAttributeValue)
+}
+
+// RecordSystemLinuxMemoryTransparentHugepagesUsageDataPoint adds a data point to system.linux.memory.transparent_hugepages.usage metric.
+func (mb *MetricsBuilder) RecordSystemLinuxMemoryTransparentHugepagesUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemLinuxMemoryTransparentHugepagesUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordSystemMemoryLimitDataPoint adds a data point to system.memory.limit metric.
 func (mb *MetricsBuilder) RecordSystemMemoryLimitDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricSystemMemoryLimit.recordDataPoint(mb.startTime, ts, val)
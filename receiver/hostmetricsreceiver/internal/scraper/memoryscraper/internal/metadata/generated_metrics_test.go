@@ -62,6 +62,27 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSystemLinuxMemoryAvailableDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordSystemLinuxMemoryHugepagesLimitDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSystemLinuxMemoryHugepagesPageSizeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSystemLinuxMemoryHugepagesReservedDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSystemLinuxMemoryHugepagesSurplusDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSystemLinuxMemoryHugepagesUsageDataPoint(ts, 1, AttributeStateBuffered)
+
+			allMetricsCount++
+			mb.RecordSystemLinuxMemorySlabUsageDataPoint(ts, 1, "slab.cache-val")
+
+			allMetricsCount++
+			mb.RecordSystemLinuxMemoryTransparentHugepagesUsageDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordSystemMemoryLimitDataPoint(ts, 1)
 
@@ -108,6 +129,110 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "system.linux.memory.hugepages.limit":
+					assert.False(t, validatedMetrics["system.linux.memory.hugepages.limit"], "Found a duplicate in the metrics slice: system.linux.memory.hugepages.limit")
+					validatedMetrics["system.linux.memory.hugepages.limit"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total number of huge pages in the pool. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "{pages}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "system.linux.memory.hugepages.page_size":
+					assert.False(t, validatedMetrics["system.linux.memory.hugepages.page_size"], "Found a duplicate in the metrics slice: system.linux.memory.hugepages.page_size")
+					validatedMetrics["system.linux.memory.hugepages.page_size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Size of the huge pages. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "system.linux.memory.hugepages.reserved":
+					assert.False(t, validatedMetrics["system.linux.memory.hugepages.reserved"], "Found a duplicate in the metrics slice: system.linux.memory.hugepages.reserved")
+					validatedMetrics["system.linux.memory.hugepages.reserved"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of huge pages reserved for an allocation that has not been faulted in yet. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "{pages}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "system.linux.memory.hugepages.surplus":
+					assert.False(t, validatedMetrics["system.linux.memory.hugepages.surplus"], "Found a duplicate in the metrics slice: system.linux.memory.hugepages.surplus")
+					validatedMetrics["system.linux.memory.hugepages.surplus"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of huge pages allocated above the size of the pool, up to the overcommit limit. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "{pages}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "system.linux.memory.hugepages.usage":
+					assert.False(t, validatedMetrics["system.linux.memory.hugepages.usage"], "Found a duplicate in the metrics slice: system.linux.memory.hugepages.usage")
+					validatedMetrics["system.linux.memory.hugepages.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of huge pages of the pool in use. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "{pages}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "buffered", attrVal.Str())
+				case "system.linux.memory.slab.usage":
+					assert.False(t, validatedMetrics["system.linux.memory.slab.usage"], "Found a duplicate in the metrics slice: system.linux.memory.slab.usage")
+					validatedMetrics["system.linux.memory.slab.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes of memory held by the kernel slab caches that hold the most memory. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("slab.cache")
+					assert.True(t, ok)
+					assert.EqualValues(t, "slab.cache-val", attrVal.Str())
+				case "system.linux.memory.transparent_hugepages.usage":
+					assert.False(t, validatedMetrics["system.linux.memory.transparent_hugepages.usage"], "Found a duplicate in the metrics slice: system.linux.memory.transparent_hugepages.usage")
+					validatedMetrics["system.linux.memory.transparent_hugepages.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes of anonymous memory backed by transparent huge pages. (Linux only)", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "system.memory.limit":
 					assert.False(t, validatedMetrics["system.memory.limit"], "Found a duplicate in the metrics slice: system.memory.limit")
 					validatedMetrics["system.memory.limit"] = true
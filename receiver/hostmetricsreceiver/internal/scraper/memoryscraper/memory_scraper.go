@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/common"
@@ -18,10 +20,18 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper/internal/metadata"
 )
 
-const metricsLen = 2
+const (
+	metricsLen = 2
+
+	numaNodeAttribute = "numa.node"
+
+	utilizationLimitHost   = "host"
+	utilizationLimitCgroup = "cgroup"
+)
 
 var ErrInvalidTotalMem = errors.New("invalid total memory")
 
@@ -35,11 +45,26 @@ type scraper struct {
 	// for mocking gopsutil mem.VirtualMemory
 	bootTime      func(context.Context) (uint64, error)
 	virtualMemory func(context.Context) (*mem.VirtualMemoryStat, error)
+	numaNodes     func(context.Context) ([]numaNode, error)
+	cgroupMemory  func() (*cgroup.Memory, error)
+	slabCaches    func(context.Context) ([]slabCache, error)
+}
+
+// numaNode holds the memory statistics of a NUMA node.
+type numaNode struct {
+	id      string
+	memInfo *mem.VirtualMemoryStat
+}
+
+// slabCache holds the memory held by a kernel slab cache.
+type slabCache struct {
+	name string
+	size int64
 }
 
 // newMemoryScraper creates a Memory Scraper
 func newMemoryScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
-	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, virtualMemory: mem.VirtualMemoryWithContext}
+	return &scraper{settings: settings, config: cfg, envMap: cfg.EnvMap, bootTime: host.BootTimeWithContext, virtualMemory: mem.VirtualMemoryWithContext, numaNodes: readNUMANodes, cgroupMemory: cgroup.NewReader().ReadMemory, slabCaches: readSlabCaches}
 }
 
 func (s *scraper) start(ctx context.Context, _ component.Host) error {
@@ -66,16 +91,151 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
 
+	var nodes []numaNode
+	if s.config.PerNUMANode {
+		nodes, err = s.numaNodes(ctx)
+		if err != nil {
+			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+		}
+	}
+
 	if memInfo != nil {
-		s.recordMemoryUsageMetric(now, memInfo)
+		if len(nodes) == 0 {
+			s.recordMemoryUsageMetric(now, memInfo)
+		}
 		if memInfo.Total <= 0 {
 			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(fmt.Errorf("%w: %d", ErrInvalidTotalMem,
 				memInfo.Total), metricsLen)
 		}
-		s.recordMemoryUtilizationMetric(now, memInfo)
+		if s.config.UtilizationLimit == utilizationLimitCgroup {
+			if s.config.MetricsBuilderConfig.Metrics.SystemMemoryUtilization.Enabled {
+				if err = s.recordCgroupMemoryUtilizationMetric(now, memInfo); err != nil {
+					return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+				}
+			}
+		} else if len(nodes) == 0 {
+			s.recordMemoryUtilizationMetric(now, memInfo)
+		}
 		s.recordMemoryLimitMetric(now, memInfo)
 		s.recordSystemSpecificMetrics(now, memInfo)
 	}
 
-	return s.mb.Emit(), nil
+	var errs scrapererror.ScrapeErrors
+	if s.config.MetricsBuilderConfig.Metrics.SystemLinuxMemorySlabUsage.Enabled {
+		if err = s.recordSlabUsageMetric(ctx, now); err != nil {
+			errs.AddPartial(1, err)
+		}
+	}
+
+	md := s.mb.Emit()
+	for _, node := range nodes {
+		s.recordMemoryUsageMetric(now, node.memInfo)
+		// nodes without memory, such as the nodes of CPU-only sockets, have no utilization
+		if node.memInfo.Total > 0 {
+			s.recordMemoryUtilizationMetric(now, node.memInfo)
+		}
+		nodeMD := s.mb.Emit()
+		setNUMANode(nodeMD, node.id)
+		mergeMetrics(md, nodeMD)
+	}
+	return md, errs.Combine()
+}
+
+// recordSlabUsageMetric records the memory held by the top_slab_caches slab caches that hold the most memory.
+func (s *scraper) recordSlabUsageMetric(ctx context.Context, now pcommon.Timestamp) error {
+	caches, err := s.slabCaches(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read slab caches: %w", err)
+	}
+	sort.Slice(caches, func(i, j int) bool {
+		if caches[i].size != caches[j].size {
+			return caches[i].size > caches[j].size
+		}
+		return caches[i].name < caches[j].name
+	})
+	if len(caches) > s.config.TopSlabCaches {
+		caches = caches[:s.config.TopSlabCaches]
+	}
+	for _, cache := range caches {
+		s.mb.RecordSystemLinuxMemorySlabUsageDataPoint(now, cache.size, cache.name)
+	}
+	return nil
+}
+
+// recordCgroupMemoryUtilizationMetric records the utilization of the cgroup of the collector against its memory limit,
+// or against the memory of the host if it has none.
+func (s *scraper) recordCgroupMemoryUtilizationMetric(now pcommon.Timestamp, memInfo *mem.VirtualMemoryStat) error {
+	cgroupMemory, err := s.cgroupMemory()
+	if err != nil {
+		return fmt.Errorf("failed to read the memory of the cgroup: %w", err)
+	}
+	limit := memInfo.Total
+	if cgroupMemory.Limit > 0 && cgroupMemory.Limit < limit {
+		limit = cgroupMemory.Limit
+	}
+	used := math.Min(float64(cgroupMemory.Usage)/float64(limit), 1)
+	s.mb.RecordSystemMemoryUtilizationDataPoint(now, used, metadata.AttributeStateUsed)
+	s.mb.RecordSystemMemoryUtilizationDataPoint(now, 1-used, metadata.AttributeStateFree)
+	return nil
+}
+
+// setNUMANode sets the `numa.node` attribute of every data point of md.
+func setNUMANode(md pmetric.Metrics, id string) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				var dps pmetric.NumberDataPointSlice
+				switch metrics.At(k).Type() {
+				case pmetric.MetricTypeGauge:
+					dps = metrics.At(k).Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					dps = metrics.At(k).Sum().DataPoints()
+				default:
+					continue
+				}
+				for l := 0; l < dps.Len(); l++ {
+					dps.At(l).Attributes().PutStr(numaNodeAttribute, id)
+				}
+			}
+		}
+	}
+}
+
+// mergeMetrics moves the data points of the metrics of src to the metrics of the same name of dst,
+// which are appended to dst when missing. Both must hold a single resource and scope, as emitted
+// by the metrics builder.
+func mergeMetrics(dst, src pmetric.Metrics) {
+	if src.ResourceMetrics().Len() == 0 {
+		return
+	}
+	if dst.ResourceMetrics().Len() == 0 {
+		src.ResourceMetrics().MoveAndAppendTo(dst.ResourceMetrics())
+		return
+	}
+	dstMetrics := dst.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	srcMetrics := src.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < srcMetrics.Len(); i++ {
+		srcMetric := srcMetrics.At(i)
+		merged := false
+		for j := 0; j < dstMetrics.Len() && !merged; j++ {
+			dstMetric := dstMetrics.At(j)
+			if dstMetric.Name() != srcMetric.Name() || dstMetric.Type() != srcMetric.Type() {
+				continue
+			}
+			switch srcMetric.Type() {
+			case pmetric.MetricTypeGauge:
+				srcMetric.Gauge().DataPoints().MoveAndAppendTo(dstMetric.Gauge().DataPoints())
+				merged = true
+			case pmetric.MetricTypeSum:
+				srcMetric.Sum().DataPoints().MoveAndAppendTo(dstMetric.Sum().DataPoints())
+				merged = true
+			}
+		}
+		if !merged {
+			srcMetric.CopyTo(dstMetrics.AppendEmpty())
+		}
+	}
 }
@@ -6,9 +6,19 @@
 package memoryscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 
 import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
 	"github.com/shirou/gopsutil/v3/mem"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper/internal/metadata"
 )
 
@@ -34,6 +44,124 @@ func (s *scraper) recordLinuxMemoryAvailableMetric(now pcommon.Timestamp, memInf
 	s.mb.RecordSystemLinuxMemoryAvailableDataPoint(now, int64(memInfo.Available))
 }
 
+func (s *scraper) recordLinuxHugePagesMetrics(now pcommon.Timestamp, memInfo *mem.VirtualMemoryStat) {
+	s.mb.RecordSystemLinuxMemoryHugepagesLimitDataPoint(now, int64(memInfo.HugePagesTotal))
+	s.mb.RecordSystemLinuxMemoryHugepagesPageSizeDataPoint(now, int64(memInfo.HugePageSize))
+	s.mb.RecordSystemLinuxMemoryHugepagesReservedDataPoint(now, int64(memInfo.HugePagesRsvd))
+	s.mb.RecordSystemLinuxMemoryHugepagesSurplusDataPoint(now, int64(memInfo.HugePagesSurp))
+	s.mb.RecordSystemLinuxMemoryHugepagesUsageDataPoint(now, int64(memInfo.HugePagesTotal-memInfo.HugePagesFree), metadata.AttributeStateUsed)
+	s.mb.RecordSystemLinuxMemoryHugepagesUsageDataPoint(now, int64(memInfo.HugePagesFree), metadata.AttributeStateFree)
+	s.mb.RecordSystemLinuxMemoryTransparentHugepagesUsageDataPoint(now, int64(memInfo.AnonHugePages))
+}
+
 func (s *scraper) recordSystemSpecificMetrics(now pcommon.Timestamp, memInfo *mem.VirtualMemoryStat) {
 	s.recordLinuxMemoryAvailableMetric(now, memInfo)
+	s.recordLinuxHugePagesMetrics(now, memInfo)
+}
+
+// readSlabCaches reads the memory held by each slab cache from /proc/slabinfo, which is only readable by root.
+func readSlabCaches(ctx context.Context) ([]slabCache, error) {
+	f, err := os.Open(filepath.Join(internal.ProcPath(ctx), "slabinfo"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseSlabInfo(f, int64(os.Getpagesize()))
+}
+
+// parseSlabInfo parses the version 2.x of the slabinfo file, whose lines look like
+// `<name> <active_objs> <num_objs> <objsize> <objperslab> <pagesperslab> : tunables <limit> <batchcount>
+// <sharedfactor> : slabdata <active_slabs> <num_slabs> <sharedavail>`. The memory held by a cache is that of its
+// slabs, including the free objects they hold.
+func parseSlabInfo(r io.Reader, pageSize int64) ([]slabCache, error) {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("empty slabinfo: %w", scanner.Err())
+	}
+	if version := scanner.Text(); !strings.HasPrefix(version, "slabinfo - version: 2.") {
+		return nil, fmt.Errorf("unsupported slabinfo %q", version)
+	}
+
+	var caches []slabCache
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 16 || fields[12] != "slabdata" {
+			return nil, fmt.Errorf("unexpected slabinfo line %q", line)
+		}
+		pagesPerSlab, err := strconv.ParseInt(fields[5], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pages per slab in slabinfo line %q: %w", line, err)
+		}
+		slabs, err := strconv.ParseInt(fields[14], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number of slabs in slabinfo line %q: %w", line, err)
+		}
+		caches = append(caches, slabCache{name: fields[0], size: slabs * pagesPerSlab * pageSize})
+	}
+	return caches, scanner.Err()
+}
+
+// readNUMANodes reads the memory statistics of each NUMA node from its sysfs meminfo file. The kernel
+// accounts buffers as part of the page cache of each node, so they are included in Cached, and Buffers is
+// always zero. Nothing is returned if the kernel exposes no NUMA information.
+func readNUMANodes(ctx context.Context) ([]numaNode, error) {
+	nodePaths, err := filepath.Glob(filepath.Join(internal.SysPath(ctx), "devices", "system", "node", "node[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]numaNode, 0, len(nodePaths))
+	for _, nodePath := range nodePaths {
+		memInfo, err := readNodeMemInfo(filepath.Join(nodePath, "meminfo"))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, numaNode{id: strings.TrimPrefix(filepath.Base(nodePath), "node"), memInfo: memInfo})
+	}
+	return nodes, nil
+}
+
+// readNodeMemInfo parses the meminfo file of a NUMA node, whose lines look like `Node 0 MemFree: 1024 kB`.
+func readNodeMemInfo(path string) (*mem.VirtualMemoryStat, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := make(map[string]uint64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in %s: %w", path, err)
+		}
+		if len(fields) > 4 && fields[4] == "kB" {
+			value *= 1024
+		}
+		values[strings.TrimSuffix(fields[2], ":")] = value
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	memInfo := &mem.VirtualMemoryStat{
+		Total:        values["MemTotal"],
+		Free:         values["MemFree"],
+		Cached:       values["FilePages"] + values["SReclaimable"],
+		Sreclaimable: values["SReclaimable"],
+		Sunreclaim:   values["SUnreclaim"],
+	}
+	// computed as gopsutil does for the whole host
+	if memInfo.Free+memInfo.Cached <= memInfo.Total {
+		memInfo.Used = memInfo.Total - memInfo.Free - memInfo.Cached
+	}
+	return memInfo, nil
 }
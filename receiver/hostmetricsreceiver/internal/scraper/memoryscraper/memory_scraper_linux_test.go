@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package memoryscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadNUMANodes(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+
+	// no NUMA information
+	nodes, err := readNUMANodes(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+
+	writeMemInfo := func(node, memInfo string) {
+		path := filepath.Join(sysPath, "devices", "system", "node", node)
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "meminfo"), []byte(memInfo), 0o600))
+	}
+	writeMemInfo("node0", `Node 0 MemTotal:       16384 kB
+Node 0 MemFree:         4096 kB
+Node 0 MemUsed:        12288 kB
+Node 0 FilePages:       2048 kB
+Node 0 Slab:            1536 kB
+Node 0 SReclaimable:    1024 kB
+Node 0 SUnreclaim:       512 kB
+Node 0 HugePages_Total:     0
+`)
+	writeMemInfo("node1", `Node 1 MemTotal:           0 kB
+Node 1 MemFree:            0 kB
+`)
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "devices", "system", "node", "power"), 0o755))
+
+	nodes, err = readNUMANodes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []numaNode{
+		{id: "0", memInfo: &mem.VirtualMemoryStat{
+			Total:        16384 * 1024,
+			Free:         4096 * 1024,
+			Used:         9216 * 1024,
+			Cached:       3072 * 1024,
+			Sreclaimable: 1024 * 1024,
+			Sunreclaim:   512 * 1024,
+		}},
+		{id: "1", memInfo: &mem.VirtualMemoryStat{}},
+	}, nodes)
+
+	writeMemInfo("node2", "Node 2 MemTotal: garbage kB\n")
+	_, err = readNUMANodes(ctx)
+	assert.Error(t, err)
+}
+
+func TestParseSlabInfo(t *testing.T) {
+	caches, err := parseSlabInfo(strings.NewReader(`slabinfo - version: 2.1
+# name            <active_objs> <num_objs> <objsize> <objperslab> <pagesperslab> : tunables <limit> <batchcount> <sharedfactor> : slabdata <active_slabs> <num_slabs> <sharedavail>
+ext4_inode_cache   42660  42660   1096   29    8 : tunables    0    0    0 : slabdata   1471   1471      0
+dentry            120036 120036    192   21    1 : tunables    0    0    0 : slabdata   5716   5716      0
+kmalloc-8k             0      0   8192    4    8 : tunables    0    0    0 : slabdata      0      0      0
+`), 4096)
+	require.NoError(t, err)
+	assert.Equal(t, []slabCache{
+		{name: "ext4_inode_cache", size: 1471 * 8 * 4096},
+		{name: "dentry", size: 5716 * 4096},
+		{name: "kmalloc-8k", size: 0},
+	}, caches)
+
+	_, err = parseSlabInfo(strings.NewReader("slabinfo - version: 1.1\n"), 4096)
+	assert.EqualError(t, err, `unsupported slabinfo "slabinfo - version: 1.1"`)
+
+	_, err = parseSlabInfo(strings.NewReader("slabinfo - version: 2.1\ndentry 1 1 192 21\n"), 4096)
+	assert.Error(t, err)
+
+	_, err = parseSlabInfo(strings.NewReader("slabinfo - version: 2.1\ndentry 1 1 192 21 1 : tunables 0 0 0 : slabdata 1 many 0\n"), 4096)
+	assert.Error(t, err)
+}
+
+func TestReadSlabCaches(t *testing.T) {
+	procPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: procPath})
+
+	_, err := readSlabCaches(ctx)
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, "slabinfo"), []byte(`slabinfo - version: 2.1
+dentry            120036 120036    192   21    1 : tunables    0    0    0 : slabdata   5716   5716      0
+`), 0o600))
+	caches, err := readSlabCaches(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []slabCache{{name: "dentry", size: 5716 * int64(os.Getpagesize())}}, caches)
+}
@@ -6,6 +6,8 @@
 package memoryscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 
 import (
+	"context"
+
 	"github.com/shirou/gopsutil/v3/mem"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
@@ -26,3 +28,11 @@ func (s *scraper) recordMemoryUtilizationMetric(now pcommon.Timestamp, memInfo *
 
 func (s *scraper) recordSystemSpecificMetrics(_ pcommon.Timestamp, _ *mem.VirtualMemoryStat) {
 }
+
+func readNUMANodes(context.Context) ([]numaNode, error) {
+	return nil, nil
+}
+
+func readSlabCaches(context.Context) ([]slabCache, error) {
+	return nil, nil
+}
@@ -19,6 +19,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper/internal/metadata"
 )
 
@@ -196,6 +197,130 @@ func TestScrape_MemoryUtilization(t *testing.T) {
 	}
 }
 
+func TestScrape_HugePages(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("huge pages metrics are only supported on Linux")
+	}
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.SystemMemoryUsage.Enabled = false
+	mbc.Metrics.SystemLinuxMemoryHugepagesLimit.Enabled = true
+	mbc.Metrics.SystemLinuxMemoryHugepagesPageSize.Enabled = true
+	mbc.Metrics.SystemLinuxMemoryHugepagesReserved.Enabled = true
+	mbc.Metrics.SystemLinuxMemoryHugepagesSurplus.Enabled = true
+	mbc.Metrics.SystemLinuxMemoryHugepagesUsage.Enabled = true
+	mbc.Metrics.SystemLinuxMemoryTransparentHugepagesUsage.Enabled = true
+	scraper := newMemoryScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: mbc})
+	scraper.virtualMemory = func(context.Context) (*mem.VirtualMemoryStat, error) {
+		return &mem.VirtualMemoryStat{
+			Total:          8 << 30,
+			HugePagesTotal: 512,
+			HugePagesFree:  100,
+			HugePagesRsvd:  20,
+			HugePagesSurp:  4,
+			HugePageSize:   2 << 20,
+			AnonHugePages:  64 << 20,
+		}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	values := make(map[string]int64)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			name := metrics.At(i).Name()
+			if state, ok := dps.At(j).Attributes().Get("state"); ok {
+				name += "/" + state.Str()
+			}
+			values[name] = dps.At(j).IntValue()
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"system.linux.memory.hugepages.limit":             512,
+		"system.linux.memory.hugepages.page_size":         2 << 20,
+		"system.linux.memory.hugepages.reserved":          20,
+		"system.linux.memory.hugepages.surplus":           4,
+		"system.linux.memory.hugepages.usage/used":        412,
+		"system.linux.memory.hugepages.usage/free":        100,
+		"system.linux.memory.transparent_hugepages.usage": 64 << 20,
+	}, values)
+}
+
+func TestScrape_PerNUMANode(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.SystemMemoryUtilization.Enabled = true
+	mbc.Metrics.SystemMemoryLimit.Enabled = true
+	scraper := newMemoryScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: mbc, PerNUMANode: true})
+	scraper.virtualMemory = func(context.Context) (*mem.VirtualMemoryStat, error) {
+		return &mem.VirtualMemoryStat{Total: 3000, Used: 1000, Free: 2000}, nil
+	}
+	scraper.numaNodes = func(context.Context) ([]numaNode, error) {
+		return []numaNode{
+			{id: "0", memInfo: &mem.VirtualMemoryStat{Total: 2000, Used: 500, Free: 1500}},
+			{id: "1", memInfo: &mem.VirtualMemoryStat{Total: 1000, Used: 500, Free: 500}},
+		}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		switch metric.Name() {
+		case "system.memory.limit":
+			// still reported for the whole host
+			require.Equal(t, 1, metric.Sum().DataPoints().Len())
+			assert.Equal(t, int64(3000), metric.Sum().DataPoints().At(0).IntValue())
+			assert.Equal(t, 0, metric.Sum().DataPoints().At(0).Attributes().Len())
+		case "system.memory.usage":
+			dps := metric.Sum().DataPoints()
+			require.Equal(t, 0, dps.Len()%2)
+			half := dps.Len() / 2
+			internal.AssertSumMetricHasAttributeValue(t, metric, 0, numaNodeAttribute, pcommon.NewValueStr("0"))
+			internal.AssertSumMetricHasAttributeValue(t, metric, 0, "state", pcommon.NewValueStr(metadata.AttributeStateUsed.String()))
+			assert.Equal(t, int64(500), dps.At(0).IntValue())
+			internal.AssertSumMetricHasAttributeValue(t, metric, half+1, numaNodeAttribute, pcommon.NewValueStr("1"))
+			internal.AssertSumMetricHasAttributeValue(t, metric, half+1, "state", pcommon.NewValueStr(metadata.AttributeStateFree.String()))
+			assert.Equal(t, int64(500), dps.At(half+1).IntValue())
+		case "system.memory.utilization":
+			dps := metric.Gauge().DataPoints()
+			half := dps.Len() / 2
+			internal.AssertGaugeMetricHasAttributeValue(t, metric, 0, numaNodeAttribute, pcommon.NewValueStr("0"))
+			assert.InDelta(t, 0.25, dps.At(0).DoubleValue(), 0.0001)
+			internal.AssertGaugeMetricHasAttributeValue(t, metric, half, numaNodeAttribute, pcommon.NewValueStr("1"))
+			assert.InDelta(t, 0.5, dps.At(half).DoubleValue(), 0.0001)
+		default:
+			assert.Fail(t, "unexpected metric", metric.Name())
+		}
+	}
+
+	// falls back to the whole host when no NUMA information is available
+	scraper.numaNodes = func(context.Context) ([]numaNode, error) {
+		return nil, nil
+	}
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "system.memory.usage" {
+			_, ok := metrics.At(i).Sum().DataPoints().At(0).Attributes().Get(numaNodeAttribute)
+			assert.False(t, ok)
+		}
+	}
+
+	scraper.numaNodes = func(context.Context) ([]numaNode, error) {
+		return nil, errors.New("err1")
+	}
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
 func assertMemoryUsageMetricValid(t *testing.T, metric pmetric.Metric, expectedName string) {
 	assert.Equal(t, expectedName, metric.Name())
 	assert.GreaterOrEqual(t, metric.Sum().DataPoints().Len(), 2)
@@ -235,3 +360,80 @@ func assertMemoryUtilizationMetricHasLinuxSpecificStateLabels(t *testing.T, metr
 	internal.AssertGaugeMetricHasAttributeValue(t, metric, 5, "state",
 		pcommon.NewValueStr(metadata.AttributeStateSlabUnreclaimable.String()))
 }
+
+func TestScrape_CgroupUtilization(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.SystemMemoryUsage.Enabled = false
+	mbc.Metrics.SystemMemoryUtilization.Enabled = true
+	scraper := newMemoryScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: mbc, UtilizationLimit: utilizationLimitCgroup})
+	scraper.virtualMemory = func(context.Context) (*mem.VirtualMemoryStat, error) {
+		return &mem.VirtualMemoryStat{Total: 4000, Used: 3000, Free: 1000}, nil
+	}
+	scraper.cgroupMemory = func() (*cgroup.Memory, error) {
+		return &cgroup.Memory{Usage: 500, Limit: 2000}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	assertUtilization := func(used, free float64) {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 1, metrics.Len())
+		dps := metrics.At(0).Gauge().DataPoints()
+		require.Equal(t, 2, dps.Len())
+		internal.AssertGaugeMetricHasAttributeValue(t, metrics.At(0), 0, "state", pcommon.NewValueStr(metadata.AttributeStateUsed.String()))
+		assert.InDelta(t, used, dps.At(0).DoubleValue(), 0.0001)
+		internal.AssertGaugeMetricHasAttributeValue(t, metrics.At(0), 1, "state", pcommon.NewValueStr(metadata.AttributeStateFree.String()))
+		assert.InDelta(t, free, dps.At(1).DoubleValue(), 0.0001)
+	}
+	assertUtilization(0.25, 0.75)
+
+	// without a limit the utilization is computed against the memory of the host
+	scraper.cgroupMemory = func() (*cgroup.Memory, error) {
+		return &cgroup.Memory{Usage: 500}, nil
+	}
+	assertUtilization(0.125, 0.875)
+
+	scraper.cgroupMemory = func() (*cgroup.Memory, error) { return nil, errors.New("err1") }
+	_, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read the memory of the cgroup: err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
+func TestScrape_SlabUsage(t *testing.T) {
+	cfg := (&Factory{}).CreateDefaultConfig().(*Config)
+	cfg.Metrics.SystemMemoryUsage.Enabled = false
+	cfg.Metrics.SystemLinuxMemorySlabUsage.Enabled = true
+	cfg.TopSlabCaches = 2
+	scraper := newMemoryScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	scraper.virtualMemory = func(context.Context) (*mem.VirtualMemoryStat, error) {
+		return &mem.VirtualMemoryStat{Total: 4000}, nil
+	}
+	scraper.slabCaches = func(context.Context) ([]slabCache, error) {
+		return []slabCache{
+			{name: "kmalloc-64", size: 4096},
+			{name: "dentry", size: 1 << 20},
+			{name: "ext4_inode_cache", size: 2 << 20},
+		}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	assert.Equal(t, "system.linux.memory.slab.usage", metrics.At(0).Name())
+
+	// only the caches holding the most memory are reported
+	dps := metrics.At(0).Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, map[string]any{"slab.cache": "ext4_inode_cache"}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, int64(2<<20), dps.At(0).IntValue())
+	assert.Equal(t, map[string]any{"slab.cache": "dentry"}, dps.At(1).Attributes().AsRaw())
+	assert.Equal(t, int64(1<<20), dps.At(1).IntValue())
+
+	scraper.slabCaches = func(context.Context) ([]slabCache, error) { return nil, errors.New("err1") }
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read slab caches: err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
@@ -17,6 +17,20 @@ type Config struct {
 	Include MatchConfig `mapstructure:"include"`
 	// Exclude specifies a filter on the network interfaces that should be excluded from the generated metrics.
 	Exclude MatchConfig `mapstructure:"exclude"`
+
+	// ReportCause breaks the `system.network.dropped` and `system.network.errors` data points down by a `cause`
+	// attribute, read from the interface statistics in sysfs, so that errors of the NIC can be told apart from drops
+	// in the kernel queues. This option is only supported on Linux.
+	ReportCause bool `mapstructure:"report_cause"`
+
+	// ReportHierarchy adds a `network.type` attribute holding the kind of the interface (e.g. `bond`, `bridge`, `vlan`
+	// or `physical`), and a `network.master` attribute holding the bond or bridge the interface is a member of, to the
+	// data points of every interface. This option is only supported on Linux.
+	ReportHierarchy bool `mapstructure:"report_hierarchy"`
+
+	// ExcludeMembers leaves the members of bonds and bridges out of the packets, dropped, errors and io metrics,
+	// so that their traffic is only counted once, on their master. This option is only supported on Linux.
+	ExcludeMembers bool `mapstructure:"exclude_members"`
 }
 
 type MatchConfig struct {
@@ -5,6 +5,8 @@ package networkscraper // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"errors"
+	"runtime"
 
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -38,6 +40,9 @@ func (f *Factory) CreateMetricsScraper(
 	config internal.Config,
 ) (scraperhelper.Scraper, error) {
 	cfg := config.(*Config)
+	if cfg.ReportCause && runtime.GOOS != "linux" {
+		return nil, errors.New("report_cause only available on Linux")
+	}
 	s, err := newNetworkScraper(ctx, settings, cfg)
 	if err != nil {
 		return nil, err
@@ -47,5 +52,6 @@ func (f *Factory) CreateMetricsScraper(
 		TypeStr,
 		s.scrape,
 		scraperhelper.WithStart(s.start),
+		scraperhelper.WithShutdown(s.shutdown),
 	)
 }
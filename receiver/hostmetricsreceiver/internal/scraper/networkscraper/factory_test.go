@@ -5,6 +5,7 @@ package networkscraper
 
 import (
 	"context"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -35,3 +36,14 @@ func TestCreateMetricsScraper_Error(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestCreateMetricsScraper_ReportCause(t *testing.T) {
+	factory := &Factory{}
+
+	_, err := factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{ReportCause: true})
+	if runtime.GOOS == "linux" {
+		assert.NoError(t, err)
+	} else {
+		assert.EqualError(t, err, "report_cause only available on Linux")
+	}
+}
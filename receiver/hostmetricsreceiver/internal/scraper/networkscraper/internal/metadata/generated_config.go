@@ -27,13 +27,22 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for hostmetricsreceiver/network metrics.
 type MetricsConfig struct {
-	SystemNetworkConnections    MetricConfig `mapstructure:"system.network.connections"`
-	SystemNetworkConntrackCount MetricConfig `mapstructure:"system.network.conntrack.count"`
-	SystemNetworkConntrackMax   MetricConfig `mapstructure:"system.network.conntrack.max"`
-	SystemNetworkDropped        MetricConfig `mapstructure:"system.network.dropped"`
-	SystemNetworkErrors         MetricConfig `mapstructure:"system.network.errors"`
-	SystemNetworkIo             MetricConfig `mapstructure:"system.network.io"`
-	SystemNetworkPackets        MetricConfig `mapstructure:"system.network.packets"`
+	SystemNetworkConnections              MetricConfig `mapstructure:"system.network.connections"`
+	SystemNetworkConntrackCount           MetricConfig `mapstructure:"system.network.conntrack.count"`
+	SystemNetworkConntrackMax             MetricConfig `mapstructure:"system.network.conntrack.max"`
+	SystemNetworkDropped                  MetricConfig `mapstructure:"system.network.dropped"`
+	SystemNetworkErrors                   MetricConfig `mapstructure:"system.network.errors"`
+	SystemNetworkInterfaceMtu             MetricConfig `mapstructure:"system.network.interface.mtu"`
+	SystemNetworkInterfaceSpeed           MetricConfig `mapstructure:"system.network.interface.speed"`
+	SystemNetworkInterfaceState           MetricConfig `mapstructure:"system.network.interface.state"`
+	SystemNetworkIo                       MetricConfig `mapstructure:"system.network.io"`
+	SystemNetworkPackets                  MetricConfig `mapstructure:"system.network.packets"`
+	SystemNetworkSocketConnections        MetricConfig `mapstructure:"system.network.socket.connections"`
+	SystemNetworkSocketIo                 MetricConfig `mapstructure:"system.network.socket.io"`
+	SystemNetworkSocketRetransmits        MetricConfig `mapstructure:"system.network.socket.retransmits"`
+	SystemNetworkTcpOpens                 MetricConfig `mapstructure:"system.network.tcp.opens"`
+	SystemNetworkTcpSegmentsRetransmitted MetricConfig `mapstructure:"system.network.tcp.segments.retransmitted"`
+	SystemNetworkTcpSockets               MetricConfig `mapstructure:"system.network.tcp.sockets"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -53,12 +62,39 @@ func DefaultMetricsConfig() MetricsConfig {
 		SystemNetworkErrors: MetricConfig{
 			Enabled: true,
 		},
+		SystemNetworkInterfaceMtu: MetricConfig{
+			Enabled: false,
+		},
+		SystemNetworkInterfaceSpeed: MetricConfig{
+			Enabled: false,
+		},
+		SystemNetworkInterfaceState: MetricConfig{
+			Enabled: false,
+		},
 		SystemNetworkIo: MetricConfig{
 			Enabled: true,
 		},
 		SystemNetworkPackets: MetricConfig{
 			Enabled: true,
 		},
+		SystemNetworkSocketConnections: MetricConfig{
+			Enabled: false,
+		},
+		SystemNetworkSocketIo: MetricConfig{
+			Enabled: false,
+		},
+		SystemNetworkSocketRetransmits: MetricConfig{
+			Enabled: false,
+		},
+		SystemNetworkTcpOpens: MetricConfig{
+			Enabled: false,
+		},
+		SystemNetworkTcpSegmentsRetransmitted: MetricConfig{
+			Enabled: false,
+		},
+		SystemNetworkTcpSockets: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
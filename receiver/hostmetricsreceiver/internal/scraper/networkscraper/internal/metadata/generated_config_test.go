@@ -26,13 +26,22 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemNetworkConnections:    MetricConfig{Enabled: true},
-					SystemNetworkConntrackCount: MetricConfig{Enabled: true},
-					SystemNetworkConntrackMax:   MetricConfig{Enabled: true},
-					SystemNetworkDropped:        MetricConfig{Enabled: true},
-					SystemNetworkErrors:         MetricConfig{Enabled: true},
-					SystemNetworkIo:             MetricConfig{Enabled: true},
-					SystemNetworkPackets:        MetricConfig{Enabled: true},
+					SystemNetworkConnections:              MetricConfig{Enabled: true},
+					SystemNetworkConntrackCount:           MetricConfig{Enabled: true},
+					SystemNetworkConntrackMax:             MetricConfig{Enabled: true},
+					SystemNetworkDropped:                  MetricConfig{Enabled: true},
+					SystemNetworkErrors:                   MetricConfig{Enabled: true},
+					SystemNetworkInterfaceMtu:             MetricConfig{Enabled: true},
+					SystemNetworkInterfaceSpeed:           MetricConfig{Enabled: true},
+					SystemNetworkInterfaceState:           MetricConfig{Enabled: true},
+					SystemNetworkIo:                       MetricConfig{Enabled: true},
+					SystemNetworkPackets:                  MetricConfig{Enabled: true},
+					SystemNetworkSocketConnections:        MetricConfig{Enabled: true},
+					SystemNetworkSocketIo:                 MetricConfig{Enabled: true},
+					SystemNetworkSocketRetransmits:        MetricConfig{Enabled: true},
+					SystemNetworkTcpOpens:                 MetricConfig{Enabled: true},
+					SystemNetworkTcpSegmentsRetransmitted: MetricConfig{Enabled: true},
+					SystemNetworkTcpSockets:               MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -40,13 +49,22 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemNetworkConnections:    MetricConfig{Enabled: false},
-					SystemNetworkConntrackCount: MetricConfig{Enabled: false},
-					SystemNetworkConntrackMax:   MetricConfig{Enabled: false},
-					SystemNetworkDropped:        MetricConfig{Enabled: false},
-					SystemNetworkErrors:         MetricConfig{Enabled: false},
-					SystemNetworkIo:             MetricConfig{Enabled: false},
-					SystemNetworkPackets:        MetricConfig{Enabled: false},
+					SystemNetworkConnections:              MetricConfig{Enabled: false},
+					SystemNetworkConntrackCount:           MetricConfig{Enabled: false},
+					SystemNetworkConntrackMax:             MetricConfig{Enabled: false},
+					SystemNetworkDropped:                  MetricConfig{Enabled: false},
+					SystemNetworkErrors:                   MetricConfig{Enabled: false},
+					SystemNetworkInterfaceMtu:             MetricConfig{Enabled: false},
+					SystemNetworkInterfaceSpeed:           MetricConfig{Enabled: false},
+					SystemNetworkInterfaceState:           MetricConfig{Enabled: false},
+					SystemNetworkIo:                       MetricConfig{Enabled: false},
+					SystemNetworkPackets:                  MetricConfig{Enabled: false},
+					SystemNetworkSocketConnections:        MetricConfig{Enabled: false},
+					SystemNetworkSocketIo:                 MetricConfig{Enabled: false},
+					SystemNetworkSocketRetransmits:        MetricConfig{Enabled: false},
+					SystemNetworkTcpOpens:                 MetricConfig{Enabled: false},
+					SystemNetworkTcpSegmentsRetransmitted: MetricConfig{Enabled: false},
+					SystemNetworkTcpSockets:               MetricConfig{Enabled: false},
 				},
 			},
 		},
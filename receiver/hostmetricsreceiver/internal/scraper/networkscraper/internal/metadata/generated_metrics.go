@@ -38,6 +38,82 @@ var MapAttributeDirection = map[string]AttributeDirection{
 	"transmit": AttributeDirectionTransmit,
 }
 
+// AttributeDuplex specifies the a value duplex attribute.
+type AttributeDuplex int
+
+const (
+	_ AttributeDuplex = iota
+	AttributeDuplexFull
+	AttributeDuplexHalf
+	AttributeDuplexUnknown
+)
+
+// String returns the string representation of the AttributeDuplex.
+func (av AttributeDuplex) String() string {
+	switch av {
+	case AttributeDuplexFull:
+		return "full"
+	case AttributeDuplexHalf:
+		return "half"
+	case AttributeDuplexUnknown:
+		return "unknown"
+	}
+	return ""
+}
+
+// MapAttributeDuplex is a helper map of string to AttributeDuplex attribute value.
+var MapAttributeDuplex = map[string]AttributeDuplex{
+	"full":    AttributeDuplexFull,
+	"half":    AttributeDuplexHalf,
+	"unknown": AttributeDuplexUnknown,
+}
+
+// AttributeOperstate specifies the a value operstate attribute.
+type AttributeOperstate int
+
+const (
+	_ AttributeOperstate = iota
+	AttributeOperstateDown
+	AttributeOperstateDormant
+	AttributeOperstateLowerlayerdown
+	AttributeOperstateNotpresent
+	AttributeOperstateTesting
+	AttributeOperstateUnknown
+	AttributeOperstateUp
+)
+
+// String returns the string representation of the AttributeOperstate.
+func (av AttributeOperstate) String() string {
+	switch av {
+	case AttributeOperstateDown:
+		return "down"
+	case AttributeOperstateDormant:
+		return "dormant"
+	case AttributeOperstateLowerlayerdown:
+		return "lowerlayerdown"
+	case AttributeOperstateNotpresent:
+		return "notpresent"
+	case AttributeOperstateTesting:
+		return "testing"
+	case AttributeOperstateUnknown:
+		return "unknown"
+	case AttributeOperstateUp:
+		return "up"
+	}
+	return ""
+}
+
+// MapAttributeOperstate is a helper map of string to AttributeOperstate attribute value.
+var MapAttributeOperstate = map[string]AttributeOperstate{
+	"down":           AttributeOperstateDown,
+	"dormant":        AttributeOperstateDormant,
+	"lowerlayerdown": AttributeOperstateLowerlayerdown,
+	"notpresent":     AttributeOperstateNotpresent,
+	"testing":        AttributeOperstateTesting,
+	"unknown":        AttributeOperstateUnknown,
+	"up":             AttributeOperstateUp,
+}
+
 // AttributeProtocol specifies the a value protocol attribute.
 type AttributeProtocol int
 
@@ -60,6 +136,32 @@ var MapAttributeProtocol = map[string]AttributeProtocol{
 	"tcp": AttributeProtocolTcp,
 }
 
+// AttributeType specifies the a value type attribute.
+type AttributeType int
+
+const (
+	_ AttributeType = iota
+	AttributeTypeActive
+	AttributeTypePassive
+)
+
+// String returns the string representation of the AttributeType.
+func (av AttributeType) String() string {
+	switch av {
+	case AttributeTypeActive:
+		return "active"
+	case AttributeTypePassive:
+		return "passive"
+	}
+	return ""
+}
+
+// MapAttributeType is a helper map of string to AttributeType attribute value.
+var MapAttributeType = map[string]AttributeType{
+	"active":  AttributeTypeActive,
+	"passive": AttributeTypePassive,
+}
+
 type metricSystemNetworkConnections struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -324,6 +426,161 @@ func newMetricSystemNetworkErrors(cfg MetricConfig) metricSystemNetworkErrors {
 	return m
 }
 
+type metricSystemNetworkInterfaceMtu struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.interface.mtu metric with initial data.
+func (m *metricSystemNetworkInterfaceMtu) init() {
+	m.data.SetName("system.network.interface.mtu")
+	m.data.SetDescription("The maximum transmission unit of the network interface.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkInterfaceMtu) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkInterfaceMtu) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkInterfaceMtu) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkInterfaceMtu(cfg MetricConfig) metricSystemNetworkInterfaceMtu {
+	m := metricSystemNetworkInterfaceMtu{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkInterfaceSpeed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.interface.speed metric with initial data.
+func (m *metricSystemNetworkInterfaceSpeed) init() {
+	m.data.SetName("system.network.interface.speed")
+	m.data.SetDescription("The negotiated link speed of the network interface.")
+	m.data.SetUnit("By/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkInterfaceSpeed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, duplexAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("duplex", duplexAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkInterfaceSpeed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkInterfaceSpeed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkInterfaceSpeed(cfg MetricConfig) metricSystemNetworkInterfaceSpeed {
+	m := metricSystemNetworkInterfaceSpeed{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkInterfaceState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.interface.state metric with initial data.
+func (m *metricSystemNetworkInterfaceState) init() {
+	m.data.SetName("system.network.interface.state")
+	m.data.SetDescription("Whether the network interface is in the given operational state (1) or not (0).")
+	m.data.SetUnit("{state}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkInterfaceState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, operstateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("operstate", operstateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkInterfaceState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkInterfaceState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkInterfaceState(cfg MetricConfig) metricSystemNetworkInterfaceState {
+	m := metricSystemNetworkInterfaceState{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSystemNetworkIo struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -432,21 +689,347 @@ func newMetricSystemNetworkPackets(cfg MetricConfig) metricSystemNetworkPackets
 	return m
 }
 
+type metricSystemNetworkSocketConnections struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.socket.connections metric with initial data.
+func (m *metricSystemNetworkSocketConnections) init() {
+	m.data.SetName("system.network.socket.connections")
+	m.data.SetDescription("The number of TCP connections established, by service port. Collected with eBPF, on Linux only.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkSocketConnections) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, portAttributeValue int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutInt("port", portAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkSocketConnections) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkSocketConnections) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkSocketConnections(cfg MetricConfig) metricSystemNetworkSocketConnections {
+	m := metricSystemNetworkSocketConnections{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkSocketIo struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.socket.io metric with initial data.
+func (m *metricSystemNetworkSocketIo) init() {
+	m.data.SetName("system.network.socket.io")
+	m.data.SetDescription("The number of bytes sent and received over TCP connections, by service port. Collected with eBPF, on Linux only.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkSocketIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, portAttributeValue int64, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutInt("port", portAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkSocketIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkSocketIo) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkSocketIo(cfg MetricConfig) metricSystemNetworkSocketIo {
+	m := metricSystemNetworkSocketIo{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkSocketRetransmits struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.socket.retransmits metric with initial data.
+func (m *metricSystemNetworkSocketRetransmits) init() {
+	m.data.SetName("system.network.socket.retransmits")
+	m.data.SetDescription("The number of TCP segments retransmitted, by service port. Collected with eBPF, on Linux only.")
+	m.data.SetUnit("{segments}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkSocketRetransmits) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, portAttributeValue int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutInt("port", portAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkSocketRetransmits) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkSocketRetransmits) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkSocketRetransmits(cfg MetricConfig) metricSystemNetworkSocketRetransmits {
+	m := metricSystemNetworkSocketRetransmits{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkTcpOpens struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.tcp.opens metric with initial data.
+func (m *metricSystemNetworkTcpOpens) init() {
+	m.data.SetName("system.network.tcp.opens")
+	m.data.SetDescription("The number of TCP connections opened, actively by connecting or passively by accepting.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkTcpOpens) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, typeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("type", typeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkTcpOpens) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkTcpOpens) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkTcpOpens(cfg MetricConfig) metricSystemNetworkTcpOpens {
+	m := metricSystemNetworkTcpOpens{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkTcpSegmentsRetransmitted struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.tcp.segments.retransmitted metric with initial data.
+func (m *metricSystemNetworkTcpSegmentsRetransmitted) init() {
+	m.data.SetName("system.network.tcp.segments.retransmitted")
+	m.data.SetDescription("The number of TCP segments retransmitted.")
+	m.data.SetUnit("{segments}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemNetworkTcpSegmentsRetransmitted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkTcpSegmentsRetransmitted) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkTcpSegmentsRetransmitted) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkTcpSegmentsRetransmitted(cfg MetricConfig) metricSystemNetworkTcpSegmentsRetransmitted {
+	m := metricSystemNetworkTcpSegmentsRetransmitted{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkTcpSockets struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.tcp.sockets metric with initial data.
+func (m *metricSystemNetworkTcpSockets) init() {
+	m.data.SetName("system.network.tcp.sockets")
+	m.data.SetDescription("The number of TCP sockets by state.")
+	m.data.SetUnit("{sockets}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkTcpSockets) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, stateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", stateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkTcpSockets) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkTcpSockets) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkTcpSockets(cfg MetricConfig) metricSystemNetworkTcpSockets {
+	m := metricSystemNetworkTcpSockets{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                            MetricsBuilderConfig // config of the metrics builder.
-	startTime                         pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                   int                  // maximum observed number of metrics per resource.
-	metricsBuffer                     pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                         component.BuildInfo  // contains version information.
-	metricSystemNetworkConnections    metricSystemNetworkConnections
-	metricSystemNetworkConntrackCount metricSystemNetworkConntrackCount
-	metricSystemNetworkConntrackMax   metricSystemNetworkConntrackMax
-	metricSystemNetworkDropped        metricSystemNetworkDropped
-	metricSystemNetworkErrors         metricSystemNetworkErrors
-	metricSystemNetworkIo             metricSystemNetworkIo
-	metricSystemNetworkPackets        metricSystemNetworkPackets
+	config                                      MetricsBuilderConfig // config of the metrics builder.
+	startTime                                   pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                             int                  // maximum observed number of metrics per resource.
+	metricsBuffer                               pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                   component.BuildInfo  // contains version information.
+	metricSystemNetworkConnections              metricSystemNetworkConnections
+	metricSystemNetworkConntrackCount           metricSystemNetworkConntrackCount
+	metricSystemNetworkConntrackMax             metricSystemNetworkConntrackMax
+	metricSystemNetworkDropped                  metricSystemNetworkDropped
+	metricSystemNetworkErrors                   metricSystemNetworkErrors
+	metricSystemNetworkInterfaceMtu             metricSystemNetworkInterfaceMtu
+	metricSystemNetworkInterfaceSpeed           metricSystemNetworkInterfaceSpeed
+	metricSystemNetworkInterfaceState           metricSystemNetworkInterfaceState
+	metricSystemNetworkIo                       metricSystemNetworkIo
+	metricSystemNetworkPackets                  metricSystemNetworkPackets
+	metricSystemNetworkSocketConnections        metricSystemNetworkSocketConnections
+	metricSystemNetworkSocketIo                 metricSystemNetworkSocketIo
+	metricSystemNetworkSocketRetransmits        metricSystemNetworkSocketRetransmits
+	metricSystemNetworkTcpOpens                 metricSystemNetworkTcpOpens
+	metricSystemNetworkTcpSegmentsRetransmitted metricSystemNetworkTcpSegmentsRetransmitted
+	metricSystemNetworkTcpSockets               metricSystemNetworkTcpSockets
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -461,17 +1044,26 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                            mbc,
-		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                     pmetric.NewMetrics(),
-		buildInfo:                         settings.BuildInfo,
-		metricSystemNetworkConnections:    newMetricSystemNetworkConnections(mbc.Metrics.SystemNetworkConnections),
-		metricSystemNetworkConntrackCount: newMetricSystemNetworkConntrackCount(mbc.Metrics.SystemNetworkConntrackCount),
-		metricSystemNetworkConntrackMax:   newMetricSystemNetworkConntrackMax(mbc.Metrics.SystemNetworkConntrackMax),
-		metricSystemNetworkDropped:        newMetricSystemNetworkDropped(mbc.Metrics.SystemNetworkDropped),
-		metricSystemNetworkErrors:         newMetricSystemNetworkErrors(mbc.Metrics.SystemNetworkErrors),
-		metricSystemNetworkIo:             newMetricSystemNetworkIo(mbc.Metrics.SystemNetworkIo),
-		metricSystemNetworkPackets:        newMetricSystemNetworkPackets(mbc.Metrics.SystemNetworkPackets),
+		config:                                      mbc,
+		startTime:                                   pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                               pmetric.NewMetrics(),
+		buildInfo:                                   settings.BuildInfo,
+		metricSystemNetworkConnections:              newMetricSystemNetworkConnections(mbc.Metrics.SystemNetworkConnections),
+		metricSystemNetworkConntrackCount:           newMetricSystemNetworkConntrackCount(mbc.Metrics.SystemNetworkConntrackCount),
+		metricSystemNetworkConntrackMax:             newMetricSystemNetworkConntrackMax(mbc.Metrics.SystemNetworkConntrackMax),
+		metricSystemNetworkDropped:                  newMetricSystemNetworkDropped(mbc.Metrics.SystemNetworkDropped),
+		metricSystemNetworkErrors:                   newMetricSystemNetworkErrors(mbc.Metrics.SystemNetworkErrors),
+		metricSystemNetworkInterfaceMtu:             newMetricSystemNetworkInterfaceMtu(mbc.Metrics.SystemNetworkInterfaceMtu),
+		metricSystemNetworkInterfaceSpeed:           newMetricSystemNetworkInterfaceSpeed(mbc.Metrics.SystemNetworkInterfaceSpeed),
+		metricSystemNetworkInterfaceState:           newMetricSystemNetworkInterfaceState(mbc.Metrics.SystemNetworkInterfaceState),
+		metricSystemNetworkIo:                       newMetricSystemNetworkIo(mbc.Metrics.SystemNetworkIo),
+		metricSystemNetworkPackets:                  newMetricSystemNetworkPackets(mbc.Metrics.SystemNetworkPackets),
+		metricSystemNetworkSocketConnections:        newMetricSystemNetworkSocketConnections(mbc.Metrics.SystemNetworkSocketConnections),
+		metricSystemNetworkSocketIo:                 newMetricSystemNetworkSocketIo(mbc.Metrics.SystemNetworkSocketIo),
+		metricSystemNetworkSocketRetransmits:        newMetricSystemNetworkSocketRetransmits(mbc.Metrics.SystemNetworkSocketRetransmits),
+		metricSystemNetworkTcpOpens:                 newMetricSystemNetworkTcpOpens(mbc.Metrics.SystemNetworkTcpOpens),
+		metricSystemNetworkTcpSegmentsRetransmitted: newMetricSystemNetworkTcpSegmentsRetransmitted(mbc.Metrics.SystemNetworkTcpSegmentsRetransmitted),
+		metricSystemNetworkTcpSockets:               newMetricSystemNetworkTcpSockets(mbc.Metrics.SystemNetworkTcpSockets),
 	}
 
 	for _, op := range options {
@@ -535,8 +1127,17 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSystemNetworkConntrackMax.emit(ils.Metrics())
 	mb.metricSystemNetworkDropped.emit(ils.Metrics())
 	mb.metricSystemNetworkErrors.emit(ils.Metrics())
+	mb.metricSystemNetworkInterfaceMtu.emit(ils.Metrics())
+	mb.metricSystemNetworkInterfaceSpeed.emit(ils.Metrics())
+	mb.metricSystemNetworkInterfaceState.emit(ils.Metrics())
 	mb.metricSystemNetworkIo.emit(ils.Metrics())
 	mb.metricSystemNetworkPackets.emit(ils.Metrics())
+	mb.metricSystemNetworkSocketConnections.emit(ils.Metrics())
+	mb.metricSystemNetworkSocketIo.emit(ils.Metrics())
+	mb.metricSystemNetworkSocketRetransmits.emit(ils.Metrics())
+	mb.metricSystemNetworkTcpOpens.emit(ils.Metrics())
+	mb.metricSystemNetworkTcpSegmentsRetransmitted.emit(ils.Metrics())
+	mb.metricSystemNetworkTcpSockets.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -583,6 +1184,21 @@ func (mb *MetricsBuilder) RecordSystemNetworkErrorsDataPoint(ts pcommon.Timestam
 	mb.metricSystemNetworkErrors.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordSystemNetworkInterfaceMtuDataPoint adds a data point to system.network.interface.mtu metric.
+func (mb *MetricsBuilder) RecordSystemNetworkInterfaceMtuDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricSystemNetworkInterfaceMtu.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordSystemNetworkInterfaceSpeedDataPoint adds a data point to system.network.interface.speed metric.
+func (mb *MetricsBuilder) RecordSystemNetworkInterfaceSpeedDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, duplexAttributeValue AttributeDuplex) {
+	mb.metricSystemNetworkInterfaceSpeed.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, duplexAttributeValue.String())
+}
+
+// RecordSystemNetworkInterfaceStateDataPoint adds a data point to system.network.interface.state metric.
+func (mb *MetricsBuilder) RecordSystemNetworkInterfaceStateDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, operstateAttributeValue AttributeOperstate) {
+	mb.metricSystemNetworkInterfaceState.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, operstateAttributeValue.String())
+}
+
 // RecordSystemNetworkIoDataPoint adds a data point to system.network.io metric.
 func (mb *MetricsBuilder) RecordSystemNetworkIoDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
 	mb.metricSystemNetworkIo.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
@@ -593,6 +1209,36 @@ func (mb *MetricsBuilder) RecordSystemNetworkPacketsDataPoint(ts pcommon.Timesta
 	mb.metricSystemNetworkPackets.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordSystemNetworkSocketConnectionsDataPoint adds a data point to system.network.socket.connections metric.
+func (mb *MetricsBuilder) RecordSystemNetworkSocketConnectionsDataPoint(ts pcommon.Timestamp, val int64, portAttributeValue int64) {
+	mb.metricSystemNetworkSocketConnections.recordDataPoint(mb.startTime, ts, val, portAttributeValue)
+}
+
+// RecordSystemNetworkSocketIoDataPoint adds a data point to system.network.socket.io metric.
+func (mb *MetricsBuilder) RecordSystemNetworkSocketIoDataPoint(ts pcommon.Timestamp, val int64, portAttributeValue int64, directionAttributeValue AttributeDirection) {
+	mb.metricSystemNetworkSocketIo.recordDataPoint(mb.startTime, ts, val, portAttributeValue, directionAttributeValue.String())
+}
+
+// RecordSystemNetworkSocketRetransmitsDataPoint adds a data point to system.network.socket.retransmits metric.
+func (mb *MetricsBuilder) RecordSystemNetworkSocketRetransmitsDataPoint(ts pcommon.Timestamp, val int64, portAttributeValue int64) {
+	mb.metricSystemNetworkSocketRetransmits.recordDataPoint(mb.startTime, ts, val, portAttributeValue)
+}
+
+// RecordSystemNetworkTcpOpensDataPoint adds a data point to system.network.tcp.opens metric.
+func (mb *MetricsBuilder) RecordSystemNetworkTcpOpensDataPoint(ts pcommon.Timestamp, val int64, typeAttributeValue AttributeType) {
+	mb.metricSystemNetworkTcpOpens.recordDataPoint(mb.startTime, ts, val, typeAttributeValue.String())
+}
+
+// RecordSystemNetworkTcpSegmentsRetransmittedDataPoint adds a data point to system.network.tcp.segments.retransmitted metric.
+func (mb *MetricsBuilder) RecordSystemNetworkTcpSegmentsRetransmittedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemNetworkTcpSegmentsRetransmitted.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemNetworkTcpSocketsDataPoint adds a data point to system.network.tcp.sockets metric.
+func (mb *MetricsBuilder) RecordSystemNetworkTcpSocketsDataPoint(ts pcommon.Timestamp, val int64, stateAttributeValue string) {
+	mb.metricSystemNetworkTcpSockets.recordDataPoint(mb.startTime, ts, val, stateAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -77,6 +77,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSystemNetworkErrorsDataPoint(ts, 1, "device-val", AttributeDirectionReceive)
 
+			allMetricsCount++
+			mb.RecordSystemNetworkInterfaceMtuDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordSystemNetworkInterfaceSpeedDataPoint(ts, 1, "device-val", AttributeDuplexFull)
+
+			allMetricsCount++
+			mb.RecordSystemNetworkInterfaceStateDataPoint(ts, 1, "device-val", AttributeOperstateDown)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSystemNetworkIoDataPoint(ts, 1, "device-val", AttributeDirectionReceive)
@@ -85,6 +94,24 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSystemNetworkPacketsDataPoint(ts, 1, "device-val", AttributeDirectionReceive)
 
+			allMetricsCount++
+			mb.RecordSystemNetworkSocketConnectionsDataPoint(ts, 1, 4)
+
+			allMetricsCount++
+			mb.RecordSystemNetworkSocketIoDataPoint(ts, 1, 4, AttributeDirectionReceive)
+
+			allMetricsCount++
+			mb.RecordSystemNetworkSocketRetransmitsDataPoint(ts, 1, 4)
+
+			allMetricsCount++
+			mb.RecordSystemNetworkTcpOpensDataPoint(ts, 1, AttributeTypeActive)
+
+			allMetricsCount++
+			mb.RecordSystemNetworkTcpSegmentsRetransmittedDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSystemNetworkTcpSocketsDataPoint(ts, 1, "state-val")
+
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
 
@@ -195,6 +222,57 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("direction")
 					assert.True(t, ok)
 					assert.EqualValues(t, "receive", attrVal.Str())
+				case "system.network.interface.mtu":
+					assert.False(t, validatedMetrics["system.network.interface.mtu"], "Found a duplicate in the metrics slice: system.network.interface.mtu")
+					validatedMetrics["system.network.interface.mtu"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The maximum transmission unit of the network interface.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+				case "system.network.interface.speed":
+					assert.False(t, validatedMetrics["system.network.interface.speed"], "Found a duplicate in the metrics slice: system.network.interface.speed")
+					validatedMetrics["system.network.interface.speed"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The negotiated link speed of the network interface.", ms.At(i).Description())
+					assert.Equal(t, "By/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("duplex")
+					assert.True(t, ok)
+					assert.EqualValues(t, "full", attrVal.Str())
+				case "system.network.interface.state":
+					assert.False(t, validatedMetrics["system.network.interface.state"], "Found a duplicate in the metrics slice: system.network.interface.state")
+					validatedMetrics["system.network.interface.state"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the network interface is in the given operational state (1) or not (0).", ms.At(i).Description())
+					assert.Equal(t, "{state}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.EqualValues(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("operstate")
+					assert.True(t, ok)
+					assert.EqualValues(t, "down", attrVal.Str())
 				case "system.network.io":
 					assert.False(t, validatedMetrics["system.network.io"], "Found a duplicate in the metrics slice: system.network.io")
 					validatedMetrics["system.network.io"] = true
@@ -235,6 +313,108 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("direction")
 					assert.True(t, ok)
 					assert.EqualValues(t, "receive", attrVal.Str())
+				case "system.network.socket.connections":
+					assert.False(t, validatedMetrics["system.network.socket.connections"], "Found a duplicate in the metrics slice: system.network.socket.connections")
+					validatedMetrics["system.network.socket.connections"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of TCP connections established, by service port. Collected with eBPF, on Linux only.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("port")
+					assert.True(t, ok)
+					assert.EqualValues(t, 4, attrVal.Int())
+				case "system.network.socket.io":
+					assert.False(t, validatedMetrics["system.network.socket.io"], "Found a duplicate in the metrics slice: system.network.socket.io")
+					validatedMetrics["system.network.socket.io"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of bytes sent and received over TCP connections, by service port. Collected with eBPF, on Linux only.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("port")
+					assert.True(t, ok)
+					assert.EqualValues(t, 4, attrVal.Int())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "receive", attrVal.Str())
+				case "system.network.socket.retransmits":
+					assert.False(t, validatedMetrics["system.network.socket.retransmits"], "Found a duplicate in the metrics slice: system.network.socket.retransmits")
+					validatedMetrics["system.network.socket.retransmits"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of TCP segments retransmitted, by service port. Collected with eBPF, on Linux only.", ms.At(i).Description())
+					assert.Equal(t, "{segments}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("port")
+					assert.True(t, ok)
+					assert.EqualValues(t, 4, attrVal.Int())
+				case "system.network.tcp.opens":
+					assert.False(t, validatedMetrics["system.network.tcp.opens"], "Found a duplicate in the metrics slice: system.network.tcp.opens")
+					validatedMetrics["system.network.tcp.opens"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of TCP connections opened, actively by connecting or passively by accepting.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "active", attrVal.Str())
+				case "system.network.tcp.segments.retransmitted":
+					assert.False(t, validatedMetrics["system.network.tcp.segments.retransmitted"], "Found a duplicate in the metrics slice: system.network.tcp.segments.retransmitted")
+					validatedMetrics["system.network.tcp.segments.retransmitted"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of TCP segments retransmitted.", ms.At(i).Description())
+					assert.Equal(t, "{segments}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "system.network.tcp.sockets":
+					assert.False(t, validatedMetrics["system.network.tcp.sockets"], "Found a duplicate in the metrics slice: system.network.tcp.sockets")
+					validatedMetrics["system.network.tcp.sockets"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of TCP sockets by state.", ms.At(i).Description())
+					assert.Equal(t, "{sockets}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "state-val", attrVal.Str())
 				}
 			}
 		})
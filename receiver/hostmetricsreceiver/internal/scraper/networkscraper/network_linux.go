@@ -7,11 +7,20 @@ package networkscraper // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/common"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper/internal/metadata"
 )
 
 var allTCPStates = []string{
@@ -43,3 +52,184 @@ func (s *scraper) recordNetworkConntrackMetrics() error {
 	s.mb.RecordSystemNetworkConntrackMaxDataPoint(now, conntrack[0].ConnTrackMax)
 	return nil
 }
+
+func (s *scraper) recordNetworkTCPMetrics() error {
+	if !s.config.Metrics.SystemNetworkTcpOpens.Enabled && !s.config.Metrics.SystemNetworkTcpSegmentsRetransmitted.Enabled &&
+		!s.config.Metrics.SystemNetworkTcpSockets.Enabled {
+		return nil
+	}
+	ctx := context.WithValue(context.Background(), common.EnvKey, s.config.EnvMap)
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	counters, err := s.protoCounters(ctx, []string{"tcp"})
+	if err != nil {
+		return fmt.Errorf("failed to read TCP counters: %w", err)
+	}
+	if len(counters) == 0 {
+		return errors.New("failed to read TCP counters: no Tcp entry in /proc/net/snmp")
+	}
+	stats := counters[0].Stats
+	s.mb.RecordSystemNetworkTcpOpensDataPoint(now, stats["ActiveOpens"], metadata.AttributeTypeActive)
+	s.mb.RecordSystemNetworkTcpOpensDataPoint(now, stats["PassiveOpens"], metadata.AttributeTypePassive)
+	s.mb.RecordSystemNetworkTcpSegmentsRetransmittedDataPoint(now, stats["RetransSegs"])
+	s.mb.RecordSystemNetworkTcpSocketsDataPoint(now, stats["CurrEstab"], "established")
+
+	if !s.config.Metrics.SystemNetworkTcpSockets.Enabled {
+		return nil
+	}
+	sockstat, err := s.tcpSockstat(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read TCP socket counts: %w", err)
+	}
+	s.mb.RecordSystemNetworkTcpSocketsDataPoint(now, sockstat.timeWait, "time_wait")
+	s.mb.RecordSystemNetworkTcpSocketsDataPoint(now, sockstat.orphan, "orphaned")
+	return nil
+}
+
+// readTCPSockstat reads the number of TCP sockets in the TIME_WAIT state, and of orphaned TCP sockets, which are no
+// longer attached to a file descriptor, from the `TCP:` line of /proc/net/sockstat.
+func readTCPSockstat(ctx context.Context) (tcpSockstat, error) {
+	data, err := os.ReadFile(filepath.Join(internal.ProcPath(ctx), "net", "sockstat"))
+	if err != nil {
+		return tcpSockstat{}, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != "TCP:" {
+			continue
+		}
+		var sockstat tcpSockstat
+		for i := 1; i+1 < len(fields); i += 2 {
+			var target *int64
+			switch fields[i] {
+			case "orphan":
+				target = &sockstat.orphan
+			case "tw":
+				target = &sockstat.timeWait
+			default:
+				continue
+			}
+			if *target, err = strconv.ParseInt(fields[i+1], 10, 64); err != nil {
+				return tcpSockstat{}, fmt.Errorf("failed to parse %s of %q: %w", fields[i], line, err)
+			}
+		}
+		return sockstat, nil
+	}
+	return tcpSockstat{}, errors.New("no TCP entry in sockstat")
+}
+
+// readInterfaces reads the link settings of the network interfaces from /sys/class/net. The speed is
+// reported by the kernel in Mbit/s, and is unknown for virtual interfaces and interfaces whose link is down.
+func readInterfaces(ctx context.Context) ([]interfaceInfo, error) {
+	paths, err := filepath.Glob(filepath.Join(internal.SysPath(ctx), "class", "net", "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := make([]interfaceInfo, 0, len(paths))
+	for _, path := range paths {
+		iface := interfaceInfo{name: filepath.Base(path), speed: -1, duplex: metadata.AttributeDuplexUnknown}
+
+		mtu, err := readSysFile(filepath.Join(path, "mtu"))
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ENOTDIR) {
+			// the interface was removed, or the entry is not an interface (e.g. bonding_masters)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if iface.mtu, err = strconv.ParseInt(mtu, 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse mtu of %s: %w", iface.name, err)
+		}
+
+		iface.operstate = metadata.AttributeOperstateUnknown
+		if operstate, err := readSysFile(filepath.Join(path, "operstate")); err == nil {
+			if value, ok := metadata.MapAttributeOperstate[operstate]; ok {
+				iface.operstate = value
+			}
+		}
+
+		// reading speed and duplex fails with EINVAL when the link is down
+		if speed, err := readSysFile(filepath.Join(path, "speed")); err == nil {
+			if mbps, err := strconv.ParseInt(speed, 10, 64); err == nil && mbps > 0 {
+				iface.speed = mbps * 1_000_000 / 8
+			}
+		}
+		if duplex, err := readSysFile(filepath.Join(path, "duplex")); err == nil {
+			if value, ok := metadata.MapAttributeDuplex[duplex]; ok {
+				iface.duplex = value
+			}
+		}
+
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces, nil
+}
+
+// readCauses breaks the dropped and erroneous packets of a network interface down by cause, from its statistics in
+// /sys/class/net, along the lines of /proc/net/dev. Receive drops are split between the packets missed by the NIC
+// (`missed`) and the ones dropped by the kernel (`other`). Receive errors are split between FIFO overruns (`fifo`),
+// framing, length and CRC errors (`frame`) and the rest (`other`), and transmit errors between FIFO underruns
+// (`fifo`), carrier errors (`carrier`) and the rest (`other`), so that the causes add up to the totals. Collisions are
+// left out, as the kernel does not count them in the errors of the interface.
+func readCauses(ctx context.Context, device string) ([]causeCount, error) {
+	path := filepath.Join(internal.SysPath(ctx), "class", "net", device, "statistics")
+	stats := make(map[string]int64)
+	for _, name := range []string{
+		"rx_dropped", "rx_missed_errors", "tx_dropped",
+		"rx_errors", "rx_fifo_errors", "rx_frame_errors", "rx_length_errors", "rx_over_errors", "rx_crc_errors",
+		"tx_errors", "tx_fifo_errors", "tx_carrier_errors", "tx_aborted_errors", "tx_window_errors", "tx_heartbeat_errors",
+	} {
+		value, err := readSysFile(filepath.Join(path, name))
+		if err != nil {
+			return nil, err
+		}
+		if stats[name], err = strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+	}
+
+	rxFrame := stats["rx_frame_errors"] + stats["rx_length_errors"] + stats["rx_over_errors"] + stats["rx_crc_errors"]
+	txCarrier := stats["tx_carrier_errors"] + stats["tx_aborted_errors"] + stats["tx_window_errors"] + stats["tx_heartbeat_errors"]
+	return []causeCount{
+		{"system.network.dropped", metadata.AttributeDirectionReceive, "missed", stats["rx_missed_errors"]},
+		{"system.network.dropped", metadata.AttributeDirectionReceive, "other", stats["rx_dropped"]},
+		{"system.network.dropped", metadata.AttributeDirectionTransmit, "other", stats["tx_dropped"]},
+		{"system.network.errors", metadata.AttributeDirectionReceive, "fifo", stats["rx_fifo_errors"]},
+		{"system.network.errors", metadata.AttributeDirectionReceive, "frame", rxFrame},
+		{"system.network.errors", metadata.AttributeDirectionReceive, "other", max(stats["rx_errors"]-stats["rx_fifo_errors"]-rxFrame, 0)},
+		{"system.network.errors", metadata.AttributeDirectionTransmit, "fifo", stats["tx_fifo_errors"]},
+		{"system.network.errors", metadata.AttributeDirectionTransmit, "carrier", txCarrier},
+		{"system.network.errors", metadata.AttributeDirectionTransmit, "other", max(stats["tx_errors"]-stats["tx_fifo_errors"]-txCarrier, 0)},
+	}, nil
+}
+
+// readHierarchy reads the kind of a network interface from the DEVTYPE of its uevent, which the kernel sets for
+// bonds, bridges, VLANs and other virtual devices, falling back to `physical` for the interfaces backed by a device,
+// and `virtual` for the others, and the bond or bridge it is a member of from its master link.
+func readHierarchy(ctx context.Context, device string) interfaceHierarchy {
+	path := filepath.Join(internal.SysPath(ctx), "class", "net", device)
+	hierarchy := interfaceHierarchy{kind: "virtual"}
+	if _, err := os.Stat(filepath.Join(path, "device")); err == nil {
+		hierarchy.kind = "physical"
+	}
+	if uevent, err := os.ReadFile(filepath.Join(path, "uevent")); err == nil {
+		for _, line := range strings.Split(string(uevent), "\n") {
+			if devtype, ok := strings.CutPrefix(line, "DEVTYPE="); ok && devtype != "" {
+				hierarchy.kind = devtype
+			}
+		}
+	}
+	if master, err := os.Readlink(filepath.Join(path, "master")); err == nil {
+		hierarchy.master = filepath.Base(master)
+	}
+	return hierarchy
+}
+
+func readSysFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package networkscraper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/net"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper/internal/metadata"
+)
+
+func TestReadInterfaces(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+	writeFile := func(iface, name, value string) {
+		path := filepath.Join(sysPath, "class", "net", iface)
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(value+"\n"), 0o600))
+	}
+	writeFile("eth0", "mtu", "9000")
+	writeFile("eth0", "operstate", "up")
+	writeFile("eth0", "speed", "10000")
+	writeFile("eth0", "duplex", "full")
+	// link down: speed is -1 and duplex unknown
+	writeFile("eth1", "mtu", "1500")
+	writeFile("eth1", "operstate", "down")
+	writeFile("eth1", "speed", "-1")
+	writeFile("eth1", "duplex", "unknown")
+	// virtual interface without speed and duplex
+	writeFile("lo", "mtu", "65536")
+	writeFile("lo", "operstate", "unknown")
+	require.NoError(t, os.WriteFile(filepath.Join(sysPath, "class", "net", "bonding_masters"), []byte("bond0\n"), 0o600))
+
+	interfaces, err := readInterfaces(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []interfaceInfo{
+		{name: "eth0", speed: 1_250_000_000, duplex: metadata.AttributeDuplexFull, operstate: metadata.AttributeOperstateUp, mtu: 9000},
+		{name: "eth1", speed: -1, duplex: metadata.AttributeDuplexUnknown, operstate: metadata.AttributeOperstateDown, mtu: 1500},
+		{name: "lo", speed: -1, duplex: metadata.AttributeDuplexUnknown, operstate: metadata.AttributeOperstateUnknown, mtu: 65536},
+	}, interfaces)
+
+	writeFile("eth2", "mtu", "garbage")
+	_, err = readInterfaces(ctx)
+	assert.EqualError(t, err, `failed to parse mtu of eth2: strconv.ParseInt: parsing "garbage": invalid syntax`)
+}
+
+func TestReadCauses(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+	path := filepath.Join(sysPath, "class", "net", "eth0", "statistics")
+	require.NoError(t, os.MkdirAll(path, 0o755))
+	for name, value := range map[string]string{
+		"rx_dropped": "4", "rx_missed_errors": "10", "tx_dropped": "1",
+		"rx_errors": "20", "rx_fifo_errors": "3", "rx_frame_errors": "1", "rx_length_errors": "2", "rx_over_errors": "0", "rx_crc_errors": "5",
+		"tx_errors": "6", "tx_fifo_errors": "1", "tx_carrier_errors": "2", "tx_aborted_errors": "1", "tx_window_errors": "0", "tx_heartbeat_errors": "0",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(value+"\n"), 0o600))
+	}
+
+	causes, err := readCauses(ctx, "eth0")
+	require.NoError(t, err)
+	assert.Equal(t, []causeCount{
+		{"system.network.dropped", metadata.AttributeDirectionReceive, "missed", 10},
+		{"system.network.dropped", metadata.AttributeDirectionReceive, "other", 4},
+		{"system.network.dropped", metadata.AttributeDirectionTransmit, "other", 1},
+		{"system.network.errors", metadata.AttributeDirectionReceive, "fifo", 3},
+		{"system.network.errors", metadata.AttributeDirectionReceive, "frame", 8},
+		{"system.network.errors", metadata.AttributeDirectionReceive, "other", 9},
+		{"system.network.errors", metadata.AttributeDirectionTransmit, "fifo", 1},
+		{"system.network.errors", metadata.AttributeDirectionTransmit, "carrier", 3},
+		{"system.network.errors", metadata.AttributeDirectionTransmit, "other", 2},
+	}, causes)
+
+	_, err = readCauses(ctx, "eth1")
+	assert.Error(t, err)
+}
+
+func TestScrape_TCPMetrics(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.SystemNetworkTcpOpens.Enabled = true
+	mbc.Metrics.SystemNetworkTcpSegmentsRetransmitted.Enabled = true
+	mbc.Metrics.SystemNetworkTcpSockets.Enabled = true
+	scraper, err := newNetworkScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: mbc})
+	require.NoError(t, err)
+	scraper.protoCounters = func(_ context.Context, protocols []string) ([]net.ProtoCountersStat, error) {
+		assert.Equal(t, []string{"tcp"}, protocols)
+		return []net.ProtoCountersStat{{Protocol: "tcp", Stats: map[string]int64{
+			"ActiveOpens": 120, "PassiveOpens": 80, "RetransSegs": 17, "CurrEstab": 9,
+		}}}, nil
+	}
+	scraper.tcpSockstat = func(context.Context) (tcpSockstat, error) {
+		return tcpSockstat{orphan: 2, timeWait: 31}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	values := make(map[string]int64)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Type() != pmetric.MetricTypeSum {
+			continue
+		}
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			key := metrics.At(i).Name()
+			if attr, ok := dps.At(j).Attributes().Get("type"); ok {
+				key += " " + attr.Str()
+			}
+			if attr, ok := dps.At(j).Attributes().Get("state"); ok {
+				key += " " + attr.Str()
+			}
+			values[key] = dps.At(j).IntValue()
+		}
+	}
+	assert.Equal(t, int64(120), values["system.network.tcp.opens active"])
+	assert.Equal(t, int64(80), values["system.network.tcp.opens passive"])
+	assert.Equal(t, int64(17), values["system.network.tcp.segments.retransmitted"])
+	assert.Equal(t, int64(9), values["system.network.tcp.sockets established"])
+	assert.Equal(t, int64(31), values["system.network.tcp.sockets time_wait"])
+	assert.Equal(t, int64(2), values["system.network.tcp.sockets orphaned"])
+
+	scraper.tcpSockstat = func(context.Context) (tcpSockstat, error) { return tcpSockstat{}, errors.New("err1") }
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read TCP socket counts: err1")
+}
+
+func TestReadTCPSockstat(t *testing.T) {
+	procPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: procPath})
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "net"), 0o755))
+	writeSockstat := func(content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(procPath, "net", "sockstat"), []byte(content), 0o600))
+	}
+
+	writeSockstat("sockets: used 312\nTCP: inuse 14 orphan 2 tw 31 alloc 20 mem 3\nUDP: inuse 5 mem 2\n")
+	sockstat, err := readTCPSockstat(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, tcpSockstat{orphan: 2, timeWait: 31}, sockstat)
+
+	writeSockstat("TCP: inuse 14 orphan x tw 31 alloc 20 mem 3\n")
+	_, err = readTCPSockstat(ctx)
+	assert.Error(t, err)
+
+	writeSockstat("sockets: used 312\n")
+	_, err = readTCPSockstat(ctx)
+	assert.EqualError(t, err, "no TCP entry in sockstat")
+}
+
+func TestReadHierarchy(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+	netPath := filepath.Join(sysPath, "class", "net")
+	writeUevent := func(iface, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(netPath, iface), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(netPath, iface, "uevent"), []byte(content), 0o600))
+	}
+	writeUevent("bond0", "DEVTYPE=bond\nINTERFACE=bond0\nIFINDEX=4\n")
+	writeUevent("eth0", "INTERFACE=eth0\nIFINDEX=2\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "devices", "pci0000:00", "0000:00:03.0"), 0o755))
+	require.NoError(t, os.Symlink("../../../devices/pci0000:00/0000:00:03.0", filepath.Join(netPath, "eth0", "device")))
+	require.NoError(t, os.Symlink("../bond0", filepath.Join(netPath, "eth0", "master")))
+	writeUevent("eth0.100", "DEVTYPE=vlan\nINTERFACE=eth0.100\n")
+	writeUevent("lo", "INTERFACE=lo\nIFINDEX=1\n")
+
+	assert.Equal(t, interfaceHierarchy{kind: "bond"}, readHierarchy(ctx, "bond0"))
+	assert.Equal(t, interfaceHierarchy{kind: "physical", master: "bond0"}, readHierarchy(ctx, "eth0"))
+	assert.Equal(t, interfaceHierarchy{kind: "vlan"}, readHierarchy(ctx, "eth0.100"))
+	assert.Equal(t, interfaceHierarchy{kind: "virtual"}, readHierarchy(ctx, "lo"))
+}
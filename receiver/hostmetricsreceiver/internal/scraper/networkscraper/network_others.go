@@ -5,6 +5,11 @@
 
 package networkscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 
+import (
+	"context"
+	"errors"
+)
+
 var allTCPStates = []string{
 	"CLOSE_WAIT",
 	"CLOSED",
@@ -23,3 +28,23 @@ var allTCPStates = []string{
 func (s *scraper) recordNetworkConntrackMetrics() error {
 	return nil
 }
+
+func (s *scraper) recordNetworkTCPMetrics() error {
+	return nil
+}
+
+func readTCPSockstat(context.Context) (tcpSockstat, error) {
+	return tcpSockstat{}, errors.New("sockstat is only supported on Linux")
+}
+
+func readInterfaces(context.Context) ([]interfaceInfo, error) {
+	return nil, errors.New("network interface settings are only supported on Linux")
+}
+
+func readCauses(context.Context, string) ([]causeCount, error) {
+	return nil, errors.New("report_cause is only supported on Linux")
+}
+
+func readHierarchy(context.Context, string) interfaceHierarchy {
+	return interfaceHierarchy{kind: "unknown"}
+}
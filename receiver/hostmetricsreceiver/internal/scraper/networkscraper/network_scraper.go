@@ -16,14 +16,25 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper/internal/metadata"
 )
 
 const (
 	networkMetricsLen     = 4
 	connectionsMetricsLen = 1
+	interfaceMetricsLen   = 3
+	causeMetricsLen       = 2
+	tcpMetricsLen         = 3
+	socketMetricsLen      = 3
+
+	causeAttribute  = "cause"
+	typeAttribute   = "network.type"
+	masterAttribute = "network.master"
 )
 
 // scraper for Network Metrics
@@ -35,22 +46,84 @@ type scraper struct {
 	includeFS filterset.FilterSet
 	excludeFS filterset.FilterSet
 
+	filterTelemetry *internal.FilterTelemetry
+
+	// tracer is nil when the socket metrics are disabled, or the eBPF programs collecting them could not be loaded.
+	tracer socketTracer
+
 	// for mocking
-	bootTime    func(context.Context) (uint64, error)
-	ioCounters  func(context.Context, bool) ([]net.IOCountersStat, error)
-	connections func(context.Context, string) ([]net.ConnectionStat, error)
-	conntrack   func(context.Context) ([]net.FilterStat, error)
+	bootTime      func(context.Context) (uint64, error)
+	ioCounters    func(context.Context, bool) ([]net.IOCountersStat, error)
+	connections   func(context.Context, string) ([]net.ConnectionStat, error)
+	conntrack     func(context.Context) ([]net.FilterStat, error)
+	interfaces    func(context.Context) ([]interfaceInfo, error)
+	causes        func(context.Context, string) ([]causeCount, error)
+	protoCounters func(context.Context, []string) ([]net.ProtoCountersStat, error)
+	tcpSockstat   func(context.Context) (tcpSockstat, error)
+	hierarchy     func(context.Context, string) interfaceHierarchy
+	socketTracer  func(context.Context) (socketTracer, error)
+}
+
+// socketTracer counts the TCP connections, bytes and retransmits of each service port, the lower of the local and
+// remote ports of the connections.
+type socketTracer interface {
+	read() (map[int64]socketStats, error)
+	close() error
+}
+
+// socketStats holds the counters of the TCP connections of a service port, laid out as in the map of the eBPF
+// programs of the socket metrics.
+type socketStats struct {
+	Connections uint64
+	Transmit    uint64
+	Receive     uint64
+	Retransmits uint64
+}
+
+// interfaceHierarchy tells the kind of a network interface, and the bond or bridge it is a member of, if any.
+type interfaceHierarchy struct {
+	kind   string
+	master string
+}
+
+// tcpSockstat holds the TCP socket counts of /proc/net/sockstat.
+type tcpSockstat struct {
+	orphan   int64
+	timeWait int64
+}
+
+// causeCount is the number of packets of a network interface that were dropped, or had errors, for a cause.
+type causeCount struct {
+	metric    string
+	direction metadata.AttributeDirection
+	cause     string
+	count     int64
+}
+
+// interfaceInfo holds the link settings of a network interface.
+type interfaceInfo struct {
+	name      string
+	speed     int64 // in bytes per second, or -1 if unknown
+	duplex    metadata.AttributeDuplex
+	operstate metadata.AttributeOperstate
+	mtu       int64
 }
 
 // newNetworkScraper creates a set of Network related metrics
 func newNetworkScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) (*scraper, error) {
 	scraper := &scraper{
-		settings:    settings,
-		config:      cfg,
-		bootTime:    host.BootTimeWithContext,
-		ioCounters:  net.IOCountersWithContext,
-		connections: net.ConnectionsWithContext,
-		conntrack:   net.FilterCountersWithContext,
+		settings:      settings,
+		config:        cfg,
+		bootTime:      host.BootTimeWithContext,
+		ioCounters:    net.IOCountersWithContext,
+		connections:   net.ConnectionsWithContext,
+		conntrack:     net.FilterCountersWithContext,
+		interfaces:    readInterfaces,
+		causes:        readCauses,
+		protoCounters: net.ProtoCountersWithContext,
+		tcpSockstat:   readTCPSockstat,
+		hierarchy:     readHierarchy,
+		socketTracer:  newSocketTracer,
 	}
 
 	var err error
@@ -69,6 +142,10 @@ func newNetworkScraper(_ context.Context, settings receiver.CreateSettings, cfg
 		}
 	}
 
+	if scraper.filterTelemetry, err = internal.NewFilterTelemetry(settings.TelemetrySettings, TypeStr); err != nil {
+		return nil, err
+	}
+
 	return scraper, nil
 }
 
@@ -81,9 +158,23 @@ func (s *scraper) start(ctx context.Context, _ component.Host) error {
 
 	s.startTime = pcommon.Timestamp(bootTime * 1e9)
 	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+
+	if s.config.Metrics.SystemNetworkSocketConnections.Enabled || s.config.Metrics.SystemNetworkSocketIo.Enabled ||
+		s.config.Metrics.SystemNetworkSocketRetransmits.Enabled {
+		if s.tracer, err = s.socketTracer(ctx); err != nil {
+			s.settings.Logger.Warn("Failed to load the eBPF programs of the socket metrics, which will not be reported", zap.Error(err))
+		}
+	}
 	return nil
 }
 
+func (s *scraper) shutdown(_ context.Context) error {
+	if s.tracer == nil {
+		return nil
+	}
+	return s.tracer.close()
+}
+
 func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	var errors scrapererror.ScrapeErrors
 
@@ -102,7 +193,144 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		errors.AddPartial(connectionsMetricsLen, err)
 	}
 
-	return s.mb.Emit(), errors.Combine()
+	err = s.recordNetworkInterfaceMetrics()
+	if err != nil {
+		errors.AddPartial(interfaceMetricsLen, err)
+	}
+
+	err = s.recordNetworkTCPMetrics()
+	if err != nil {
+		errors.AddPartial(tcpMetricsLen, err)
+	}
+
+	err = s.recordNetworkSocketMetrics()
+	if err != nil {
+		errors.AddPartial(socketMetricsLen, err)
+	}
+
+	md := s.mb.Emit()
+	if s.config.ReportCause {
+		err = s.splitByCause(md)
+		if err != nil {
+			errors.AddPartial(causeMetricsLen, err)
+		}
+	}
+
+	if s.config.ReportHierarchy || s.config.ExcludeMembers {
+		s.applyHierarchy(md)
+	}
+
+	return md, errors.Combine()
+}
+
+// applyHierarchy removes the data points of the members of bonds and bridges from the counter metrics of md when
+// ExcludeMembers is set, and sets the hierarchy attributes of the data points of every interface when ReportHierarchy
+// is set.
+func (s *scraper) applyHierarchy(md pmetric.Metrics) {
+	if md.ResourceMetrics().Len() == 0 {
+		return
+	}
+	ctx := context.WithValue(context.Background(), common.EnvKey, s.config.EnvMap)
+
+	hierarchies := make(map[string]interfaceHierarchy)
+	hierarchyOf := func(dp pmetric.NumberDataPoint) (interfaceHierarchy, bool) {
+		device, ok := dp.Attributes().Get("device")
+		if !ok {
+			return interfaceHierarchy{}, false
+		}
+		hierarchy, ok := hierarchies[device.Str()]
+		if !ok {
+			hierarchy = s.hierarchy(ctx, device.Str())
+			hierarchies[device.Str()] = hierarchy
+		}
+		return hierarchy, true
+	}
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		var dps pmetric.NumberDataPointSlice
+		switch metric.Type() {
+		case pmetric.MetricTypeGauge:
+			dps = metric.Gauge().DataPoints()
+		case pmetric.MetricTypeSum:
+			dps = metric.Sum().DataPoints()
+		default:
+			continue
+		}
+		switch metric.Name() {
+		case "system.network.packets", "system.network.dropped", "system.network.errors", "system.network.io":
+			if s.config.ExcludeMembers {
+				dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+					hierarchy, ok := hierarchyOf(dp)
+					return ok && hierarchy.master != ""
+				})
+			}
+		}
+		if !s.config.ReportHierarchy {
+			continue
+		}
+		for j := 0; j < dps.Len(); j++ {
+			if hierarchy, ok := hierarchyOf(dps.At(j)); ok {
+				dps.At(j).Attributes().PutStr(typeAttribute, hierarchy.kind)
+				if hierarchy.master != "" {
+					dps.At(j).Attributes().PutStr(masterAttribute, hierarchy.master)
+				}
+			}
+		}
+	}
+	metrics.RemoveIf(func(metric pmetric.Metric) bool {
+		return metric.Type() == pmetric.MetricTypeSum && metric.Sum().DataPoints().Len() == 0
+	})
+}
+
+// splitByCause replaces each data point of the dropped and errors metrics of md with one data point per cause,
+// holding a `cause` attribute. The data points of the interfaces whose causes cannot be read are left as is.
+func (s *scraper) splitByCause(md pmetric.Metrics) error {
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	ctx := context.WithValue(context.Background(), common.EnvKey, s.config.EnvMap)
+
+	var errs error
+	causesByDevice := make(map[string][]causeCount)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != "system.network.dropped" && metric.Name() != "system.network.errors" {
+			continue
+		}
+		dps := pmetric.NewNumberDataPointSlice()
+		metric.Sum().DataPoints().MoveAndAppendTo(dps)
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			device, _ := dp.Attributes().Get("device")
+			direction, _ := dp.Attributes().Get("direction")
+			causes, ok := causesByDevice[device.Str()]
+			if !ok {
+				var err error
+				if causes, err = s.causes(ctx, device.Str()); err != nil {
+					errs = multierr.Append(errs, fmt.Errorf("failed to read drop and error causes of %s: %w", device.Str(), err))
+				}
+				causesByDevice[device.Str()] = causes
+			}
+			split := false
+			for _, cause := range causes {
+				if cause.metric != metric.Name() || cause.direction.String() != direction.Str() {
+					continue
+				}
+				causeDP := metric.Sum().DataPoints().AppendEmpty()
+				dp.CopyTo(causeDP)
+				causeDP.SetIntValue(cause.count)
+				causeDP.Attributes().PutStr(causeAttribute, cause.cause)
+				split = true
+			}
+			if !split {
+				dp.CopyTo(metric.Sum().DataPoints().AppendEmpty())
+			}
+		}
+	}
+	return errs
 }
 
 func (s *scraper) recordNetworkCounterMetrics() error {
@@ -116,7 +344,7 @@ func (s *scraper) recordNetworkCounterMetrics() error {
 	}
 
 	// filter network interfaces by name
-	ioCounters = s.filterByInterface(ioCounters)
+	ioCounters = s.filterByInterface(ctx, ioCounters)
 
 	if len(ioCounters) > 0 {
 		s.recordNetworkPacketsMetric(now, ioCounters)
@@ -175,6 +403,60 @@ func (s *scraper) recordNetworkConnectionsMetrics() error {
 	return nil
 }
 
+func (s *scraper) recordNetworkInterfaceMetrics() error {
+	if !s.config.Metrics.SystemNetworkInterfaceSpeed.Enabled && !s.config.Metrics.SystemNetworkInterfaceState.Enabled &&
+		!s.config.Metrics.SystemNetworkInterfaceMtu.Enabled {
+		return nil
+	}
+
+	ctx := context.WithValue(context.Background(), common.EnvKey, s.config.EnvMap)
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	interfaces, err := s.interfaces(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read network interfaces: %w", err)
+	}
+
+	for _, iface := range interfaces {
+		if !s.includeInterface(iface.name) {
+			continue
+		}
+		if iface.speed >= 0 {
+			s.mb.RecordSystemNetworkInterfaceSpeedDataPoint(now, iface.speed, iface.name, iface.duplex)
+		}
+		for operstate := range metadata.MapAttributeOperstate {
+			var value int64
+			if operstate == iface.operstate.String() {
+				value = 1
+			}
+			s.mb.RecordSystemNetworkInterfaceStateDataPoint(now, value, iface.name, metadata.MapAttributeOperstate[operstate])
+		}
+		s.mb.RecordSystemNetworkInterfaceMtuDataPoint(now, iface.mtu, iface.name)
+	}
+	return nil
+}
+
+func (s *scraper) recordNetworkSocketMetrics() error {
+	if s.tracer == nil {
+		return nil
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	stats, err := s.tracer.read()
+	if err != nil {
+		return fmt.Errorf("failed to read socket stats: %w", err)
+	}
+
+	for port, portStats := range stats {
+		s.mb.RecordSystemNetworkSocketConnectionsDataPoint(now, int64(portStats.Connections), port)
+		s.mb.RecordSystemNetworkSocketIoDataPoint(now, int64(portStats.Transmit), port, metadata.AttributeDirectionTransmit)
+		s.mb.RecordSystemNetworkSocketIoDataPoint(now, int64(portStats.Receive), port, metadata.AttributeDirectionReceive)
+		s.mb.RecordSystemNetworkSocketRetransmitsDataPoint(now, int64(portStats.Retransmits), port)
+	}
+	return nil
+}
+
 func getTCPConnectionStatusCounts(connections []net.ConnectionStat) map[string]int64 {
 	tcpStatuses := make(map[string]int64, len(allTCPStates))
 	for _, state := range allTCPStates {
@@ -193,7 +475,7 @@ func (s *scraper) recordNetworkConnectionsMetric(now pcommon.Timestamp, connecti
 	}
 }
 
-func (s *scraper) filterByInterface(ioCounters []net.IOCountersStat) []net.IOCountersStat {
+func (s *scraper) filterByInterface(ctx context.Context, ioCounters []net.IOCountersStat) []net.IOCountersStat {
 	if s.includeFS == nil && s.excludeFS == nil {
 		return ioCounters
 	}
@@ -204,6 +486,7 @@ func (s *scraper) filterByInterface(ioCounters []net.IOCountersStat) []net.IOCou
 			filteredIOCounters = append(filteredIOCounters, io)
 		}
 	}
+	s.filterTelemetry.RecordFiltered(ctx, len(ioCounters)-len(filteredIOCounters))
 	return filteredIOCounters
 }
 
@@ -6,6 +6,7 @@ package networkscraper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/shirou/gopsutil/v3/net"
@@ -235,3 +236,286 @@ func assertNetworkConnectionsMetricValid(t *testing.T, metric pmetric.Metric) {
 	assert.LessOrEqual(t, 12, metric.Sum().DataPoints().Len())
 	assert.GreaterOrEqual(t, 13, metric.Sum().DataPoints().Len())
 }
+
+func TestScrape_InterfaceMetrics(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.SystemNetworkInterfaceSpeed.Enabled = true
+	mbc.Metrics.SystemNetworkInterfaceState.Enabled = true
+	mbc.Metrics.SystemNetworkInterfaceMtu.Enabled = true
+	cfg := &Config{
+		MetricsBuilderConfig: mbc,
+		Exclude:              MatchConfig{filterset.Config{MatchType: "strict"}, []string{"lo"}},
+	}
+	scraper, err := newNetworkScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	scraper.interfaces = func(context.Context) ([]interfaceInfo, error) {
+		return []interfaceInfo{
+			{name: "lo", speed: -1, duplex: metadata.AttributeDuplexUnknown, operstate: metadata.AttributeOperstateUnknown, mtu: 65536},
+			{name: "eth0", speed: 1_250_000_000, duplex: metadata.AttributeDuplexFull, operstate: metadata.AttributeOperstateUp, mtu: 9000},
+			{name: "eth1", speed: -1, duplex: metadata.AttributeDuplexUnknown, operstate: metadata.AttributeOperstateDown, mtu: 1500},
+		}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var found int
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Type() != pmetric.MetricTypeGauge {
+			continue
+		}
+		dps := metrics.At(i).Gauge().DataPoints()
+		switch metrics.At(i).Name() {
+		case "system.network.interface.speed":
+			found++
+			require.Equal(t, 1, dps.Len())
+			assert.Equal(t, int64(1_250_000_000), dps.At(0).IntValue())
+			assert.Equal(t, map[string]any{"device": "eth0", "duplex": "full"}, dps.At(0).Attributes().AsRaw())
+		case "system.network.interface.state":
+			found++
+			require.Equal(t, 2*len(metadata.MapAttributeOperstate), dps.Len())
+			states := make(map[string]int64)
+			for j := 0; j < dps.Len(); j++ {
+				attrs := dps.At(j).Attributes().AsRaw()
+				states[attrs["device"].(string)+" "+attrs["operstate"].(string)] = dps.At(j).IntValue()
+			}
+			assert.Equal(t, int64(1), states["eth0 up"])
+			assert.Equal(t, int64(0), states["eth0 down"])
+			assert.Equal(t, int64(1), states["eth1 down"])
+			assert.Equal(t, int64(0), states["eth1 up"])
+		case "system.network.interface.mtu":
+			found++
+			require.Equal(t, 2, dps.Len())
+			assert.Equal(t, int64(9000), dps.At(0).IntValue())
+			assert.Equal(t, int64(1500), dps.At(1).IntValue())
+		}
+	}
+	assert.Equal(t, 3, found)
+
+	scraper.interfaces = func(context.Context) ([]interfaceInfo, error) { return nil, errors.New("err1") }
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read network interfaces: err1")
+	var scraperErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &scraperErr)
+	assert.Equal(t, interfaceMetricsLen, scraperErr.Failed)
+}
+
+func TestScrape_ReportCause(t *testing.T) {
+	scraper, err := newNetworkScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ReportCause:          true,
+	})
+	require.NoError(t, err)
+	scraper.ioCounters = func(context.Context, bool) ([]net.IOCountersStat, error) {
+		return []net.IOCountersStat{
+			{Name: "eth0", Dropin: 12, Errin: 7, Errout: 3},
+			{Name: "eth1", Dropin: 1},
+		}, nil
+	}
+	scraper.causes = func(_ context.Context, device string) ([]causeCount, error) {
+		if device == "eth1" {
+			return nil, errors.New("err1")
+		}
+		return []causeCount{
+			{"system.network.dropped", metadata.AttributeDirectionReceive, "missed", 10},
+			{"system.network.dropped", metadata.AttributeDirectionReceive, "other", 2},
+			{"system.network.errors", metadata.AttributeDirectionReceive, "fifo", 5},
+			{"system.network.errors", metadata.AttributeDirectionReceive, "other", 2},
+		}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read drop and error causes of eth1: err1")
+	var scraperErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &scraperErr)
+	assert.Equal(t, causeMetricsLen, scraperErr.Failed)
+
+	values := make(map[string]map[string]int64)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "system.network.connections" {
+			continue
+		}
+		values[metrics.At(i).Name()] = make(map[string]int64)
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			attrs := dps.At(j).Attributes().AsRaw()
+			key := attrs["device"].(string) + " " + attrs["direction"].(string)
+			if cause, ok := attrs["cause"]; ok {
+				key += " " + cause.(string)
+			}
+			values[metrics.At(i).Name()][key] = dps.At(j).IntValue()
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"eth0 receive missed": 10,
+		"eth0 receive other":  2,
+		"eth0 transmit":       0,
+		"eth1 receive":        1,
+		"eth1 transmit":       0,
+	}, values["system.network.dropped"])
+	assert.Equal(t, map[string]int64{
+		"eth0 receive fifo":  5,
+		"eth0 receive other": 2,
+		"eth0 transmit":      3,
+		"eth1 receive":       0,
+		"eth1 transmit":      0,
+	}, values["system.network.errors"])
+	assert.Len(t, values["system.network.io"], 4)
+}
+
+func TestScrape_Hierarchy(t *testing.T) {
+	hierarchies := map[string]interfaceHierarchy{
+		"bond0": {kind: "bond"},
+		"eth0":  {kind: "physical", master: "bond0"},
+		"eth1":  {kind: "physical", master: "bond0"},
+		"vlan5": {kind: "vlan"},
+	}
+	ioCounters := func(context.Context, bool) ([]net.IOCountersStat, error) {
+		return []net.IOCountersStat{{Name: "bond0"}, {Name: "eth0"}, {Name: "eth1"}, {Name: "vlan5"}}, nil
+	}
+
+	testCases := []struct {
+		name            string
+		reportHierarchy bool
+		excludeMembers  bool
+		expected        map[string]map[string]any
+	}{
+		{
+			name:            "report hierarchy",
+			reportHierarchy: true,
+			expected: map[string]map[string]any{
+				"bond0": {"device": "bond0", "direction": "transmit", "network.type": "bond"},
+				"eth0":  {"device": "eth0", "direction": "transmit", "network.type": "physical", "network.master": "bond0"},
+				"eth1":  {"device": "eth1", "direction": "transmit", "network.type": "physical", "network.master": "bond0"},
+				"vlan5": {"device": "vlan5", "direction": "transmit", "network.type": "vlan"},
+			},
+		},
+		{
+			name:           "exclude members",
+			excludeMembers: true,
+			expected: map[string]map[string]any{
+				"bond0": {"device": "bond0", "direction": "transmit"},
+				"vlan5": {"device": "vlan5", "direction": "transmit"},
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraper, err := newNetworkScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				ReportHierarchy:      test.reportHierarchy,
+				ExcludeMembers:       test.excludeMembers,
+			})
+			require.NoError(t, err)
+			scraper.ioCounters = ioCounters
+			scraper.hierarchy = func(_ context.Context, device string) interfaceHierarchy { return hierarchies[device] }
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for _, name := range []string{"system.network.packets", "system.network.dropped", "system.network.errors", "system.network.io"} {
+				metric, err := findMetricByName(metrics, name)
+				require.NoError(t, err)
+				attrs := make(map[string]map[string]any)
+				for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+					raw := metric.Sum().DataPoints().At(i).Attributes().AsRaw()
+					if raw["direction"] == "transmit" {
+						attrs[raw["device"].(string)] = raw
+					}
+				}
+				assert.Equal(t, test.expected, attrs, name)
+			}
+		})
+	}
+}
+
+type fakeSocketTracer struct {
+	stats  map[int64]socketStats
+	err    error
+	closed bool
+}
+
+func (t *fakeSocketTracer) read() (map[int64]socketStats, error) {
+	return t.stats, t.err
+}
+
+func (t *fakeSocketTracer) close() error {
+	t.closed = true
+	return nil
+}
+
+func TestScrape_SocketMetrics(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.SystemNetworkSocketConnections.Enabled = true
+	mbc.Metrics.SystemNetworkSocketIo.Enabled = true
+	mbc.Metrics.SystemNetworkSocketRetransmits.Enabled = true
+	scraper, err := newNetworkScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: mbc})
+	require.NoError(t, err)
+	tracer := &fakeSocketTracer{stats: map[int64]socketStats{
+		443: {Connections: 12, Transmit: 4096, Receive: 1024, Retransmits: 3},
+	}}
+	scraper.socketTracer = func(context.Context) (socketTracer, error) { return tracer, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	values := make(map[string]int64)
+	for _, name := range []string{"system.network.socket.connections", "system.network.socket.io", "system.network.socket.retransmits"} {
+		metric, err := findMetricByName(metrics, name)
+		require.NoError(t, err)
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			dp := metric.Sum().DataPoints().At(i)
+			port, _ := dp.Attributes().Get("port")
+			key := fmt.Sprintf("%s %d", name, port.Int())
+			if direction, ok := dp.Attributes().Get("direction"); ok {
+				key += " " + direction.Str()
+			}
+			values[key] = dp.IntValue()
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"system.network.socket.connections 443": 12,
+		"system.network.socket.io 443 transmit": 4096,
+		"system.network.socket.io 443 receive":  1024,
+		"system.network.socket.retransmits 443": 3,
+	}, values)
+
+	tracer.err = errors.New("err1")
+	_, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read socket stats: err1")
+	var scraperErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &scraperErr)
+	assert.Equal(t, socketMetricsLen, scraperErr.Failed)
+
+	require.NoError(t, scraper.shutdown(context.Background()))
+	assert.True(t, tracer.closed)
+}
+
+func TestScrape_SocketMetricsUnavailable(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.SystemNetworkSocketIo.Enabled = true
+	scraper, err := newNetworkScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: mbc})
+	require.NoError(t, err)
+	scraper.socketTracer = func(context.Context) (socketTracer, error) { return nil, errors.New("operation not permitted") }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	_, err = findMetricByName(md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics(), "system.network.socket.io")
+	assert.Error(t, err)
+	require.NoError(t, scraper.shutdown(context.Background()))
+}
+
+func findMetricByName(metrics pmetric.MetricSlice, name string) (pmetric.Metric, error) {
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i), nil
+		}
+	}
+	return pmetric.Metric{}, fmt.Errorf("no metric found with name %s", name)
+}
@@ -0,0 +1,347 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package networkscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/link"
+	"github.com/cilium/ebpf/rlimit"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+)
+
+// The offsets of the counters of socketStats, which the eBPF programs increment in the values of their map.
+const (
+	connectionsOffset = 0
+	transmitOffset    = 8
+	receiveOffset     = 16
+	retransmitsOffset = 24
+	socketStatsSize   = 32
+)
+
+// skcPortpairOffset is the offset of skc_portpair in struct sock, holding skc_dport, in network byte order, followed
+// by skc_num, the local port, in host byte order. It has been stable across kernel versions, as the first member of
+// struct sock_common after its address pairs and hash.
+const skcPortpairOffset = 12
+
+const (
+	ipprotoTCP     = 6
+	tcpEstablished = 1
+)
+
+// kprobeArgOffsets holds the offsets in struct pt_regs of the registers holding the first three arguments of a
+// function, on the architectures the kprobes are supported on.
+var kprobeArgOffsets = map[string][3]int16{
+	"amd64": {112, 104, 96}, // di, si, dx
+	"arm64": {0, 8, 16},     // regs[0], regs[1], regs[2]
+}
+
+// ebpfSocketTracer counts the TCP connections, bytes and retransmits of each service port with eBPF programs
+// attached to the TCP stack of the kernel:
+//   - the tcp_sendmsg and tcp_cleanup_rbuf kprobes, for the bytes sent and received by the applications,
+//   - the tcp/tcp_retransmit_skb tracepoint, for the retransmitted segments,
+//   - the sock/inet_sock_set_state tracepoint, for the connections reaching the ESTABLISHED state.
+type ebpfSocketTracer struct {
+	stats    *ebpf.Map
+	programs []*ebpf.Program
+	links    []link.Link
+}
+
+// newSocketTracer loads and attaches the eBPF programs of the socket metrics. It fails when the kernel does not
+// support them, or the collector lacks the privileges to load them.
+func newSocketTracer(ctx context.Context) (socketTracer, error) {
+	argOffsets, ok := kprobeArgOffsets[runtime.GOARCH]
+	if !ok {
+		return nil, fmt.Errorf("socket metrics are not supported on %s", runtime.GOARCH)
+	}
+	retransmitFields, err := tracepointFields(internal.SysPath(ctx), "tcp", "tcp_retransmit_skb", "sport", "dport")
+	if err != nil {
+		return nil, err
+	}
+	stateFields, err := tracepointFields(internal.SysPath(ctx), "sock", "inet_sock_set_state", "newstate", "sport", "dport", "protocol")
+	if err != nil {
+		return nil, err
+	}
+	if err = rlimit.RemoveMemlock(); err != nil {
+		return nil, fmt.Errorf("failed to remove the memlock limit: %w", err)
+	}
+
+	t := &ebpfSocketTracer{}
+	t.stats, err = ebpf.NewMap(&ebpf.MapSpec{
+		Name:       "socket_stats",
+		Type:       ebpf.Hash,
+		KeySize:    4,
+		ValueSize:  socketStatsSize,
+		MaxEntries: 1 << 16,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the eBPF map: %w", err)
+	}
+
+	fd := t.stats.FD()
+	attachments := []struct {
+		name         string
+		programType  ebpf.ProgramType
+		instructions asm.Instructions
+		attach       func(*ebpf.Program) (link.Link, error)
+	}{
+		{
+			name:         "sock_transmit",
+			programType:  ebpf.Kprobe,
+			instructions: sendmsgInstructions(fd, argOffsets),
+			attach: func(prog *ebpf.Program) (link.Link, error) {
+				return link.Kprobe("tcp_sendmsg", prog, nil)
+			},
+		},
+		{
+			name:         "sock_receive",
+			programType:  ebpf.Kprobe,
+			instructions: cleanupRbufInstructions(fd, argOffsets),
+			attach: func(prog *ebpf.Program) (link.Link, error) {
+				return link.Kprobe("tcp_cleanup_rbuf", prog, nil)
+			},
+		},
+		{
+			name:         "sock_retransmit",
+			programType:  ebpf.TracePoint,
+			instructions: retransmitInstructions(fd, retransmitFields),
+			attach: func(prog *ebpf.Program) (link.Link, error) {
+				return link.Tracepoint("tcp", "tcp_retransmit_skb", prog, nil)
+			},
+		},
+		{
+			name:         "sock_connect",
+			programType:  ebpf.TracePoint,
+			instructions: setStateInstructions(fd, stateFields),
+			attach: func(prog *ebpf.Program) (link.Link, error) {
+				return link.Tracepoint("sock", "inet_sock_set_state", prog, nil)
+			},
+		},
+	}
+	for _, a := range attachments {
+		prog, err := ebpf.NewProgram(&ebpf.ProgramSpec{
+			Name:         a.name,
+			Type:         a.programType,
+			Instructions: a.instructions,
+			License:      "Dual MIT/GPL",
+		})
+		if err != nil {
+			return nil, multierr.Append(fmt.Errorf("failed to load the %s eBPF program: %w", a.name, err), t.close())
+		}
+		t.programs = append(t.programs, prog)
+		l, err := a.attach(prog)
+		if err != nil {
+			return nil, multierr.Append(fmt.Errorf("failed to attach the %s eBPF program: %w", a.name, err), t.close())
+		}
+		t.links = append(t.links, l)
+	}
+	return t, nil
+}
+
+func (t *ebpfSocketTracer) read() (map[int64]socketStats, error) {
+	stats := make(map[int64]socketStats)
+	var port uint32
+	var value socketStats
+	entries := t.stats.Iterate()
+	for entries.Next(&port, &value) {
+		stats[int64(port)] = value
+	}
+	if err := entries.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the eBPF map: %w", err)
+	}
+	return stats, nil
+}
+
+func (t *ebpfSocketTracer) close() error {
+	var errs error
+	for _, l := range t.links {
+		errs = multierr.Append(errs, l.Close())
+	}
+	for _, prog := range t.programs {
+		errs = multierr.Append(errs, prog.Close())
+	}
+	return multierr.Append(errs, t.stats.Close())
+}
+
+// tracepointFields reads the offsets of the given fields of a tracepoint from its format file in tracefs, which is
+// mounted at /sys/kernel/tracing, or /sys/kernel/debug/tracing on older systems.
+func tracepointFields(sysPath, group, name string, fields ...string) (map[string]int16, error) {
+	var file *os.File
+	var err error
+	for _, tracefs := range []string{"kernel/tracing", "kernel/debug/tracing"} {
+		if file, err = os.Open(filepath.Join(sysPath, tracefs, "events", group, name, "format")); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the format of the %s/%s tracepoint: %w", group, name, err)
+	}
+	defer file.Close()
+
+	offsets := make(map[string]int16)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// e.g. "	field:__u16 sport;	offset:24;	size:2;	signed:0;"
+		var field, offset string
+		for _, part := range strings.Split(strings.TrimSpace(scanner.Text()), ";") {
+			key, value, _ := strings.Cut(strings.TrimSpace(part), ":")
+			switch key {
+			case "field":
+				field = value[strings.LastIndexAny(value, " *")+1:]
+			case "offset":
+				offset = value
+			}
+		}
+		if field == "" || offset == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(offset, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset of the %s field of the %s/%s tracepoint: %w", field, group, name, err)
+		}
+		offsets[field] = int16(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for _, field := range fields {
+		if _, ok := offsets[field]; !ok {
+			return nil, fmt.Errorf("no %s field in the %s/%s tracepoint", field, group, name)
+		}
+	}
+	return offsets, nil
+}
+
+// sendmsgInstructions counts the size argument of tcp_sendmsg(sk, msg, size) as transmitted bytes.
+func sendmsgInstructions(statsFD int, argOffsets [3]int16) asm.Instructions {
+	insns := asm.Instructions{
+		asm.Mov.Reg(asm.R6, asm.R1),
+		asm.LoadMem(asm.R7, asm.R6, argOffsets[2], asm.DWord),
+	}
+	insns = append(insns, sockPortInstructions(argOffsets[0])...)
+	return append(insns, incrementInstructions(statsFD, transmitOffset)...)
+}
+
+// cleanupRbufInstructions counts the copied argument of tcp_cleanup_rbuf(sk, copied), called once an application
+// has read data from a socket, as received bytes.
+func cleanupRbufInstructions(statsFD int, argOffsets [3]int16) asm.Instructions {
+	insns := asm.Instructions{
+		asm.Mov.Reg(asm.R6, asm.R1),
+		asm.LoadMem(asm.R7, asm.R6, argOffsets[1], asm.DWord),
+		// copied is an int, negative on errors
+		asm.LSh.Imm(asm.R7, 32),
+		asm.ArSh.Imm(asm.R7, 32),
+		asm.JSLE.Imm(asm.R7, 0, "exit"),
+	}
+	insns = append(insns, sockPortInstructions(argOffsets[0])...)
+	return append(insns, incrementInstructions(statsFD, receiveOffset)...)
+}
+
+// retransmitInstructions counts the tcp/tcp_retransmit_skb tracepoint hits as retransmitted segments.
+func retransmitInstructions(statsFD int, fields map[string]int16) asm.Instructions {
+	insns := asm.Instructions{
+		asm.Mov.Imm(asm.R7, 1),
+		asm.LoadMem(asm.R8, asm.R1, fields["sport"], asm.Half),
+		asm.LoadMem(asm.R9, asm.R1, fields["dport"], asm.Half),
+	}
+	insns = append(insns, minPortInstructions()...)
+	return append(insns, incrementInstructions(statsFD, retransmitsOffset)...)
+}
+
+// setStateInstructions counts the TCP sockets moving to the ESTABLISHED state, from the sock/inet_sock_set_state
+// tracepoint, as established connections.
+func setStateInstructions(statsFD int, fields map[string]int16) asm.Instructions {
+	insns := asm.Instructions{
+		asm.LoadMem(asm.R2, asm.R1, fields["protocol"], asm.Half),
+		asm.JNE.Imm(asm.R2, ipprotoTCP, "exit"),
+		asm.LoadMem(asm.R2, asm.R1, fields["newstate"], asm.Word),
+		asm.JNE.Imm(asm.R2, tcpEstablished, "exit"),
+		asm.Mov.Imm(asm.R7, 1),
+		asm.LoadMem(asm.R8, asm.R1, fields["sport"], asm.Half),
+		asm.LoadMem(asm.R9, asm.R1, fields["dport"], asm.Half),
+	}
+	insns = append(insns, minPortInstructions()...)
+	return append(insns, incrementInstructions(statsFD, connectionsOffset)...)
+}
+
+// sockPortInstructions reads the service port of the struct sock passed as the kprobe argument at argOffset into R8,
+// given the kprobe context in R6.
+func sockPortInstructions(argOffset int16) asm.Instructions {
+	return append(asm.Instructions{
+		asm.LoadMem(asm.R3, asm.R6, argOffset, asm.DWord),
+		asm.Add.Imm(asm.R3, skcPortpairOffset),
+		asm.Mov.Reg(asm.R1, asm.RFP),
+		asm.Add.Imm(asm.R1, -48),
+		asm.Mov.Imm(asm.R2, 4),
+		asm.FnProbeReadKernel.Call(),
+		asm.JNE.Imm(asm.R0, 0, "exit"),
+		asm.LoadMem(asm.R8, asm.RFP, -48, asm.Half),
+		asm.HostTo(asm.BE, asm.R8, asm.Half),
+		asm.LoadMem(asm.R9, asm.RFP, -46, asm.Half),
+	}, minPortInstructions()...)
+}
+
+// minPortInstructions sets R8 to the lower of the ports in R8 and R9, or to R9 when R8 is zero, as it is for the
+// sockets which are not connected.
+func minPortInstructions() asm.Instructions {
+	return asm.Instructions{
+		asm.JEq.Imm(asm.R8, 0, "local"),
+		asm.JLE.Reg(asm.R8, asm.R9, "key"),
+		asm.Mov.Reg(asm.R8, asm.R9).WithSymbol("local"),
+	}
+}
+
+// incrementInstructions adds R7 to the counter at offset field of the value of the port in R8 in the map, inserting
+// a zero value first if the port is not in the map yet, and exits.
+func incrementInstructions(statsFD int, field int16) asm.Instructions {
+	add := asm.StoreXAdd(asm.R0, asm.R7, asm.DWord)
+	add.Offset = field
+	insns := asm.Instructions{
+		asm.StoreMem(asm.RFP, -4, asm.R8, asm.Word).WithSymbol("key"),
+	}
+	for offset := int16(-40); offset < -8; offset += 8 {
+		insns = append(insns, asm.StoreImm(asm.RFP, offset, 0, asm.DWord))
+	}
+	insns = append(insns, lookupInstructions(statsFD)...)
+	insns = append(insns,
+		asm.JNE.Imm(asm.R0, 0, "increment"),
+		asm.LoadMapPtr(asm.R1, statsFD),
+		asm.Mov.Reg(asm.R2, asm.RFP),
+		asm.Add.Imm(asm.R2, -4),
+		asm.Mov.Reg(asm.R3, asm.RFP),
+		asm.Add.Imm(asm.R3, -40),
+		asm.Mov.Imm(asm.R4, int32(ebpf.UpdateNoExist)),
+		asm.FnMapUpdateElem.Call(),
+	)
+	insns = append(insns, lookupInstructions(statsFD)...)
+	return append(insns,
+		asm.JEq.Imm(asm.R0, 0, "exit"),
+		add.WithSymbol("increment"),
+		asm.Mov.Imm(asm.R0, 0).WithSymbol("exit"),
+		asm.Return(),
+	)
+}
+
+// lookupInstructions looks the port stored on the stack by incrementInstructions up in the map, into R0.
+func lookupInstructions(statsFD int) asm.Instructions {
+	return asm.Instructions{
+		asm.LoadMapPtr(asm.R1, statsFD),
+		asm.Mov.Reg(asm.R2, asm.RFP),
+		asm.Add.Imm(asm.R2, -4),
+		asm.FnMapLookupElem.Call(),
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package networkscraper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cilium/ebpf/asm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracepointFields(t *testing.T) {
+	sysPath := t.TempDir()
+	eventPath := filepath.Join(sysPath, "kernel", "debug", "tracing", "events", "sock", "inet_sock_set_state")
+	require.NoError(t, os.MkdirAll(eventPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(eventPath, "format"), []byte(`name: inet_sock_set_state
+ID: 1466
+format:
+	field:unsigned short common_type;	offset:0;	size:2;	signed:0;
+	field:unsigned char common_flags;	offset:2;	size:1;	signed:0;
+
+	field:const void * skaddr;	offset:8;	size:8;	signed:0;
+	field:int newstate;	offset:20;	size:4;	signed:1;
+	field:__u16 sport;	offset:24;	size:2;	signed:0;
+	field:__u16 dport;	offset:26;	size:2;	signed:0;
+	field:__u16 protocol;	offset:30;	size:2;	signed:0;
+
+print fmt: "sport=%hu dport=%hu", REC->sport, REC->dport
+`), 0o600))
+
+	fields, err := tracepointFields(sysPath, "sock", "inet_sock_set_state", "newstate", "sport", "dport", "protocol")
+	require.NoError(t, err)
+	assert.Equal(t, int16(8), fields["skaddr"])
+	assert.Equal(t, int16(20), fields["newstate"])
+	assert.Equal(t, int16(24), fields["sport"])
+	assert.Equal(t, int16(26), fields["dport"])
+	assert.Equal(t, int16(30), fields["protocol"])
+
+	_, err = tracepointFields(sysPath, "sock", "inet_sock_set_state", "family")
+	assert.EqualError(t, err, "no family field in the sock/inet_sock_set_state tracepoint")
+
+	_, err = tracepointFields(sysPath, "tcp", "tcp_retransmit_skb")
+	assert.ErrorContains(t, err, "failed to read the format of the tcp/tcp_retransmit_skb tracepoint")
+}
+
+func TestSocketInstructions(t *testing.T) {
+	// Marshaling resolves the jumps to the labels of the instructions, failing on missing or duplicate labels.
+	fields := map[string]int16{"newstate": 20, "sport": 24, "dport": 26, "protocol": 30}
+	programs := map[string]asm.Instructions{
+		"retransmit": retransmitInstructions(3, fields),
+		"set_state":  setStateInstructions(3, fields),
+	}
+	for arch, argOffsets := range kprobeArgOffsets {
+		programs["sendmsg "+arch] = sendmsgInstructions(3, argOffsets)
+		programs["cleanup_rbuf "+arch] = cleanupRbufInstructions(3, argOffsets)
+	}
+	for name, insns := range programs {
+		assert.NoError(t, insns.Marshal(&bytes.Buffer{}, binary.LittleEndian), name)
+	}
+}
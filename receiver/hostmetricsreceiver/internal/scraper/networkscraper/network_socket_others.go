@@ -0,0 +1,15 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package networkscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
+
+import (
+	"context"
+	"errors"
+)
+
+func newSocketTracer(context.Context) (socketTracer, error) {
+	return nil, errors.New("socket metrics are only available on Linux")
+}
@@ -27,14 +27,18 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for hostmetricsreceiver/paging metrics.
 type MetricsConfig struct {
-	SystemPagingFaults      MetricConfig `mapstructure:"system.paging.faults"`
-	SystemPagingOperations  MetricConfig `mapstructure:"system.paging.operations"`
-	SystemPagingUsage       MetricConfig `mapstructure:"system.paging.usage"`
-	SystemPagingUtilization MetricConfig `mapstructure:"system.paging.utilization"`
+	SystemPagingDeviceOperations MetricConfig `mapstructure:"system.paging.device.operations"`
+	SystemPagingFaults           MetricConfig `mapstructure:"system.paging.faults"`
+	SystemPagingOperations       MetricConfig `mapstructure:"system.paging.operations"`
+	SystemPagingUsage            MetricConfig `mapstructure:"system.paging.usage"`
+	SystemPagingUtilization      MetricConfig `mapstructure:"system.paging.utilization"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		SystemPagingDeviceOperations: MetricConfig{
+			Enabled: false,
+		},
 		SystemPagingFaults: MetricConfig{
 			Enabled: true,
 		},
@@ -26,10 +26,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemPagingFaults:      MetricConfig{Enabled: true},
-					SystemPagingOperations:  MetricConfig{Enabled: true},
-					SystemPagingUsage:       MetricConfig{Enabled: true},
-					SystemPagingUtilization: MetricConfig{Enabled: true},
+					SystemPagingDeviceOperations: MetricConfig{Enabled: true},
+					SystemPagingFaults:           MetricConfig{Enabled: true},
+					SystemPagingOperations:       MetricConfig{Enabled: true},
+					SystemPagingUsage:            MetricConfig{Enabled: true},
+					SystemPagingUtilization:      MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -37,10 +38,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemPagingFaults:      MetricConfig{Enabled: false},
-					SystemPagingOperations:  MetricConfig{Enabled: false},
-					SystemPagingUsage:       MetricConfig{Enabled: false},
-					SystemPagingUtilization: MetricConfig{Enabled: false},
+					SystemPagingDeviceOperations: MetricConfig{Enabled: false},
+					SystemPagingFaults:           MetricConfig{Enabled: false},
+					SystemPagingOperations:       MetricConfig{Enabled: false},
+					SystemPagingUsage:            MetricConfig{Enabled: false},
+					SystemPagingUtilization:      MetricConfig{Enabled: false},
 				},
 			},
 		},
@@ -9,4 +9,5 @@ type pageFileStats struct {
 	freeBytes   uint64
 	totalBytes  uint64
 	cachedBytes *uint64 //nolint:unused
+	partition   bool    // whether the page file is a block device of its own, such as a partition or a zram device
 }
@@ -7,19 +7,23 @@ package pagingscraper // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 )
 
 const swapsFilePath = "/proc/swaps"
 
 // swaps file column indexes
 const (
-	nameCol = 0
-	// typeCol     = 1
+	nameCol  = 0
+	typeCol  = 1
 	totalCol = 2
 	usedCol  = 3
 	// priorityCol = 4
@@ -84,6 +88,7 @@ func parseSwapsFile(r io.Reader) ([]*pageFileStats, error) {
 			usedBytes:  usedKiB * 1024,
 			freeBytes:  (totalKiB - usedKiB) * 1024,
 			totalBytes: totalKiB * 1024,
+			partition:  fields[typeCol] == "partition",
 		})
 	}
 
@@ -93,3 +98,35 @@ func parseSwapsFile(r io.Reader) ([]*pageFileStats, error) {
 
 	return swapDevices, nil
 }
+
+// readSwapDeviceIO returns the number of pages read from and written to a swap partition or zram device, from the
+// sectors read and written in /sys/class/block/<device>/stat. Device mapper paths, such as /dev/mapper/swap, are
+// resolved to the dm-N device they link to.
+func readSwapDeviceIO(ctx context.Context, device string) (pagesIn, pagesOut uint64, err error) {
+	if resolved, err := filepath.EvalSymlinks(device); err == nil {
+		device = resolved
+	}
+	path := filepath.Join(internal.SysPath(ctx), "class", "block", filepath.Base(device), "stat")
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// reads completed, reads merged, sectors read, time reading, writes completed, writes merged, sectors written, ...
+	fields := strings.Fields(string(contents))
+	if len(fields) < 7 {
+		return 0, 0, fmt.Errorf("couldn't parse %q: expected ≥7 fields but got %v", path, fields)
+	}
+	sectorsRead, err := strconv.ParseUint(fields[2], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("couldn't parse sectors read in %q: %w", path, err)
+	}
+	sectorsWritten, err := strconv.ParseUint(fields[6], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("couldn't parse sectors written in %q: %w", path, err)
+	}
+
+	// sectors are always 512 bytes long in block device statistics
+	sectorsPerPage := uint64(os.Getpagesize() / 512)
+	return sectorsRead / sectorsPerPage, sectorsWritten / sectorsPerPage, nil
+}
@@ -6,10 +6,16 @@
 package pagingscraper
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/shirou/gopsutil/v3/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const validFile = `Filename				Type		Size		Used		Priority
@@ -32,6 +38,7 @@ func TestGetPageFileStats_ValidFile(t *testing.T) {
 		usedBytes:  502566912,
 		freeBytes:  68128825344,
 		totalBytes: 68631392256,
+		partition:  true,
 	})
 
 	assert.Equal(*stats[1], pageFileStats{
@@ -51,3 +58,24 @@ func TestGetPageFileStats_EmptyFile(t *testing.T) {
 	_, err := parseSwapsFile(strings.NewReader(""))
 	assert.Error(t, err)
 }
+
+func TestReadSwapDeviceIO(t *testing.T) {
+	sysPath := t.TempDir()
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostSysEnvKey: sysPath})
+
+	_, _, err := readSwapDeviceIO(ctx, "/dev/zram0")
+	assert.Error(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "class", "block", "zram0"), 0o755))
+	sectorsPerPage := uint64(os.Getpagesize() / 512)
+	stat := fmt.Sprintf("    2100        0    %d       12     5300        0    %d       40        0       52        0\n", 2100*sectorsPerPage, 5300*sectorsPerPage)
+	require.NoError(t, os.WriteFile(filepath.Join(sysPath, "class", "block", "zram0", "stat"), []byte(stat), 0o600))
+	pagesIn, pagesOut, err := readSwapDeviceIO(ctx, "/dev/zram0")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2100), pagesIn)
+	assert.Equal(t, uint64(5300), pagesOut)
+
+	require.NoError(t, os.WriteFile(filepath.Join(sysPath, "class", "block", "zram0", "stat"), []byte("2100 0\n"), 0o600))
+	_, _, err = readSwapDeviceIO(ctx, "/dev/zram0")
+	assert.Error(t, err)
+}
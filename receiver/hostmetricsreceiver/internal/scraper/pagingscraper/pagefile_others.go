@@ -5,7 +5,12 @@
 
 package pagingscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
 
-import "github.com/shirou/gopsutil/v3/mem"
+import (
+	"context"
+	"errors"
+
+	"github.com/shirou/gopsutil/v3/mem"
+)
 
 func getPageFileStats() ([]*pageFileStats, error) {
 	vmem, err := mem.VirtualMemory()
@@ -19,3 +24,7 @@ func getPageFileStats() ([]*pageFileStats, error) {
 		cachedBytes: &vmem.SwapCached,
 	}}, nil
 }
+
+func readSwapDeviceIO(context.Context, string) (uint64, uint64, error) {
+	return 0, 0, errors.New("per-device paging operations are only supported on Linux")
+}
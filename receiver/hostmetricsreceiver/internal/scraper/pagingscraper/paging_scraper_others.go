@@ -18,13 +18,15 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper/internal/metadata"
 )
 
 const (
-	pagingUsageMetricsLen = 2
-	pagingMetricsLen      = 2
+	pagingUsageMetricsLen  = 2
+	pagingMetricsLen       = 2
+	pagingDeviceMetricsLen = 1
 )
 
 // scraper for Paging Metrics
@@ -37,6 +39,7 @@ type scraper struct {
 	bootTime         func(context.Context) (uint64, error)
 	getPageFileStats func() ([]*pageFileStats, error)
 	swapMemory       func(context.Context) (*mem.SwapMemoryStat, error)
+	swapDeviceIO     func(ctx context.Context, device string) (uint64, uint64, error)
 }
 
 // newPagingScraper creates a Paging Scraper
@@ -47,6 +50,7 @@ func newPagingScraper(_ context.Context, settings receiver.CreateSettings, cfg *
 		bootTime:         host.BootTimeWithContext,
 		getPageFileStats: getPageFileStats,
 		swapMemory:       mem.SwapMemoryWithContext,
+		swapDeviceIO:     readSwapDeviceIO,
 	}
 }
 
@@ -61,14 +65,19 @@ func (s *scraper) start(ctx context.Context, _ component.Host) error {
 	return nil
 }
 
-func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	var errors scrapererror.ScrapeErrors
 
-	err := s.scrapePagingUsageMetric()
+	pageFileStats, err := s.scrapePagingUsageMetric()
 	if err != nil {
 		errors.AddPartial(pagingUsageMetricsLen, err)
 	}
 
+	err = s.scrapePagingDeviceMetric(ctx, pageFileStats)
+	if err != nil {
+		errors.AddPartial(pagingDeviceMetricsLen, err)
+	}
+
 	err = s.scrapePagingMetrics()
 	if err != nil {
 		errors.AddPartial(pagingMetricsLen, err)
@@ -77,16 +86,16 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	return s.mb.Emit(), errors.Combine()
 }
 
-func (s *scraper) scrapePagingUsageMetric() error {
+func (s *scraper) scrapePagingUsageMetric() ([]*pageFileStats, error) {
 	now := pcommon.NewTimestampFromTime(time.Now())
 	pageFileStats, err := s.getPageFileStats()
 	if err != nil {
-		return fmt.Errorf("failed to read page file stats: %w", err)
+		return nil, fmt.Errorf("failed to read page file stats: %w", err)
 	}
 
 	s.recordPagingUsageDataPoints(now, pageFileStats)
 	s.recordPagingUtilizationDataPoints(now, pageFileStats)
-	return nil
+	return pageFileStats, nil
 }
 
 func (s *scraper) recordPagingUsageDataPoints(now pcommon.Timestamp, pageFileStats []*pageFileStats) {
@@ -109,6 +118,30 @@ func (s *scraper) recordPagingUtilizationDataPoints(now pcommon.Timestamp, pageF
 	}
 }
 
+// scrapePagingDeviceMetric records the pages swapped in and out of each swap partition or zram device. Swap files
+// are skipped, as the block device holding them also serves other files.
+func (s *scraper) scrapePagingDeviceMetric(ctx context.Context, pageFileStats []*pageFileStats) error {
+	if !s.config.MetricsBuilderConfig.Metrics.SystemPagingDeviceOperations.Enabled {
+		return nil
+	}
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errs error
+	for _, pageFile := range pageFileStats {
+		if !pageFile.partition {
+			continue
+		}
+		pagesIn, pagesOut, err := s.swapDeviceIO(ctx, pageFile.deviceName)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to read I/O stats of swap device %q: %w", pageFile.deviceName, err))
+			continue
+		}
+		s.mb.RecordSystemPagingDeviceOperationsDataPoint(now, int64(pagesIn), pageFile.deviceName, metadata.AttributeDirectionPageIn)
+		s.mb.RecordSystemPagingDeviceOperationsDataPoint(now, int64(pagesOut), pageFile.deviceName, metadata.AttributeDirectionPageOut)
+	}
+	return errs
+}
+
 func (s *scraper) scrapePagingMetrics() error {
 	ctx := context.WithValue(context.Background(), common.EnvKey, s.config.EnvMap)
 	now := pcommon.NewTimestampFromTime(time.Now())
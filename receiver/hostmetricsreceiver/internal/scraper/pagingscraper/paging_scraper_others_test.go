@@ -16,6 +16,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper/internal/metadata"
 )
 
 func TestScrape_Errors(t *testing.T) {
@@ -75,3 +77,52 @@ func TestScrape_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestScrape_DeviceOperations(t *testing.T) {
+	metricsBuilderConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsBuilderConfig.Metrics.SystemPagingDeviceOperations.Enabled = true
+	scraper := newPagingScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metricsBuilderConfig})
+	scraper.getPageFileStats = func() ([]*pageFileStats, error) {
+		return []*pageFileStats{
+			{deviceName: "/dev/zram0", partition: true},
+			{deviceName: "/dev/sda2", partition: true},
+			{deviceName: "/swapfile"},
+		}, nil
+	}
+	scraper.swapDeviceIO = func(_ context.Context, device string) (uint64, uint64, error) {
+		if device == "/dev/sda2" {
+			return 0, 0, errors.New("err1")
+		}
+		return 1000, 300, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, `failed to read I/O stats of swap device "/dev/sda2": err1`)
+	var scraperErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &scraperErr)
+	assert.Equal(t, pagingDeviceMetricsLen, scraperErr.Failed)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var found bool
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != "system.paging.device.operations" {
+			continue
+		}
+		found = true
+		dps := metric.Sum().DataPoints()
+		require.Equal(t, 2, dps.Len())
+		for j, expected := range []struct {
+			direction string
+			value     int64
+		}{{"page_in", 1000}, {"page_out", 300}} {
+			device, _ := dps.At(j).Attributes().Get("device")
+			direction, _ := dps.At(j).Attributes().Get("direction")
+			assert.Equal(t, "/dev/zram0", device.Str())
+			assert.Equal(t, expected.direction, direction.Str())
+			assert.Equal(t, expected.value, dps.At(j).IntValue())
+		}
+	}
+	assert.True(t, found)
+}
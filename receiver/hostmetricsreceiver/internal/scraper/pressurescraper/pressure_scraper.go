@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/psi"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+const metricsLen = 4
+
+// resources lists the resources whose pressure is reported, with the name of their file in /proc/pressure.
+var resources = []struct {
+	name      string
+	attribute metadata.AttributeResource
+}{
+	{name: "cpu", attribute: metadata.AttributeResourceCPU},
+	{name: "io", attribute: metadata.AttributeResourceIo},
+	{name: "memory", attribute: metadata.AttributeResourceMemory},
+}
+
+// scraper for Pressure Stall Information (PSI) Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking
+	bootTime func(context.Context) (uint64, error)
+	pressure func(ctx context.Context, resource string) ([]psi.Stat, error)
+}
+
+// newPressureScraper creates a Pressure Scraper
+func newPressureScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, pressure: psi.Read}
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	var errors scrapererror.ScrapeErrors
+	for _, resource := range resources {
+		stats, err := s.pressure(ctx, resource.name)
+		if err != nil {
+			errors.AddPartial(metricsLen, err)
+			continue
+		}
+		for _, stat := range stats {
+			stall := metadata.MapAttributeStall[stat.Stall]
+			s.mb.RecordSystemPressureAvg10DataPoint(now, stat.Avg10, resource.attribute, stall)
+			s.mb.RecordSystemPressureAvg60DataPoint(now, stat.Avg60, resource.attribute, stall)
+			s.mb.RecordSystemPressureAvg300DataPoint(now, stat.Avg300, resource.attribute, stall)
+			s.mb.RecordSystemPressureStallTimeDataPoint(now, stat.Total.Seconds(), resource.attribute, stall)
+		}
+	}
+
+	return s.mb.Emit(), errors.Combine()
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/psi"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	scraper := newPressureScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()})
+	scraper.bootTime = func(context.Context) (uint64, error) { return 100, nil }
+	scraper.pressure = func(_ context.Context, resource string) ([]psi.Stat, error) {
+		switch resource {
+		case "cpu":
+			return []psi.Stat{{Stall: psi.StallSome, Avg10: 1.5, Avg60: 1, Avg300: 0.5, Total: 3 * time.Second}}, nil
+		case "memory":
+			return nil, errors.New("err1")
+		}
+		return []psi.Stat{
+			{Stall: psi.StallSome, Avg10: 20, Avg60: 10, Avg300: 5, Total: 8 * time.Second},
+			{Stall: psi.StallFull, Avg10: 2, Avg60: 1, Avg300: 0.5, Total: 4 * time.Second},
+		}, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.EqualError(t, err, "err1")
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, metricsLen, partialErr.Failed)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, metricsLen, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != "system.pressure.stall_time" {
+			assert.Equal(t, 3, metric.Gauge().DataPoints().Len())
+			continue
+		}
+		dps := metric.Sum().DataPoints()
+		require.Equal(t, 3, dps.Len())
+		assert.Equal(t, pcommon.Timestamp(100*1e9), dps.At(0).StartTimestamp())
+		assert.Equal(t, 3.0, dps.At(0).DoubleValue())
+		assert.Equal(t, map[string]any{"resource": "cpu", "stall": "some"}, dps.At(0).Attributes().AsRaw())
+		assert.Equal(t, 4.0, dps.At(2).DoubleValue())
+		assert.Equal(t, map[string]any{"resource": "io", "stall": "full"}, dps.At(2).Attributes().AsRaw())
+	}
+}
@@ -27,8 +27,10 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for hostmetricsreceiver/processes metrics.
 type MetricsConfig struct {
-	SystemProcessesCount   MetricConfig `mapstructure:"system.processes.count"`
-	SystemProcessesCreated MetricConfig `mapstructure:"system.processes.created"`
+	SystemProcessesCount         MetricConfig `mapstructure:"system.processes.count"`
+	SystemProcessesCreated       MetricConfig `mapstructure:"system.processes.created"`
+	SystemProcessesStateCount    MetricConfig `mapstructure:"system.processes.state.count"`
+	SystemProcessesZombiesParent MetricConfig `mapstructure:"system.processes.zombies.parent"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -39,6 +41,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		SystemProcessesCreated: MetricConfig{
 			Enabled: true,
 		},
+		SystemProcessesStateCount: MetricConfig{
+			Enabled: false,
+		},
+		SystemProcessesZombiesParent: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -26,8 +26,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemProcessesCount:   MetricConfig{Enabled: true},
-					SystemProcessesCreated: MetricConfig{Enabled: true},
+					SystemProcessesCount:         MetricConfig{Enabled: true},
+					SystemProcessesCreated:       MetricConfig{Enabled: true},
+					SystemProcessesStateCount:    MetricConfig{Enabled: true},
+					SystemProcessesZombiesParent: MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -35,8 +37,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SystemProcessesCount:   MetricConfig{Enabled: false},
-					SystemProcessesCreated: MetricConfig{Enabled: false},
+					SystemProcessesCount:         MetricConfig{Enabled: false},
+					SystemProcessesCreated:       MetricConfig{Enabled: false},
+					SystemProcessesStateCount:    MetricConfig{Enabled: false},
+					SystemProcessesZombiesParent: MetricConfig{Enabled: false},
 				},
 			},
 		},
@@ -12,6 +12,52 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
 )
 
+// AttributeState specifies the a value state attribute.
+type AttributeState int
+
+const (
+	_ AttributeState = iota
+	AttributeStateRunning
+	AttributeStateSleeping
+	AttributeStateDiskSleep
+	AttributeStateStopped
+	AttributeStateZombie
+	AttributeStateIdle
+	AttributeStateOther
+)
+
+// String returns the string representation of the AttributeState.
+func (av AttributeState) String() string {
+	switch av {
+	case AttributeStateRunning:
+		return "running"
+	case AttributeStateSleeping:
+		return "sleeping"
+	case AttributeStateDiskSleep:
+		return "disk_sleep"
+	case AttributeStateStopped:
+		return "stopped"
+	case AttributeStateZombie:
+		return "zombie"
+	case AttributeStateIdle:
+		return "idle"
+	case AttributeStateOther:
+		return "other"
+	}
+	return ""
+}
+
+// MapAttributeState is a helper map of string to AttributeState attribute value.
+var MapAttributeState = map[string]AttributeState{
+	"running":    AttributeStateRunning,
+	"sleeping":   AttributeStateSleeping,
+	"disk_sleep": AttributeStateDiskSleep,
+	"stopped":    AttributeStateStopped,
+	"zombie":     AttributeStateZombie,
+	"idle":       AttributeStateIdle,
+	"other":      AttributeStateOther,
+}
+
 // AttributeStatus specifies the a value status attribute.
 type AttributeStatus int
 
@@ -186,16 +232,124 @@ func newMetricSystemProcessesCreated(cfg MetricConfig) metricSystemProcessesCrea
 	return m
 }
 
+type metricSystemProcessesStateCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.processes.state.count metric with initial data.
+func (m *metricSystemProcessesStateCount) init() {
+	m.data.SetName("system.processes.state.count")
+	m.data.SetDescription("Number of processes in each scheduling state, as read from the status of each process. Unlike system.processes.count, the counts are not amended with the running and blocked tasks counted by the kernel.")
+	m.data.SetUnit("{processes}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemProcessesStateCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, stateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", stateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemProcessesStateCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemProcessesStateCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemProcessesStateCount(cfg MetricConfig) metricSystemProcessesStateCount {
+	m := metricSystemProcessesStateCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemProcessesZombiesParent struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.processes.zombies.parent metric with initial data.
+func (m *metricSystemProcessesZombiesParent) init() {
+	m.data.SetName("system.processes.zombies.parent")
+	m.data.SetDescription("Number of zombie children of the process with the most zombie children, which is likely failing to reap its children.")
+	m.data.SetUnit("{processes}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemProcessesZombiesParent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, parentPidAttributeValue int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutInt("process.parent_pid", parentPidAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemProcessesZombiesParent) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemProcessesZombiesParent) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemProcessesZombiesParent(cfg MetricConfig) metricSystemProcessesZombiesParent {
+	m := metricSystemProcessesZombiesParent{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                       MetricsBuilderConfig // config of the metrics builder.
-	startTime                    pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity              int                  // maximum observed number of metrics per resource.
-	metricsBuffer                pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                    component.BuildInfo  // contains version information.
-	metricSystemProcessesCount   metricSystemProcessesCount
-	metricSystemProcessesCreated metricSystemProcessesCreated
+	config                             MetricsBuilderConfig // config of the metrics builder.
+	startTime                          pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                    int                  // maximum observed number of metrics per resource.
+	metricsBuffer                      pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                          component.BuildInfo  // contains version information.
+	metricSystemProcessesCount         metricSystemProcessesCount
+	metricSystemProcessesCreated       metricSystemProcessesCreated
+	metricSystemProcessesStateCount    metricSystemProcessesStateCount
+	metricSystemProcessesZombiesParent metricSystemProcessesZombiesParent
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -210,12 +364,14 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                       mbc,
-		startTime:                    pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                pmetric.NewMetrics(),
-		buildInfo:                    settings.BuildInfo,
-		metricSystemProcessesCount:   newMetricSystemProcessesCount(mbc.Metrics.SystemProcessesCount),
-		metricSystemProcessesCreated: newMetricSystemProcessesCreated(mbc.Metrics.SystemProcessesCreated),
+		config:                             mbc,
+		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                      pmetric.NewMetrics(),
+		buildInfo:                          settings.BuildInfo,
+		metricSystemProcessesCount:         newMetricSystemProcessesCount(mbc.Metrics.SystemProcessesCount),
+		metricSystemProcessesCreated:       newMetricSystemProcessesCreated(mbc.Metrics.SystemProcessesCreated),
+		metricSystemProcessesStateCount:    newMetricSystemProcessesStateCount(mbc.Metrics.SystemProcessesStateCount),
+		metricSystemProcessesZombiesParent: newMetricSystemProcessesZombiesParent(mbc.Metrics.SystemProcessesZombiesParent),
 	}
 
 	for _, op := range options {
@@ -276,6 +432,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricSystemProcessesCount.emit(ils.Metrics())
 	mb.metricSystemProcessesCreated.emit(ils.Metrics())
+	mb.metricSystemProcessesStateCount.emit(ils.Metrics())
+	mb.metricSystemProcessesZombiesParent.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -307,6 +465,16 @@ func (mb *MetricsBuilder) RecordSystemProcessesCreatedDataPoint(ts pcommon.Times
 	mb.metricSystemProcessesCreated.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSystemProcessesStateCountDataPoint adds a data point to system.processes.state.count metric.
+func (mb *MetricsBuilder) RecordSystemProcessesStateCountDataPoint(ts pcommon.Timestamp, val int64, stateAttributeValue AttributeState) {
+	mb.metricSystemProcessesStateCount.recordDataPoint(mb.startTime, ts, val, stateAttributeValue.String())
+}
+
+// RecordSystemProcessesZombiesParentDataPoint adds a data point to system.processes.zombies.parent metric.
+func (mb *MetricsBuilder) RecordSystemProcessesZombiesParentDataPoint(ts pcommon.Timestamp, val int64, parentPidAttributeValue int64) {
+	mb.metricSystemProcessesZombiesParent.recordDataPoint(mb.startTime, ts, val, parentPidAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
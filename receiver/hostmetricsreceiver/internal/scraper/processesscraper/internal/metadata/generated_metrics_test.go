@@ -67,6 +67,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSystemProcessesCreatedDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordSystemProcessesStateCountDataPoint(ts, 1, AttributeStateRunning)
+
+			allMetricsCount++
+			mb.RecordSystemProcessesZombiesParentDataPoint(ts, 1, 10)
+
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
 
@@ -120,6 +126,40 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "system.processes.state.count":
+					assert.False(t, validatedMetrics["system.processes.state.count"], "Found a duplicate in the metrics slice: system.processes.state.count")
+					validatedMetrics["system.processes.state.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of processes in each scheduling state, as read from the status of each process. Unlike system.processes.count, the counts are not amended with the running and blocked tasks counted by the kernel.", ms.At(i).Description())
+					assert.Equal(t, "{processes}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "running", attrVal.Str())
+				case "system.processes.zombies.parent":
+					assert.False(t, validatedMetrics["system.processes.zombies.parent"], "Found a duplicate in the metrics slice: system.processes.zombies.parent")
+					validatedMetrics["system.processes.zombies.parent"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of zombie children of the process with the most zombie children, which is likely failing to reap its children.", ms.At(i).Description())
+					assert.Equal(t, "{processes}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("process.parent_pid")
+					assert.True(t, ok)
+					assert.EqualValues(t, 10, attrVal.Int())
 				}
 			}
 		})
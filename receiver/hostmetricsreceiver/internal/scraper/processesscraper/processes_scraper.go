@@ -46,11 +46,20 @@ type scraper struct {
 // for mocking out gopsutil process.Process
 type proc interface {
 	Status() ([]string, error)
+	Ppid() (int32, error)
 }
 
 type processesMetadata struct {
 	countByStatus    map[metadata.AttributeStatus]int64 // ignored if enableProcessesCount is false
 	processesCreated *int64                             // ignored if enableProcessesCreated is false
+	countByState     map[metadata.AttributeState]int64  // nil unless system.processes.state.count is enabled
+	zombieParent     *zombieParent                      // nil unless system.processes.zombies.parent is enabled
+}
+
+// zombieParent is the process with the most zombie children.
+type zombieParent struct {
+	pid     int32
+	zombies int64
 }
 
 // newProcessesScraper creates a set of Processes related metrics
@@ -105,5 +114,13 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		s.mb.RecordSystemProcessesCreatedDataPoint(now, *processMetadata.processesCreated)
 	}
 
+	for state, count := range processMetadata.countByState {
+		s.mb.RecordSystemProcessesStateCountDataPoint(now, count, state)
+	}
+
+	if processMetadata.zombieParent != nil {
+		s.mb.RecordSystemProcessesZombiesParentDataPoint(now, processMetadata.zombieParent.zombies, int64(processMetadata.zombieParent.pid))
+	}
+
 	return s.mb.Emit(), err
 }
@@ -181,12 +181,30 @@ func (e errProcess) Status() ([]string, error) {
 	return []string{""}, errors.New("errProcess")
 }
 
+func (e errProcess) Ppid() (int32, error) {
+	return 0, errors.New("errProcess")
+}
+
 type fakeProcess string
 
 func (f fakeProcess) Status() ([]string, error) {
 	return []string{string(f)}, nil
 }
 
+func (f fakeProcess) Ppid() (int32, error) {
+	return 1, nil
+}
+
+type zombieProcess int32
+
+func (z zombieProcess) Status() ([]string, error) {
+	return []string{process.Zombie}, nil
+}
+
+func (z zombieProcess) Ppid() (int32, error) {
+	return int32(z), nil
+}
+
 func validateFakeData(t *testing.T, metrics pmetric.MetricSlice) {
 	metricIndex := 0
 	if expectProcessesCountMetric {
@@ -221,3 +239,61 @@ func validateFakeData(t *testing.T, metrics pmetric.MetricSlice) {
 		assert.Equal(t, 0, createdMetric.Sum().DataPoints().At(0).Attributes().Len())
 	}
 }
+
+func TestScrape_StateMetrics(t *testing.T) {
+	if !expectProcessesCountMetric || runtime.GOOS == "solaris" {
+		t.Skip("process states are not available on " + runtime.GOOS)
+	}
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.Metrics.SystemProcessesCount.Enabled = false
+	cfg.Metrics.SystemProcessesCreated.Enabled = false
+	cfg.Metrics.SystemProcessesStateCount.Enabled = true
+	cfg.Metrics.SystemProcessesZombiesParent.Enabled = true
+	scraper := newProcessesScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	scraper.getMiscStats = func(context.Context) (*load.MiscStat, error) { return &fakeData, nil }
+	scraper.getProcesses = func() ([]proc, error) {
+		return []proc{
+			fakeProcess(process.Running),
+			fakeProcess(process.Sleep), fakeProcess(process.Sleep),
+			fakeProcess(process.Blocked),
+			fakeProcess(process.Stop),
+			fakeProcess(process.Idle),
+			fakeProcess(process.Wait),
+			zombieProcess(200), zombieProcess(100), zombieProcess(200), zombieProcess(100), zombieProcess(300),
+			errProcess{},
+		}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.MetricCount())
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	stateMetric := metrics.At(0)
+	assert.Equal(t, "system.processes.state.count", stateMetric.Name())
+	counts := map[string]int64{}
+	for i := 0; i < stateMetric.Sum().DataPoints().Len(); i++ {
+		dp := stateMetric.Sum().DataPoints().At(i)
+		state, ok := dp.Attributes().Get("state")
+		require.True(t, ok)
+		counts[state.Str()] = dp.IntValue()
+	}
+	assert.Equal(t, map[string]int64{
+		metadata.AttributeStateRunning.String():   1,
+		metadata.AttributeStateSleeping.String():  2,
+		metadata.AttributeStateDiskSleep.String(): 1,
+		metadata.AttributeStateStopped.String():   1,
+		metadata.AttributeStateIdle.String():      1,
+		metadata.AttributeStateOther.String():     1,
+		metadata.AttributeStateZombie.String():    5,
+	}, counts)
+
+	// the parent with the lowest PID is reported on ties
+	zombieMetric := metrics.At(1)
+	assert.Equal(t, "system.processes.zombies.parent", zombieMetric.Name())
+	require.Equal(t, 1, zombieMetric.Sum().DataPoints().Len())
+	assert.Equal(t, int64(2), zombieMetric.Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, map[string]any{"process.parent_pid": int64(100)}, zombieMetric.Sum().DataPoints().At(0).Attributes().AsRaw())
+}
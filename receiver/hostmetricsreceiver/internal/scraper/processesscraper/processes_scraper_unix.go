@@ -26,6 +26,14 @@ func (s *scraper) getProcessesMetadata() (processesMetadata, error) {
 	}
 
 	countByStatus := map[metadata.AttributeStatus]int64{}
+	var countByState map[metadata.AttributeState]int64
+	if s.config.Metrics.SystemProcessesStateCount.Enabled {
+		countByState = map[metadata.AttributeState]int64{}
+	}
+	var zombiesByParent map[int32]int64
+	if s.config.Metrics.SystemProcessesZombiesParent.Enabled {
+		zombiesByParent = map[int32]int64{}
+	}
 	for _, process := range processes {
 		var status []string
 		status, err = process.Status()
@@ -34,6 +42,16 @@ func (s *scraper) getProcessesMetadata() (processesMetadata, error) {
 			// been terminated as we run this code.
 			continue
 		}
+		schedState := toAttributeState(status)
+		if countByState != nil {
+			countByState[schedState]++
+		}
+		if zombiesByParent != nil && schedState == metadata.AttributeStateZombie {
+			// the parent of a zombie may exit in the meantime, the zombie then being reaped by init
+			if ppid, ppidErr := process.Ppid(); ppidErr == nil {
+				zombiesByParent[ppid]++
+			}
+		}
 		state, ok := toAttributeStatus(status)
 		if !ok {
 			countByStatus[metadata.AttributeStatusUnknown]++
@@ -70,9 +88,23 @@ func (s *scraper) getProcessesMetadata() (processesMetadata, error) {
 	return processesMetadata{
 		countByStatus:    countByStatus,
 		processesCreated: procsCreated,
+		countByState:     countByState,
+		zombieParent:     topZombieParent(zombiesByParent),
 	}, nil
 }
 
+// topZombieParent returns the process with the most zombie children, the one with the lowest PID on ties, or nil if
+// there is no zombie.
+func topZombieParent(zombiesByParent map[int32]int64) *zombieParent {
+	var top *zombieParent
+	for pid, zombies := range zombiesByParent {
+		if top == nil || zombies > top.zombies || (zombies == top.zombies && pid < top.pid) {
+			top = &zombieParent{pid: pid, zombies: zombies}
+		}
+	}
+	return top
+}
+
 func toAttributeStatus(status []string) (metadata.AttributeStatus, bool) {
 	if len(status) == 0 || len(status[0]) == 0 {
 		return metadata.AttributeStatus(0), false
@@ -81,6 +113,27 @@ func toAttributeStatus(status []string) (metadata.AttributeStatus, bool) {
 	return state, ok
 }
 
+func toAttributeState(status []string) metadata.AttributeState {
+	if len(status) == 0 {
+		return metadata.AttributeStateOther
+	}
+	if state, ok := statusToState[status[0]]; ok {
+		return state
+	}
+	return metadata.AttributeStateOther
+}
+
+// statusToState maps the process states to the scheduling states of Linux, the disk sleep state being reported as
+// blocked by gopsutil.
+var statusToState = map[string]metadata.AttributeState{
+	process.Blocked: metadata.AttributeStateDiskSleep,
+	process.Idle:    metadata.AttributeStateIdle,
+	process.Running: metadata.AttributeStateRunning,
+	process.Sleep:   metadata.AttributeStateSleeping,
+	process.Stop:    metadata.AttributeStateStopped,
+	process.Zombie:  metadata.AttributeStateZombie,
+}
+
 var charToState = map[string]metadata.AttributeStatus{
 	process.Blocked:  metadata.AttributeStatusBlocked,
 	process.Daemon:   metadata.AttributeStatusDaemon,
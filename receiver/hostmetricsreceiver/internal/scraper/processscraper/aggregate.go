@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// aggregateAttribute marks the resources of the processes summed by top_n or aggregate_by_executable_name until they
+// are merged. It is removed by mergeResourceMetrics.
+const aggregateAttribute = "otelcol.hostmetrics.process.aggregate"
+
+// mergeResourceMetrics merges the resource metrics of md that have the same resource attributes, summing the values
+// of the data points of the same metric that have the same attributes, and keeping their earliest start time.
+// The resource metrics are expected to hold a single scope, as emitted by the metrics builder.
+//
+// The sums of the resources marked with aggregateAttribute are reported as non-monotonic, as the set of processes
+// summed into them changes from one scrape to the next, so that their values decrease when one of these processes exits.
+func mergeResourceMetrics(md pmetric.Metrics) {
+	merged := make(map[string]pmetric.ResourceMetrics)
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		key := attributesKey(rm.Resource().Attributes())
+		dst, ok := merged[key]
+		if !ok {
+			merged[key] = rm
+			return false
+		}
+		if rm.ScopeMetrics().Len() > 0 {
+			mergeMetrics(dst.ScopeMetrics().At(0).Metrics(), rm.ScopeMetrics().At(0).Metrics())
+		}
+		return true
+	})
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		if _, ok := rm.Resource().Attributes().Get(aggregateAttribute); !ok {
+			continue
+		}
+		rm.Resource().Attributes().Remove(aggregateAttribute)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				if metrics.At(k).Type() == pmetric.MetricTypeSum {
+					metrics.At(k).Sum().SetIsMonotonic(false)
+				}
+			}
+		}
+	}
+}
+
+// mergeMetrics sums the data points of the metrics of src into the ones of dst.
+func mergeMetrics(dst, src pmetric.MetricSlice) {
+	for i := 0; i < src.Len(); i++ {
+		srcMetric := src.At(i)
+		dstMetric, ok := findMetric(dst, srcMetric.Name())
+		if !ok {
+			srcMetric.CopyTo(dst.AppendEmpty())
+			continue
+		}
+		srcDPs, dstDPs := numberDataPoints(srcMetric), numberDataPoints(dstMetric)
+		for j := 0; j < srcDPs.Len(); j++ {
+			srcDP := srcDPs.At(j)
+			dstDP, ok := findDataPoint(dstDPs, srcDP.Attributes())
+			if !ok {
+				srcDP.CopyTo(dstDPs.AppendEmpty())
+				continue
+			}
+			switch srcDP.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				dstDP.SetIntValue(dstDP.IntValue() + srcDP.IntValue())
+			case pmetric.NumberDataPointValueTypeDouble:
+				dstDP.SetDoubleValue(dstDP.DoubleValue() + srcDP.DoubleValue())
+			}
+			if srcDP.StartTimestamp() < dstDP.StartTimestamp() {
+				dstDP.SetStartTimestamp(srcDP.StartTimestamp())
+			}
+		}
+	}
+}
+
+func numberDataPoints(metric pmetric.Metric) pmetric.NumberDataPointSlice {
+	if metric.Type() == pmetric.MetricTypeGauge {
+		return metric.Gauge().DataPoints()
+	}
+	return metric.Sum().DataPoints()
+}
+
+func findMetric(metrics pmetric.MetricSlice, name string) (pmetric.Metric, bool) {
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i), true
+		}
+	}
+	return pmetric.Metric{}, false
+}
+
+func findDataPoint(dps pmetric.NumberDataPointSlice, attributes pcommon.Map) (pmetric.NumberDataPoint, bool) {
+	raw := attributes.AsRaw()
+	for i := 0; i < dps.Len(); i++ {
+		if reflect.DeepEqual(dps.At(i).Attributes().AsRaw(), raw) {
+			return dps.At(i), true
+		}
+	}
+	return pmetric.NumberDataPoint{}, false
+}
+
+func attributesKey(attributes pcommon.Map) string {
+	pairs := make([]string, 0, attributes.Len())
+	attributes.Range(func(k string, v pcommon.Value) bool {
+		pairs = append(pairs, fmt.Sprintf("%q=%q", k, v.AsString()))
+		return true
+	})
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -16,9 +16,11 @@ type Config struct {
 	// MetricsBuilderConfig allows to customize scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	internal.ScraperConfig
-	// Include specifies a filter on the process names that should be included from the generated metrics.
-	// Exclude specifies a filter on the process names that should be excluded from the generated metrics.
+	// Include specifies a filter on the processes that should be included from the generated metrics.
+	// Exclude specifies a filter on the processes that should be excluded from the generated metrics.
 	// If neither `include` or `exclude` are set, process metrics will be generated for all processes.
+	// A process is included when it matches every criterion set in `include`, and excluded when it
+	// matches any criterion set in `exclude`.
 	Include MatchConfig `mapstructure:"include"`
 	Exclude MatchConfig `mapstructure:"exclude"`
 
@@ -43,13 +45,49 @@ type Config struct {
 	// doesn't exist on the system, eg. is owned by user existing in container only
 	MuteProcessUserError bool `mapstructure:"mute_process_user_error,omitempty"`
 
+	// ReportDiskIOAsRate reports the process.disk.io, process.disk.operations and process.disk.cancelled_write metrics
+	// as gauges holding their per-second rate of change since the previous scrape, instead of cumulative sums. Their
+	// units get a `/s` suffix. Nothing is reported for a process on its first scrape.
+	ReportDiskIOAsRate bool `mapstructure:"report_disk_io_as_rate"`
+
 	// ScrapeProcessDelay is used to indicate the minimum amount of time a process must be running
 	// before metrics are scraped for it.  The default value is 0 seconds (0s)
 	ScrapeProcessDelay time.Duration `mapstructure:"scrape_process_delay"`
+
+	// EnvironmentVariables lists the names of the environment variables, such as SERVICE_NAME, that are read from the
+	// environment of each process and set as `process.env.<name>` resource attributes, when the process defines them.
+	// This option is only supported on Linux and Windows.
+	EnvironmentVariables []string `mapstructure:"environment_variables"`
+
+	// TopN, when positive, only reports the N processes with the highest usage of the resource set by TopNBy at each
+	// scrape. The metrics of the other processes are summed into a single process whose `process.executable.name`
+	// is `other`, and which has no other resource attribute. As the processes summed change from one scrape to the
+	// next, the sums of this process are reported as non-monotonic.
+	TopN int `mapstructure:"top_n"`
+
+	// TopNBy is the resource the processes are ranked by with TopN: `cpu`, the default, ranks them by the CPU time
+	// they used since the previous scrape, and `memory` by their resident memory.
+	TopNBy string `mapstructure:"top_n_by"`
+
+	// AggregateByExecutableName sums the metrics of the processes that share an executable name, such as the workers
+	// of a web server, into a single process, whose only resource attribute is `process.executable.name`. This keeps
+	// the cardinality of fork-heavy workloads low. As the processes summed change from one scrape to the next, the
+	// sums of these processes are reported as non-monotonic.
+	AggregateByExecutableName bool `mapstructure:"aggregate_by_executable_name"`
 }
 
 type MatchConfig struct {
 	filterset.Config `mapstructure:",squash"`
 
 	Names []string `mapstructure:"names"`
+
+	// Cgroups matches the cgroup paths of the processes, such as `/system.slice/sshd.service`, as read from
+	// /proc/<pid>/cgroup. A process belonging to several cgroup v1 hierarchies matches when any of its paths does.
+	// This criterion is only supported on Linux.
+	Cgroups []string `mapstructure:"cgroups"`
+
+	// ContainerIDs matches the 64 hexadecimal characters long IDs of the containers the processes run in, as found
+	// in their cgroup paths by Docker, containerd and CRI-O. Processes that do not run in a container never match.
+	// This criterion is only supported on Linux.
+	ContainerIDs []string `mapstructure:"container_ids"`
 }
@@ -28,19 +28,21 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for hostmetricsreceiver/process metrics.
 type MetricsConfig struct {
-	ProcessContextSwitches     MetricConfig `mapstructure:"process.context_switches"`
-	ProcessCPUTime             MetricConfig `mapstructure:"process.cpu.time"`
-	ProcessCPUUtilization      MetricConfig `mapstructure:"process.cpu.utilization"`
-	ProcessDiskIo              MetricConfig `mapstructure:"process.disk.io"`
-	ProcessDiskOperations      MetricConfig `mapstructure:"process.disk.operations"`
-	ProcessHandles             MetricConfig `mapstructure:"process.handles"`
-	ProcessMemoryUsage         MetricConfig `mapstructure:"process.memory.usage"`
-	ProcessMemoryUtilization   MetricConfig `mapstructure:"process.memory.utilization"`
-	ProcessMemoryVirtual       MetricConfig `mapstructure:"process.memory.virtual"`
-	ProcessOpenFileDescriptors MetricConfig `mapstructure:"process.open_file_descriptors"`
-	ProcessPagingFaults        MetricConfig `mapstructure:"process.paging.faults"`
-	ProcessSignalsPending      MetricConfig `mapstructure:"process.signals_pending"`
-	ProcessThreads             MetricConfig `mapstructure:"process.threads"`
+	ProcessContextSwitches          MetricConfig `mapstructure:"process.context_switches"`
+	ProcessCPUTime                  MetricConfig `mapstructure:"process.cpu.time"`
+	ProcessCPUUtilization           MetricConfig `mapstructure:"process.cpu.utilization"`
+	ProcessDiskCancelledWrite       MetricConfig `mapstructure:"process.disk.cancelled_write"`
+	ProcessDiskIo                   MetricConfig `mapstructure:"process.disk.io"`
+	ProcessDiskOperations           MetricConfig `mapstructure:"process.disk.operations"`
+	ProcessHandles                  MetricConfig `mapstructure:"process.handles"`
+	ProcessMemoryUsage              MetricConfig `mapstructure:"process.memory.usage"`
+	ProcessMemoryUtilization        MetricConfig `mapstructure:"process.memory.utilization"`
+	ProcessMemoryVirtual            MetricConfig `mapstructure:"process.memory.virtual"`
+	ProcessOpenFileDescriptors      MetricConfig `mapstructure:"process.open_file_descriptors"`
+	ProcessOpenFileDescriptorsLimit MetricConfig `mapstructure:"process.open_file_descriptors.limit"`
+	ProcessPagingFaults             MetricConfig `mapstructure:"process.paging.faults"`
+	ProcessSignalsPending           MetricConfig `mapstructure:"process.signals_pending"`
+	ProcessThreads                  MetricConfig `mapstructure:"process.threads"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -54,6 +56,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		ProcessCPUUtilization: MetricConfig{
 			Enabled: false,
 		},
+		ProcessDiskCancelledWrite: MetricConfig{
+			Enabled: false,
+		},
 		ProcessDiskIo: MetricConfig{
 			Enabled: true,
 		},
@@ -75,6 +80,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		ProcessOpenFileDescriptors: MetricConfig{
 			Enabled: false,
 		},
+		ProcessOpenFileDescriptorsLimit: MetricConfig{
+			Enabled: false,
+		},
 		ProcessPagingFaults: MetricConfig{
 			Enabled: false,
 		},
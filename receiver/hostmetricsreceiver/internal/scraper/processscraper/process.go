@@ -5,9 +5,12 @@ package processscraper // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -32,6 +35,8 @@ type processMetadata struct {
 	username   string
 	handle     processHandle
 	createTime int64
+	// environment holds the values of the environment variables set by environment_variables, by name
+	environment map[string]string
 }
 
 type executableMetadata struct {
@@ -40,6 +45,35 @@ type executableMetadata struct {
 	cgroup string
 }
 
+// containerIDRegexp matches the container IDs found in cgroup paths, such as `/docker/<id>`,
+// `/kubepods.slice/.../cri-containerd-<id>.scope` or `/kubepods/.../crio-<id>`.
+var containerIDRegexp = regexp.MustCompile(`(?:^|[/\-])([0-9a-f]{64})(?:\.scope)?$`)
+
+// cgroupPaths returns the distinct paths of the contents of /proc/<pid>/cgroup, one per hierarchy with cgroup v1,
+// and a single one with cgroup v2.
+func cgroupPaths(cgroup string) []string {
+	var paths []string
+	for _, line := range strings.Split(cgroup, "\n") {
+		// hierarchy-ID:controller-list:cgroup-path
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 || slices.Contains(paths, parts[2]) {
+			continue
+		}
+		paths = append(paths, parts[2])
+	}
+	return paths
+}
+
+// cgroupContainerID returns the ID of the container found in the cgroup paths of a process, if any.
+func cgroupContainerID(cgroup string) string {
+	for _, path := range cgroupPaths(cgroup) {
+		if match := containerIDRegexp.FindStringSubmatch(path); match != nil {
+			return match[1]
+		}
+	}
+	return ""
+}
+
 type commandMetadata struct {
 	command          string
 	commandLine      string
@@ -65,7 +99,30 @@ func (m *processMetadata) buildResource(rb *metadata.ResourceBuilder) pcommon.Re
 	if m.username != "" {
 		rb.SetProcessOwner(m.username)
 	}
-	return rb.Emit()
+	resource := rb.Emit()
+	for name, value := range m.environment {
+		resource.Attributes().PutStr(envAttributePrefix+name, value)
+	}
+	return resource
+}
+
+// envAttributePrefix is the prefix of the resource attributes holding the environment variables of a process.
+const envAttributePrefix = "process.env."
+
+// getProcessEnvironment returns the values of the given environment variables of a process, by name.
+func getProcessEnvironment(ctx context.Context, handle processHandle, names []string) (map[string]string, error) {
+	environ, err := handle.EnvironWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	environment := make(map[string]string, len(names))
+	for _, variable := range environ {
+		name, value, ok := strings.Cut(variable, "=")
+		if ok && slices.Contains(names, name) {
+			environment[name] = value
+		}
+	}
+	return environment, nil
 }
 
 // processHandles provides a wrapper around []*process.Process
@@ -98,6 +155,8 @@ type processHandle interface {
 	// If gatherUsed is true, the currently used value will be gathered and added to the resulting RlimitStat.
 	RlimitUsageWithContext(ctx context.Context, gatherUsed bool) ([]process.RlimitStat, error)
 	CgroupWithContext(ctx context.Context) (string, error)
+	CancelledWriteBytesWithContext(ctx context.Context) (uint64, error)
+	EnvironWithContext(context.Context) ([]string, error)
 }
 
 type gopsProcessHandles struct {
@@ -131,6 +190,24 @@ func (p wrappedProcessHandle) CgroupWithContext(ctx context.Context) (string, er
 	return strings.TrimSuffix(string(contents), "\n"), nil
 }
 
+// CancelledWriteBytesWithContext returns the cancelled_write_bytes counter of /proc/<pid>/io, which gopsutil does
+// not expose.
+func (p wrappedProcessHandle) CancelledWriteBytesWithContext(ctx context.Context) (uint64, error) {
+	pid := p.Process.Pid
+	ioPath := getEnvWithContext(ctx, string(common.HostProcEnvKey), "/proc", strconv.Itoa(int(pid)), "io")
+	contents, err := os.ReadFile(ioPath)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		if value, ok := strings.CutPrefix(line, "cancelled_write_bytes:"); ok {
+			return strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("cancelled_write_bytes not found in %s", ioPath)
+}
+
 // copied from gopsutil:
 // GetEnvWithContext retrieves the environment variable key. If it does not exist it returns the default.
 // The context may optionally contain a map superseding os.EnvKey.
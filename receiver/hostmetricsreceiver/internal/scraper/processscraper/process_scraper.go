@@ -7,7 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"runtime"
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/common"
@@ -21,22 +24,27 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/handlecount"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/ucal"
 )
 
 const (
-	cpuMetricsLen               = 1
-	memoryMetricsLen            = 2
-	memoryUtilizationMetricsLen = 1
-	diskMetricsLen              = 1
-	pagingMetricsLen            = 1
-	threadMetricsLen            = 1
-	contextSwitchMetricsLen     = 1
-	fileDescriptorMetricsLen    = 1
-	handleMetricsLen            = 1
-	signalMetricsLen            = 1
+	cpuMetricsLen                 = 1
+	memoryMetricsLen              = 2
+	memoryUtilizationMetricsLen   = 1
+	diskMetricsLen                = 1
+	pagingMetricsLen              = 1
+	threadMetricsLen              = 1
+	contextSwitchMetricsLen       = 1
+	fileDescriptorMetricsLen      = 1
+	handleMetricsLen              = 1
+	signalMetricsLen              = 1
+	fileDescriptorLimitMetricsLen = 1
+
+	// otherExecutableName is the executable name of the process the metrics of the processes not in the top N are summed into
+	otherExecutableName = "other"
 
 	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen + memoryUtilizationMetricsLen + pagingMetricsLen + threadMetricsLen + contextSwitchMetricsLen + fileDescriptorMetricsLen + signalMetricsLen
 )
@@ -48,9 +56,20 @@ type scraper struct {
 	mb                 *metadata.MetricsBuilder
 	includeFS          filterset.FilterSet
 	excludeFS          filterset.FilterSet
+	includeCgroupFS    filterset.FilterSet
+	excludeCgroupFS    filterset.FilterSet
+	includeContainerFS filterset.FilterSet
+	excludeContainerFS filterset.FilterSet
 	scrapeProcessDelay time.Duration
 	ucals              map[int32]*ucal.CPUUtilizationCalculator
 	logicalCores       int
+	filterTelemetry    *internal.FilterTelemetry
+
+	// prevDiskIO holds the disk I/O counters of the processes at the previous scrape, see convertDiskIOToRates
+	prevDiskIO map[string]diskIOSample
+
+	// prevCPUTimes holds the CPU time of the processes at the previous scrape, by PID, to rank them with top_n_by: cpu
+	prevCPUTimes map[int32]float64
 
 	// for mocking
 	getProcessCreateTime func(p processHandle, ctx context.Context) (int64, error)
@@ -68,23 +87,39 @@ func newProcessScraper(settings receiver.CreateSettings, cfg *Config) (*scraper,
 		getProcessHandles:    getProcessHandlesInternal,
 		scrapeProcessDelay:   cfg.ScrapeProcessDelay,
 		ucals:                make(map[int32]*ucal.CPUUtilizationCalculator),
+		prevCPUTimes:         make(map[int32]float64),
+		prevDiskIO:           make(map[string]diskIOSample),
 		handleCountManager:   handlecount.NewManager(),
 	}
 
+	if cfg.TopN < 0 {
+		return nil, fmt.Errorf("invalid top_n %d, must not be negative", cfg.TopN)
+	}
+	switch cfg.TopNBy {
+	case "", "cpu", "memory":
+	default:
+		return nil, fmt.Errorf("invalid top_n_by %q, must be cpu or memory", cfg.TopNBy)
+	}
+
 	var err error
 
-	if len(cfg.Include.Names) > 0 {
-		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Names, &cfg.Include.Config)
-		if err != nil {
-			return nil, fmt.Errorf("error creating process include filters: %w", err)
-		}
+	if scraper.includeFS, err = createFilterSet(cfg.Include.Names, &cfg.Include.Config); err != nil {
+		return nil, fmt.Errorf("error creating process include filters: %w", err)
 	}
-
-	if len(cfg.Exclude.Names) > 0 {
-		scraper.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.Names, &cfg.Exclude.Config)
-		if err != nil {
-			return nil, fmt.Errorf("error creating process exclude filters: %w", err)
-		}
+	if scraper.excludeFS, err = createFilterSet(cfg.Exclude.Names, &cfg.Exclude.Config); err != nil {
+		return nil, fmt.Errorf("error creating process exclude filters: %w", err)
+	}
+	if scraper.includeCgroupFS, err = createFilterSet(cfg.Include.Cgroups, &cfg.Include.Config); err != nil {
+		return nil, fmt.Errorf("error creating process cgroup include filters: %w", err)
+	}
+	if scraper.excludeCgroupFS, err = createFilterSet(cfg.Exclude.Cgroups, &cfg.Exclude.Config); err != nil {
+		return nil, fmt.Errorf("error creating process cgroup exclude filters: %w", err)
+	}
+	if scraper.includeContainerFS, err = createFilterSet(cfg.Include.ContainerIDs, &cfg.Include.Config); err != nil {
+		return nil, fmt.Errorf("error creating process container ID include filters: %w", err)
+	}
+	if scraper.excludeContainerFS, err = createFilterSet(cfg.Exclude.ContainerIDs, &cfg.Exclude.Config); err != nil {
+		return nil, fmt.Errorf("error creating process container ID exclude filters: %w", err)
 	}
 
 	logicalCores, err := cpu.Counts(true)
@@ -94,6 +129,10 @@ func newProcessScraper(settings receiver.CreateSettings, cfg *Config) (*scraper,
 
 	scraper.logicalCores = logicalCores
 
+	if scraper.filterTelemetry, err = internal.NewFilterTelemetry(settings.TelemetrySettings, TypeStr); err != nil {
+		return nil, err
+	}
+
 	return scraper, nil
 }
 
@@ -131,6 +170,11 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	presentPIDs := make(map[int32]struct{}, len(data))
 	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
 
+	others := map[*processMetadata]bool{}
+	if s.config.TopN > 0 {
+		others = s.rankProcesses(ctx, data)
+	}
+
 	for _, md := range data {
 		presentPIDs[md.pid] = struct{}{}
 
@@ -168,6 +212,10 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			errs.AddPartial(fileDescriptorMetricsLen, fmt.Errorf("error reading open file descriptor count for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
 
+		if err = s.scrapeAndAppendOpenFileDescriptorsLimitMetric(ctx, now, md.handle); err != nil {
+			errs.AddPartial(fileDescriptorLimitMetricsLen, fmt.Errorf("error reading open file descriptor limit for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
 		if err = s.scrapeAndAppendHandlesMetric(ctx, now, int64(md.pid)); err != nil {
 			errs.AddPartial(handleMetricsLen, fmt.Errorf("error reading handle count for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
@@ -176,7 +224,22 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			errs.AddPartial(signalMetricsLen, fmt.Errorf("error reading pending signals for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
 
-		s.mb.EmitForResource(metadata.WithResource(md.buildResource(s.mb.NewResourceBuilder())),
+		var resource pcommon.Resource
+		switch {
+		case others[md]:
+			rb := s.mb.NewResourceBuilder()
+			rb.SetProcessExecutableName(otherExecutableName)
+			resource = rb.Emit()
+			resource.Attributes().PutBool(aggregateAttribute, true)
+		case s.config.AggregateByExecutableName:
+			rb := s.mb.NewResourceBuilder()
+			rb.SetProcessExecutableName(md.executable.name)
+			resource = rb.Emit()
+			resource.Attributes().PutBool(aggregateAttribute, true)
+		default:
+			resource = md.buildResource(s.mb.NewResourceBuilder())
+		}
+		s.mb.EmitForResource(metadata.WithResource(resource),
 			metadata.WithStartTimeOverride(pcommon.Timestamp(md.createTime*1e6)))
 	}
 
@@ -186,8 +249,53 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			delete(s.ucals, pid)
 		}
 	}
+	for pid := range s.prevCPUTimes {
+		if _, ok := presentPIDs[pid]; !ok {
+			delete(s.prevCPUTimes, pid)
+		}
+	}
+
+	metrics := s.mb.Emit()
+	if len(others) > 0 || s.config.AggregateByExecutableName {
+		mergeResourceMetrics(metrics)
+	}
+	if s.config.ReportDiskIOAsRate {
+		s.convertDiskIOToRates(metrics)
+	}
+	return metrics, errs.Combine()
+}
+
+// rankProcesses ranks the processes by their usage of the resource set by top_n_by, and returns the ones
+// that are not in the top N. The usage of the processes that cannot be read is taken as zero.
+func (s *scraper) rankProcesses(ctx context.Context, data []*processMetadata) map[*processMetadata]bool {
+	usages := make(map[*processMetadata]float64, len(data))
+	for _, md := range data {
+		if s.config.TopNBy == "memory" {
+			if mem, err := md.handle.MemoryInfoWithContext(ctx); err == nil {
+				usages[md] = float64(mem.RSS)
+			}
+			continue
+		}
+		if times, err := md.handle.TimesWithContext(ctx); err == nil {
+			total := times.User + times.System + times.Iowait
+			prev, ok := s.prevCPUTimes[md.pid]
+			s.prevCPUTimes[md.pid] = total
+			if ok && total >= prev {
+				total -= prev
+			}
+			usages[md] = total
+		}
+	}
 
-	return s.mb.Emit(), errs.Combine()
+	ranked := slices.Clone(data)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return usages[ranked[i]] > usages[ranked[j]]
+	})
+	others := make(map[*processMetadata]bool)
+	for _, md := range ranked[min(s.config.TopN, len(ranked)):] {
+		others[md] = true
+	}
+	return others
 }
 
 // getProcessMetadata returns a slice of processMetadata, including handles,
@@ -208,6 +316,7 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 	}
 
 	data := make([]*processMetadata, 0, handles.Len())
+	filtered := 0
 	for i := 0; i < handles.Len(); i++ {
 		pid := handles.Pid(i)
 		handle := handles.At(i)
@@ -236,9 +345,8 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 
 		executable := &executableMetadata{name: name, path: exe, cgroup: cgroup}
 
-		// filter processes by name
-		if (s.includeFS != nil && !s.includeFS.Matches(executable.name)) ||
-			(s.excludeFS != nil && s.excludeFS.Matches(executable.name)) {
+		if !s.includeProcess(executable) {
+			filtered++
 			continue
 		}
 
@@ -269,22 +377,73 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 			errs.AddPartial(0, fmt.Errorf("error reading parent pid for process %q (pid %v): %w", executable.name, pid, err))
 		}
 
+		var environment map[string]string
+		if len(s.config.EnvironmentVariables) > 0 {
+			environment, err = getProcessEnvironment(ctx, handle, s.config.EnvironmentVariables)
+			if err != nil {
+				errs.AddPartial(0, fmt.Errorf("error reading environment for process %q (pid %v): %w", executable.name, pid, err))
+			}
+		}
+
 		md := &processMetadata{
-			pid:        pid,
-			parentPid:  parentPid,
-			executable: executable,
-			command:    command,
-			username:   username,
-			handle:     handle,
-			createTime: createTime,
+			pid:         pid,
+			parentPid:   parentPid,
+			executable:  executable,
+			command:     command,
+			username:    username,
+			handle:      handle,
+			createTime:  createTime,
+			environment: environment,
 		}
 
 		data = append(data, md)
 	}
+	s.filterTelemetry.RecordFiltered(ctx, filtered)
 
 	return data, errs.Combine()
 }
 
+// includeProcess filters processes by name, cgroup path and container ID.
+func (s *scraper) includeProcess(executable *executableMetadata) bool {
+	if (s.includeFS != nil && !s.includeFS.Matches(executable.name)) ||
+		(s.excludeFS != nil && s.excludeFS.Matches(executable.name)) {
+		return false
+	}
+
+	if s.includeCgroupFS != nil || s.excludeCgroupFS != nil {
+		paths := cgroupPaths(executable.cgroup)
+		if (s.includeCgroupFS != nil && !matchesAny(s.includeCgroupFS, paths)) ||
+			(s.excludeCgroupFS != nil && matchesAny(s.excludeCgroupFS, paths)) {
+			return false
+		}
+	}
+
+	if s.includeContainerFS != nil || s.excludeContainerFS != nil {
+		containerID := cgroupContainerID(executable.cgroup)
+		if (s.includeContainerFS != nil && (containerID == "" || !s.includeContainerFS.Matches(containerID))) ||
+			(s.excludeContainerFS != nil && containerID != "" && s.excludeContainerFS.Matches(containerID)) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesAny(fs filterset.FilterSet, values []string) bool {
+	for _, value := range values {
+		if fs.Matches(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func createFilterSet(values []string, cfg *filterset.Config) (filterset.FilterSet, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return filterset.CreateFilterSet(values, cfg)
+}
+
 func (s *scraper) scrapeAndAppendCPUTimeMetric(ctx context.Context, now pcommon.Timestamp, handle processHandle, pid int32) error {
 	if !s.config.MetricsBuilderConfig.Metrics.ProcessCPUTime.Enabled && !s.config.MetricsBuilderConfig.Metrics.ProcessCPUUtilization.Enabled {
 		return nil
@@ -342,7 +501,8 @@ func (s *scraper) scrapeAndAppendMemoryUtilizationMetric(ctx context.Context, no
 }
 
 func (s *scraper) scrapeAndAppendDiskMetrics(ctx context.Context, now pcommon.Timestamp, handle processHandle) error {
-	if !(s.config.MetricsBuilderConfig.Metrics.ProcessDiskIo.Enabled || s.config.MetricsBuilderConfig.Metrics.ProcessDiskOperations.Enabled) || runtime.GOOS == "darwin" {
+	if !(s.config.MetricsBuilderConfig.Metrics.ProcessDiskIo.Enabled || s.config.MetricsBuilderConfig.Metrics.ProcessDiskOperations.Enabled ||
+		s.config.MetricsBuilderConfig.Metrics.ProcessDiskCancelledWrite.Enabled) || runtime.GOOS == "darwin" {
 		return nil
 	}
 
@@ -359,9 +519,76 @@ func (s *scraper) scrapeAndAppendDiskMetrics(ctx context.Context, now pcommon.Ti
 	s.mb.RecordProcessDiskOperationsDataPoint(now, int64(io.ReadCount), metadata.AttributeDirectionRead)
 	s.mb.RecordProcessDiskOperationsDataPoint(now, int64(io.WriteCount), metadata.AttributeDirectionWrite)
 
+	if !s.config.MetricsBuilderConfig.Metrics.ProcessDiskCancelledWrite.Enabled || runtime.GOOS != "linux" {
+		return nil
+	}
+	cancelledWrite, err := handle.CancelledWriteBytesWithContext(ctx)
+	if err != nil {
+		if s.config.MuteProcessIOError {
+			return nil
+		}
+		return err
+	}
+	s.mb.RecordProcessDiskCancelledWriteDataPoint(now, int64(cancelledWrite))
+
 	return nil
 }
 
+// diskIOSample is the value of a disk I/O counter of a process at a given time.
+type diskIOSample struct {
+	value int64
+	ts    pcommon.Timestamp
+}
+
+// diskIORateMetrics are the metrics converted to per-second rates when `report_disk_io_as_rate` is enabled.
+var diskIORateMetrics = map[string]bool{
+	"process.disk.io":              true,
+	"process.disk.operations":      true,
+	"process.disk.cancelled_write": true,
+}
+
+// convertDiskIOToRates replaces the cumulative sums listed in diskIORateMetrics with gauges holding their
+// per-second rate of change since the previous scrape. The data points of a process are dropped on its first
+// scrape and after its counters were reset, and so are the metrics left without data points.
+func (s *scraper) convertDiskIOToRates(md pmetric.Metrics) {
+	prevDiskIO := s.prevDiskIO
+	s.prevDiskIO = make(map[string]diskIOSample, len(prevDiskIO))
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceKey := attributesKey(rm.Resource().Attributes())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				if metric.Type() != pmetric.MetricTypeSum || !diskIORateMetrics[metric.Name()] {
+					continue
+				}
+				gauge := pmetric.NewGauge()
+				dps := metric.Sum().DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					dp := dps.At(l)
+					key := resourceKey + "/" + metric.Name() + "/" + attributesKey(dp.Attributes())
+					s.prevDiskIO[key] = diskIOSample{value: dp.IntValue(), ts: dp.Timestamp()}
+
+					prev, ok := prevDiskIO[key]
+					if !ok || dp.IntValue() < prev.value || dp.Timestamp() <= prev.ts {
+						continue
+					}
+					rate := gauge.DataPoints().AppendEmpty()
+					rate.SetTimestamp(dp.Timestamp())
+					rate.SetDoubleValue(float64(dp.IntValue()-prev.value) / dp.Timestamp().AsTime().Sub(prev.ts.AsTime()).Seconds())
+					dp.Attributes().CopyTo(rate.Attributes())
+				}
+				metric.SetUnit(metric.Unit() + "/s")
+				gauge.MoveTo(metric.SetEmptyGauge())
+			}
+			metrics.RemoveIf(func(metric pmetric.Metric) bool {
+				return metric.Type() == pmetric.MetricTypeGauge && diskIORateMetrics[metric.Name()] && metric.Gauge().DataPoints().Len() == 0
+			})
+		}
+	}
+}
+
 func (s *scraper) scrapeAndAppendPagingMetric(ctx context.Context, now pcommon.Timestamp, handle processHandle) error {
 	if !s.config.MetricsBuilderConfig.Metrics.ProcessPagingFaults.Enabled {
 		return nil
@@ -424,6 +651,26 @@ func (s *scraper) scrapeAndAppendOpenFileDescriptorsMetric(ctx context.Context,
 	return nil
 }
 
+func (s *scraper) scrapeAndAppendOpenFileDescriptorsLimitMetric(ctx context.Context, now pcommon.Timestamp, handle processHandle) error {
+	if !s.config.MetricsBuilderConfig.Metrics.ProcessOpenFileDescriptorsLimit.Enabled {
+		return nil
+	}
+
+	rlimitStats, err := handle.RlimitUsageWithContext(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	for _, rlimitStat := range rlimitStats {
+		if rlimitStat.Resource == process.RLIMIT_NOFILE && rlimitStat.Soft <= math.MaxInt64 {
+			s.mb.RecordProcessOpenFileDescriptorsLimitDataPoint(now, int64(rlimitStat.Soft))
+			break
+		}
+	}
+
+	return nil
+}
+
 func (s *scraper) refreshHandleCounts() error {
 	if !s.config.MetricsBuilderConfig.Metrics.ProcessHandles.Enabled {
 		return nil
@@ -7,10 +7,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/shirou/gopsutil/v3/common"
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/process"
 	"github.com/stretchr/testify/assert"
@@ -390,6 +394,16 @@ func (p *processHandleMock) CgroupWithContext(ctx context.Context) (string, erro
 	return args.String(0), args.Error(1)
 }
 
+func (p *processHandleMock) CancelledWriteBytesWithContext(ctx context.Context) (uint64, error) {
+	args := p.MethodCalled("CancelledWriteBytesWithContext", ctx)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (p *processHandleMock) EnvironWithContext(ctx context.Context) ([]string, error) {
+	args := p.MethodCalled("EnvironWithContext", ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (p *processHandleMock) NameWithContext(ctx context.Context) (ret string, err error) {
 	args := p.MethodCalled("NameWithContext", ctx)
 	return args.String(0), args.Error(1)
@@ -535,6 +549,9 @@ func initDefaultsHandleMock(t mock.TestingT, handleMock *processHandleMock) {
 	if !handleMock.IsMethodCallable(t, "CgroupWithContext", mock.Anything) {
 		handleMock.On("CgroupWithContext", mock.Anything).Return("cgroup", nil)
 	}
+	if !handleMock.IsMethodCallable(t, "CancelledWriteBytesWithContext", mock.Anything) {
+		handleMock.On("CancelledWriteBytesWithContext", mock.Anything).Return(uint64(0), nil)
+	}
 }
 
 func TestScrapeMetrics_Filtered(t *testing.T) {
@@ -667,6 +684,101 @@ func TestScrapeMetrics_Filtered(t *testing.T) {
 	}
 }
 
+func TestScrapeMetrics_FilteredByCgroup(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	const containerID = "3f4e2b8c9d1a0e7f6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a"
+	cgroups := map[string]string{
+		"sshd":  "0::/system.slice/sshd.service",
+		"nginx": "0::/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod1234.slice/cri-containerd-" + containerID + ".scope",
+		"redis": "12:memory:/docker/" + strings.Repeat("a", 64) + "\n1:name=systemd:/docker/" + strings.Repeat("a", 64),
+	}
+
+	testCases := []struct {
+		name          string
+		include       MatchConfig
+		exclude       MatchConfig
+		expectedNames []string
+	}{
+		{
+			name:          "Include Cgroup",
+			include:       MatchConfig{Cgroups: []string{"/system.slice/.*"}},
+			expectedNames: []string{"sshd"},
+		},
+		{
+			name:          "Exclude Cgroup",
+			exclude:       MatchConfig{Cgroups: []string{"/kubepods.slice/.*"}},
+			expectedNames: []string{"redis", "sshd"},
+		},
+		{
+			name:          "Include Container ID",
+			include:       MatchConfig{ContainerIDs: []string{containerID}},
+			expectedNames: []string{"nginx"},
+		},
+		{
+			name:          "Exclude Container ID",
+			exclude:       MatchConfig{ContainerIDs: []string{"^a+$"}},
+			expectedNames: []string{"nginx", "sshd"},
+		},
+		{
+			name:          "Include Any Container And Name",
+			include:       MatchConfig{Names: []string{"redis"}, ContainerIDs: []string{".*"}},
+			expectedNames: []string{"redis"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.include.Config = filterset.Config{MatchType: filterset.Regexp}
+			test.exclude.Config = filterset.Config{MatchType: filterset.Regexp}
+			scraper, err := newProcessScraper(receivertest.NewNopCreateSettings(), &Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				Include:              test.include,
+				Exclude:              test.exclude,
+			})
+			require.NoError(t, err, "Failed to create process scraper: %v", err)
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			handles := make([]*processHandleMock, 0, len(cgroups))
+			for _, name := range []string{"nginx", "redis", "sshd"} {
+				handleMock := &processHandleMock{}
+				handleMock.On("NameWithContext", mock.Anything).Return(name, nil)
+				handleMock.On("ExeWithContext", mock.Anything).Return(name, nil)
+				handleMock.On("CgroupWithContext", mock.Anything).Return(cgroups[name], nil)
+				initDefaultsHandleMock(t, handleMock)
+				handles = append(handles, handleMock)
+			}
+			scraper.getProcessHandles = func(context.Context) (processHandles, error) {
+				return &processHandlesMock{handles: handles}, nil
+			}
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+			names := make([]string, 0, md.ResourceMetrics().Len())
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				name, _ := md.ResourceMetrics().At(i).Resource().Attributes().Get(conventions.AttributeProcessExecutableName)
+				names = append(names, name.Str())
+			}
+			assert.Equal(t, test.expectedNames, names)
+		})
+	}
+}
+
+func TestCgroupContainerID(t *testing.T) {
+	const id = "3f4e2b8c9d1a0e7f6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a"
+	for cgroup, expected := range map[string]string{
+		"0::/system.slice/sshd.service": "",
+		"0::/":                          "",
+		"0::/system.slice/docker-" + id + ".scope":                               id,
+		"0::/kubepods.slice/kubepods-pod1.slice/cri-containerd-" + id + ".scope": id,
+		"0::/kubepods/besteffort/pod1/crio-" + id:                                id,
+		"12:memory:/docker/" + id + "\n1:name=systemd:/docker/" + id:             id,
+	} {
+		assert.Equal(t, expected, cgroupContainerID(cgroup), cgroup)
+	}
+	assert.Equal(t, []string{"/a", "/b"}, cgroupPaths("12:memory:/a\n11:cpu:/a\n1:name=systemd:/b\n"))
+}
+
 func enableOptionalMetrics(ms *metadata.MetricsConfig) {
 	ms.ProcessMemoryUtilization.Enabled = true
 	ms.ProcessThreads.Enabled = true
@@ -676,6 +788,272 @@ func enableOptionalMetrics(ms *metadata.MetricsConfig) {
 	ms.ProcessSignalsPending.Enabled = true
 }
 
+func TestScrapeMetrics_OpenFileDescriptorsLimit(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	metricsBuilderConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsBuilderConfig.Metrics.ProcessOpenFileDescriptors.Enabled = true
+	metricsBuilderConfig.Metrics.ProcessOpenFileDescriptorsLimit.Enabled = true
+	scraper, err := newProcessScraper(receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metricsBuilderConfig})
+	require.NoError(t, err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	handleMock := &processHandleMock{}
+	handleMock.On("NumFDsWithContext", mock.Anything).Return(int32(42), nil)
+	handleMock.On("RlimitUsageWithContext", mock.Anything, false).Return([]process.RlimitStat{
+		{Resource: process.RLIMIT_SIGPENDING, Soft: 63352, Hard: 63352},
+		{Resource: process.RLIMIT_NOFILE, Soft: 1024, Hard: 524288},
+	}, nil)
+	initDefaultsHandleMock(t, handleMock)
+	scraper.getProcessHandles = func(context.Context) (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	values := make(map[string]int64)
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Type() == pmetric.MetricTypeSum {
+			values[metrics.At(i).Name()] = metrics.At(i).Sum().DataPoints().At(0).IntValue()
+		}
+	}
+	assert.Equal(t, int64(42), values["process.open_file_descriptors"])
+	assert.Equal(t, int64(1024), values["process.open_file_descriptors.limit"])
+}
+
+func TestScrapeMetrics_TopN(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	_, err := newProcessScraper(receivertest.NewNopCreateSettings(), &Config{TopN: -1})
+	assert.EqualError(t, err, "invalid top_n -1, must not be negative")
+	_, err = newProcessScraper(receivertest.NewNopCreateSettings(), &Config{TopN: 1, TopNBy: "disk"})
+	assert.EqualError(t, err, `invalid top_n_by "disk", must be cpu or memory`)
+
+	scraper, err := newProcessScraper(receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		TopN:                 2,
+		TopNBy:               "memory",
+	})
+	require.NoError(t, err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	rss := map[string]uint64{"postgres": 4000, "java": 9000, "bash": 10, "sshd": 20, "cron": 30}
+	handles := make([]*processHandleMock, 0, len(rss))
+	for _, name := range []string{"bash", "cron", "java", "postgres", "sshd"} {
+		handleMock := &processHandleMock{}
+		handleMock.On("NameWithContext", mock.Anything).Return(name, nil)
+		handleMock.On("MemoryInfoWithContext", mock.Anything).Return(&process.MemoryInfoStat{RSS: rss[name], VMS: 2 * rss[name]}, nil)
+		initDefaultsHandleMock(t, handleMock)
+		handles = append(handles, handleMock)
+	}
+	scraper.getProcessHandles = func(context.Context) (processHandles, error) {
+		return &processHandlesMock{handles: handles}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	usages := make(map[string]int64)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		name, _ := rm.Resource().Attributes().Get(conventions.AttributeProcessExecutableName)
+		memory, ok := findMetric(rm.ScopeMetrics().At(0).Metrics(), "process.memory.usage")
+		require.True(t, ok)
+		usages[name.Str()] = memory.Sum().DataPoints().At(0).IntValue()
+		if name.Str() == otherExecutableName {
+			assert.Equal(t, map[string]any{conventions.AttributeProcessExecutableName: otherExecutableName}, rm.Resource().Attributes().AsRaw())
+			virtual, _ := findMetric(rm.ScopeMetrics().At(0).Metrics(), "process.memory.virtual")
+			assert.Equal(t, int64(120), virtual.Sum().DataPoints().At(0).IntValue())
+			cpuTime, _ := findMetric(rm.ScopeMetrics().At(0).Metrics(), "process.cpu.time")
+			assert.False(t, cpuTime.Sum().IsMonotonic())
+		} else {
+			cpuTime, _ := findMetric(rm.ScopeMetrics().At(0).Metrics(), "process.cpu.time")
+			assert.True(t, cpuTime.Sum().IsMonotonic())
+		}
+	}
+	assert.Equal(t, map[string]int64{"java": 9000, "postgres": 4000, "other": 60}, usages)
+}
+
+func TestScrapeMetrics_AggregateByExecutableName(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	scraper, err := newProcessScraper(receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig:      metadata.DefaultMetricsBuilderConfig(),
+		AggregateByExecutableName: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	handles := make([]*processHandleMock, 0, 4)
+	for i, name := range []string{"nginx", "nginx", "nginx", "postgres"} {
+		handleMock := &processHandleMock{}
+		handleMock.On("NameWithContext", mock.Anything).Return(name, nil)
+		handleMock.On("MemoryInfoWithContext", mock.Anything).Return(&process.MemoryInfoStat{RSS: uint64(100 * (i + 1))}, nil)
+		handleMock.On("TimesWithContext", mock.Anything).Return(&cpu.TimesStat{User: 1.5, System: 0.5}, nil)
+		initDefaultsHandleMock(t, handleMock)
+		handles = append(handles, handleMock)
+	}
+	scraper.getProcessHandles = func(context.Context) (processHandles, error) {
+		return &processHandlesMock{handles: handles}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	usages := make(map[string]int64)
+	cpuTimes := make(map[string]float64)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		name, _ := rm.Resource().Attributes().Get(conventions.AttributeProcessExecutableName)
+		assert.Equal(t, 1, rm.Resource().Attributes().Len())
+		memory, ok := findMetric(rm.ScopeMetrics().At(0).Metrics(), "process.memory.usage")
+		require.True(t, ok)
+		usages[name.Str()] = memory.Sum().DataPoints().At(0).IntValue()
+		cpuTime, ok := findMetric(rm.ScopeMetrics().At(0).Metrics(), "process.cpu.time")
+		require.True(t, ok)
+		assert.False(t, cpuTime.Sum().IsMonotonic())
+		for j := 0; j < cpuTime.Sum().DataPoints().Len(); j++ {
+			state, _ := cpuTime.Sum().DataPoints().At(j).Attributes().Get("state")
+			if state.Str() == "user" {
+				cpuTimes[name.Str()] = cpuTime.Sum().DataPoints().At(j).DoubleValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{"nginx": 600, "postgres": 400}, usages)
+	assert.Equal(t, map[string]float64{"nginx": 4.5, "postgres": 1.5}, cpuTimes)
+}
+
+func TestScrapeMetrics_EnvironmentVariables(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	scraper, err := newProcessScraper(receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		EnvironmentVariables: []string{"SERVICE_NAME", "DEPLOYMENT"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	withEnv := &processHandleMock{}
+	withEnv.On("NameWithContext", mock.Anything).Return("checkout", nil)
+	withEnv.On("EnvironWithContext", mock.Anything).Return([]string{"PATH=/usr/bin", "SERVICE_NAME=checkout", "DEPLOYMENT=blue=green"}, nil)
+	initDefaultsHandleMock(t, withEnv)
+	withoutEnv := &processHandleMock{}
+	withoutEnv.On("NameWithContext", mock.Anything).Return("sshd", nil)
+	withoutEnv.On("EnvironWithContext", mock.Anything).Return([]string(nil), errors.New("permission denied"))
+	initDefaultsHandleMock(t, withoutEnv)
+	scraper.getProcessHandles = func(context.Context) (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{withEnv, withoutEnv}}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, `error reading environment for process "sshd" (pid 1): permission denied`)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	attrs := md.ResourceMetrics().At(0).Resource().Attributes().AsRaw()
+	assert.Equal(t, "checkout", attrs["process.env.SERVICE_NAME"])
+	assert.Equal(t, "blue=green", attrs["process.env.DEPLOYMENT"])
+	assert.NotContains(t, attrs, "process.env.PATH")
+	attrs = md.ResourceMetrics().At(1).Resource().Attributes().AsRaw()
+	assert.NotContains(t, attrs, "process.env.SERVICE_NAME")
+}
+
+func TestScrapeMetrics_DiskIOAsRate(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("skipping test on %v", runtime.GOOS)
+	}
+
+	metricsBuilderConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsBuilderConfig.Metrics.ProcessDiskOperations.Enabled = true
+	metricsBuilderConfig.Metrics.ProcessDiskCancelledWrite.Enabled = true
+	scraper, err := newProcessScraper(receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: metricsBuilderConfig,
+		ReportDiskIOAsRate:   true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	handleMock := &processHandleMock{}
+	handleMock.On("NameWithContext", mock.Anything).Return("postgres", nil)
+	initDefaultsHandleMock(t, handleMock)
+	scraper.getProcessHandles = func(context.Context) (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+	setCounters := func(bytes, count, cancelled uint64) {
+		handleMock.ExpectedCalls = nil
+		handleMock.On("NameWithContext", mock.Anything).Return("postgres", nil)
+		handleMock.On("IOCountersWithContext", mock.Anything).Return(&process.IOCountersStat{
+			ReadBytes: bytes, WriteBytes: bytes, ReadCount: count, WriteCount: count,
+		}, nil)
+		handleMock.On("CancelledWriteBytesWithContext", mock.Anything).Return(cancelled, nil)
+		initDefaultsHandleMock(t, handleMock)
+	}
+
+	// nothing is reported on the first scrape
+	setCounters(1000, 10, 100)
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for _, name := range []string{"process.disk.io", "process.disk.operations", "process.disk.cancelled_write"} {
+		_, ok := findMetric(metrics, name)
+		assert.False(t, ok, name)
+	}
+
+	setCounters(3000, 20, 100)
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for name, unit := range map[string]string{"process.disk.io": "By/s", "process.disk.operations": "{operations}/s", "process.disk.cancelled_write": "By/s"} {
+		metric, ok := findMetric(metrics, name)
+		require.True(t, ok, name)
+		assert.Equal(t, unit, metric.Unit())
+		require.Equal(t, pmetric.MetricTypeGauge, metric.Type(), name)
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			dp := metric.Gauge().DataPoints().At(i)
+			if name == "process.disk.cancelled_write" {
+				assert.Zero(t, dp.DoubleValue())
+			} else {
+				assert.Positive(t, dp.DoubleValue(), name)
+			}
+		}
+	}
+	io, _ := findMetric(metrics, "process.disk.io")
+	assert.Equal(t, 2, io.Gauge().DataPoints().Len())
+}
+
+func TestCancelledWriteBytes(t *testing.T) {
+	procPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "42"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, "42", "io"),
+		[]byte("rchar: 2012\nwchar: 0\nsyscr: 7\nsyscw: 0\nread_bytes: 4096\nwrite_bytes: 8192\ncancelled_write_bytes: 4096\n"), 0o600))
+	ctx := context.WithValue(context.Background(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: procPath})
+
+	cancelled, err := wrappedProcessHandle{&process.Process{Pid: 42}}.CancelledWriteBytesWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4096), cancelled)
+
+	_, err = wrappedProcessHandle{&process.Process{Pid: 43}}.CancelledWriteBytesWithContext(ctx)
+	assert.Error(t, err)
+}
+
+func TestRankProcesses_CPU(t *testing.T) {
+	scraper := &scraper{config: &Config{TopN: 1}, prevCPUTimes: make(map[int32]float64)}
+	handles := []*processHandleMock{{}, {}}
+	data := []*processMetadata{{pid: 1, handle: handles[0]}, {pid: 2, handle: handles[1]}}
+	setTimes := func(times ...float64) {
+		for i, handleMock := range handles {
+			handleMock.ExpectedCalls = nil
+			handleMock.On("TimesWithContext", mock.Anything).Return(&cpu.TimesStat{User: times[i]}, nil)
+		}
+	}
+
+	// the first process used the most CPU time since it started
+	setTimes(100, 50)
+	assert.Equal(t, map[*processMetadata]bool{data[1]: true}, scraper.rankProcesses(context.Background(), data))
+
+	// the second process used the most CPU time since the previous scrape
+	setTimes(101, 60)
+	assert.Equal(t, map[*processMetadata]bool{data[0]: true}, scraper.rankProcesses(context.Background(), data))
+}
+
 func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 	skipTestOnUnsupportedOS(t)
 
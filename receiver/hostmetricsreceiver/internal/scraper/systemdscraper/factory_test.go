@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package systemdscraper
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	factory := &Factory{}
+	cfg := factory.CreateDefaultConfig()
+	assert.IsType(t, &Config{}, cfg)
+}
+
+func TestCreateMetricsScraper(t *testing.T) {
+	factory := &Factory{}
+	cfg := &Config{}
+
+	scraper, err := factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+
+	if runtime.GOOS == "linux" {
+		assert.NoError(t, err)
+		assert.NotNil(t, scraper)
+	} else {
+		assert.Error(t, err)
+		assert.Nil(t, scraper)
+	}
+}
+
+func TestCreateMetricsScraper_Error(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("systemd scraper only available on Linux")
+	}
+
+	factory := &Factory{}
+	cfg := &Config{Include: MatchConfig{Units: []string{""}}}
+
+	_, err := factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	assert.ErrorContains(t, err, "error creating systemd unit include filters")
+
+	cfg = &Config{Exclude: MatchConfig{Units: []string{"("}, Config: filterset.Config{MatchType: filterset.Regexp}}}
+	_, err = factory.CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	assert.ErrorContains(t, err, "error creating systemd unit exclude filters")
+}
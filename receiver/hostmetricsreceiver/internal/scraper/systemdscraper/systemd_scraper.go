@@ -0,0 +1,256 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package systemdscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper/internal/metadata"
+)
+
+const (
+	metricsLen        = 7
+	serviceMetricsLen = 1
+	socketMetricsLen  = 3
+	timerMetricsLen   = 1
+)
+
+// systemdConn is the subset of the systemd D-Bus API used by the scraper.
+type systemdConn interface {
+	ListUnitsContext(ctx context.Context) ([]dbus.UnitStatus, error)
+	GetUnitTypePropertiesContext(ctx context.Context, unit string, unitType string) (map[string]any, error)
+	Close()
+}
+
+// scraper for Systemd Metrics
+type scraper struct {
+	settings  receiver.CreateSettings
+	config    *Config
+	mb        *metadata.MetricsBuilder
+	includeFS filterset.FilterSet
+	excludeFS filterset.FilterSet
+
+	filterTelemetry *internal.FilterTelemetry
+
+	// for mocking
+	bootTime func(context.Context) (uint64, error)
+	connect  func(context.Context) (systemdConn, error)
+}
+
+// newSystemdScraper creates a Systemd Scraper
+func newSystemdScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) (*scraper, error) {
+	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, connect: connectSystemd}
+
+	var err error
+
+	if len(cfg.Include.Units) > 0 {
+		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Units, &cfg.Include.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating systemd unit include filters: %w", err)
+		}
+	}
+
+	if len(cfg.Exclude.Units) > 0 {
+		scraper.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.Units, &cfg.Exclude.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating systemd unit exclude filters: %w", err)
+		}
+	}
+
+	if scraper.filterTelemetry, err = internal.NewFilterTelemetry(settings.TelemetrySettings, TypeStr); err != nil {
+		return nil, err
+	}
+
+	return scraper, nil
+}
+
+// connectSystemd connects to systemd, through its private socket when running as root, or through the system bus.
+func connectSystemd(ctx context.Context) (systemdConn, error) {
+	return dbus.NewWithContext(ctx)
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errors scrapererror.ScrapeErrors
+
+	conn, err := s.connect(ctx)
+	if err != nil {
+		errors.AddPartial(metricsLen, fmt.Errorf("failed to connect to systemd: %w", err))
+		return s.mb.Emit(), errors.Combine()
+	}
+	defer conn.Close()
+
+	units, err := conn.ListUnitsContext(ctx)
+	if err != nil {
+		errors.AddPartial(metricsLen, fmt.Errorf("failed to list systemd units: %w", err))
+		return s.mb.Emit(), errors.Combine()
+	}
+
+	counts := make(map[metadata.AttributeType]map[metadata.AttributeState]int64)
+	filtered := 0
+	for _, unit := range units {
+		if !s.includeUnit(unit.Name) {
+			filtered++
+			continue
+		}
+
+		unitType, ok := metadata.MapAttributeType[unitTypeOf(unit.Name)]
+		if !ok {
+			continue
+		}
+		state, ok := metadata.MapAttributeState[unit.ActiveState]
+		if !ok {
+			continue
+		}
+
+		if counts[unitType] == nil {
+			counts[unitType] = make(map[metadata.AttributeState]int64)
+		}
+		counts[unitType][state]++
+
+		s.recordUnitStateDataPoints(now, unit.Name, state)
+
+		switch unitType {
+		case metadata.AttributeTypeService:
+			if err := s.scrapeServiceMetrics(ctx, conn, now, unit.Name); err != nil {
+				errors.AddPartial(serviceMetricsLen, err)
+			}
+		case metadata.AttributeTypeSocket:
+			if err := s.scrapeSocketMetrics(ctx, conn, now, unit.Name); err != nil {
+				errors.AddPartial(socketMetricsLen, err)
+			}
+		case metadata.AttributeTypeTimer:
+			if err := s.scrapeTimerMetrics(ctx, conn, now, unit.Name); err != nil {
+				errors.AddPartial(timerMetricsLen, err)
+			}
+		}
+	}
+	s.filterTelemetry.RecordFiltered(ctx, filtered)
+
+	for unitType, states := range counts {
+		for _, state := range metadata.MapAttributeState {
+			s.mb.RecordSystemSystemdUnitsDataPoint(now, states[state], unitType, state)
+		}
+	}
+
+	return s.mb.Emit(), errors.Combine()
+}
+
+// recordUnitStateDataPoints records a data point for every active state of the unit, set to 1 for its current state
+// and to 0 for the others, so that the previous state does not linger after a state change.
+func (s *scraper) recordUnitStateDataPoints(now pcommon.Timestamp, unit string, current metadata.AttributeState) {
+	for _, state := range metadata.MapAttributeState {
+		var value int64
+		if state == current {
+			value = 1
+		}
+		s.mb.RecordSystemSystemdUnitStateDataPoint(now, value, unit, state)
+	}
+}
+
+func (s *scraper) scrapeServiceMetrics(ctx context.Context, conn systemdConn, now pcommon.Timestamp, unit string) error {
+	if !s.config.MetricsBuilderConfig.Metrics.SystemSystemdServiceRestarts.Enabled {
+		return nil
+	}
+
+	properties, err := conn.GetUnitTypePropertiesContext(ctx, unit, "Service")
+	if err != nil {
+		return fmt.Errorf("failed to read the properties of %s: %w", unit, err)
+	}
+	if restarts, ok := uintProperty(properties, "NRestarts"); ok {
+		s.mb.RecordSystemSystemdServiceRestartsDataPoint(now, restarts, unit)
+	}
+	return nil
+}
+
+func (s *scraper) scrapeSocketMetrics(ctx context.Context, conn systemdConn, now pcommon.Timestamp, unit string) error {
+	metrics := s.config.MetricsBuilderConfig.Metrics
+	if !metrics.SystemSystemdSocketConnections.Enabled && !metrics.SystemSystemdSocketAccepted.Enabled &&
+		!metrics.SystemSystemdSocketRefused.Enabled {
+		return nil
+	}
+
+	properties, err := conn.GetUnitTypePropertiesContext(ctx, unit, "Socket")
+	if err != nil {
+		return fmt.Errorf("failed to read the properties of %s: %w", unit, err)
+	}
+	if connections, ok := uintProperty(properties, "NConnections"); ok {
+		s.mb.RecordSystemSystemdSocketConnectionsDataPoint(now, connections, unit)
+	}
+	if accepted, ok := uintProperty(properties, "NAccepted"); ok {
+		s.mb.RecordSystemSystemdSocketAcceptedDataPoint(now, accepted, unit)
+	}
+	if refused, ok := uintProperty(properties, "NRefused"); ok {
+		s.mb.RecordSystemSystemdSocketRefusedDataPoint(now, refused, unit)
+	}
+	return nil
+}
+
+func (s *scraper) scrapeTimerMetrics(ctx context.Context, conn systemdConn, now pcommon.Timestamp, unit string) error {
+	if !s.config.MetricsBuilderConfig.Metrics.SystemSystemdTimerLastTrigger.Enabled {
+		return nil
+	}
+
+	properties, err := conn.GetUnitTypePropertiesContext(ctx, unit, "Timer")
+	if err != nil {
+		return fmt.Errorf("failed to read the properties of %s: %w", unit, err)
+	}
+	// timers that never elapsed report 0
+	if lastTrigger, ok := uintProperty(properties, "LastTriggerUSec"); ok && lastTrigger > 0 {
+		s.mb.RecordSystemSystemdTimerLastTriggerDataPoint(now, lastTrigger/1e6, unit)
+	}
+	return nil
+}
+
+func (s *scraper) includeUnit(unit string) bool {
+	return (s.includeFS == nil || s.includeFS.Matches(unit)) &&
+		(s.excludeFS == nil || !s.excludeFS.Matches(unit))
+}
+
+// unitTypeOf returns the type of a unit, which is the suffix of its name, e.g. "service" for "sshd.service".
+func unitTypeOf(unit string) string {
+	if i := strings.LastIndexByte(unit, '.'); i >= 0 {
+		return unit[i+1:]
+	}
+	return ""
+}
+
+// uintProperty returns the value of an unsigned integer property, which is missing on older versions of systemd.
+func uintProperty(properties map[string]any, name string) (int64, bool) {
+	switch value := properties[name].(type) {
+	case uint32:
+		return int64(value), true
+	case uint64:
+		return int64(value), true
+	default:
+		return 0, false
+	}
+}
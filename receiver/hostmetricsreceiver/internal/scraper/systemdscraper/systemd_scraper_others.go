@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package systemdscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// scraper is not implemented outside of Linux, where the D-Bus client used to reach systemd does not build on every
+// platform, such as FreeBSD and AIX.
+type scraper struct{}
+
+func newSystemdScraper(context.Context, receiver.CreateSettings, *Config) (*scraper, error) {
+	return nil, errors.New("systemd scraper only available on Linux")
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	return nil
+}
+
+func (s *scraper) scrape(context.Context) (pmetric.Metrics, error) {
+	return pmetric.NewMetrics(), nil
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package systemdscraper
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper/internal/metadata"
+)
+
+type mockConn struct {
+	units      []dbus.UnitStatus
+	properties map[string]map[string]any
+	err        error
+	closed     bool
+}
+
+func (c *mockConn) ListUnitsContext(context.Context) ([]dbus.UnitStatus, error) {
+	return c.units, c.err
+}
+
+func (c *mockConn) GetUnitTypePropertiesContext(_ context.Context, unit string, _ string) (map[string]any, error) {
+	properties, ok := c.properties[unit]
+	if !ok {
+		return nil, errors.New("unit not found")
+	}
+	return properties, nil
+}
+
+func (c *mockConn) Close() {
+	c.closed = true
+}
+
+func newTestConn() *mockConn {
+	return &mockConn{
+		units: []dbus.UnitStatus{
+			{Name: "sshd.service", ActiveState: "active"},
+			{Name: "backup.service", ActiveState: "failed"},
+			{Name: "docker.socket", ActiveState: "active"},
+			{Name: "logrotate.timer", ActiveState: "active"},
+			{Name: "-.mount", ActiveState: "active"},
+			{Name: "unknown.type", ActiveState: "active"},
+		},
+		properties: map[string]map[string]any{
+			"sshd.service":    {"NRestarts": uint32(2)},
+			"backup.service":  {},
+			"docker.socket":   {"NConnections": uint32(3), "NAccepted": uint32(42), "NRefused": uint32(1)},
+			"logrotate.timer": {"LastTriggerUSec": uint64(1700000000123456)},
+		},
+	}
+}
+
+func newTestScraper(t *testing.T, cfg *Config, conn *mockConn) *scraper {
+	if runtime.GOOS != "linux" {
+		_, err := (&Factory{}).CreateMetricsScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+		require.Error(t, err)
+		t.Skip(err)
+	}
+
+	s, err := newSystemdScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	s.bootTime = func(context.Context) (uint64, error) { return 100, nil }
+	s.connect = func(context.Context) (systemdConn, error) { return conn, nil }
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	return s
+}
+
+func metricsByName(md pmetric.Metrics) map[string]pmetric.Metric {
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	byName := make(map[string]pmetric.Metric, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		byName[metrics.At(i).Name()] = metrics.At(i)
+	}
+	return byName
+}
+
+func TestScrape(t *testing.T) {
+	conn := newTestConn()
+	s := newTestScraper(t, &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}, conn)
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.True(t, conn.closed)
+
+	byName := metricsByName(md)
+	require.Len(t, byName, 7)
+
+	units := byName["system.systemd.units"].Sum().DataPoints()
+	// 4 unit types with 7 states each; the unit of an unknown type is left out
+	require.Equal(t, 4*len(metadata.MapAttributeState), units.Len())
+	counts := make(map[[2]string]int64)
+	for i := 0; i < units.Len(); i++ {
+		unitType, _ := units.At(i).Attributes().Get("type")
+		state, _ := units.At(i).Attributes().Get("state")
+		counts[[2]string{unitType.Str(), state.Str()}] = units.At(i).IntValue()
+	}
+	assert.Equal(t, int64(1), counts[[2]string{"service", "active"}])
+	assert.Equal(t, int64(1), counts[[2]string{"service", "failed"}])
+	assert.Equal(t, int64(0), counts[[2]string{"service", "inactive"}])
+	assert.Equal(t, int64(1), counts[[2]string{"mount", "active"}])
+
+	states := byName["system.systemd.unit.state"].Sum().DataPoints()
+	require.Equal(t, 5*len(metadata.MapAttributeState), states.Len())
+	for i := 0; i < states.Len(); i++ {
+		attributes := states.At(i).Attributes().AsRaw()
+		if attributes["unit"] == "backup.service" && attributes["state"] == "failed" {
+			assert.Equal(t, int64(1), states.At(i).IntValue())
+		} else if attributes["unit"] == "backup.service" {
+			assert.Equal(t, int64(0), states.At(i).IntValue())
+		}
+	}
+
+	// the restarts of backup.service are not reported by this version of systemd
+	restarts := byName["system.systemd.service.restarts"].Sum().DataPoints()
+	require.Equal(t, 1, restarts.Len())
+	assert.Equal(t, int64(2), restarts.At(0).IntValue())
+	assert.Equal(t, map[string]any{"unit": "sshd.service"}, restarts.At(0).Attributes().AsRaw())
+
+	assert.Equal(t, int64(3), byName["system.systemd.socket.connections"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(42), byName["system.systemd.socket.accepted"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(1), byName["system.systemd.socket.refused"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(1700000000), byName["system.systemd.timer.last_trigger"].Gauge().DataPoints().At(0).IntValue())
+}
+
+func TestScrape_Filter(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Include:              MatchConfig{Units: []string{`.*\.service`}, Config: filterset.Config{MatchType: filterset.Regexp}},
+		Exclude:              MatchConfig{Units: []string{"backup.service"}, Config: filterset.Config{MatchType: filterset.Strict}},
+	}
+	s := newTestScraper(t, cfg, newTestConn())
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	byName := metricsByName(md)
+	assert.Len(t, byName, 3)
+	states := byName["system.systemd.unit.state"].Sum().DataPoints()
+	require.Equal(t, len(metadata.MapAttributeState), states.Len())
+	unit, _ := states.At(0).Attributes().Get("unit")
+	assert.Equal(t, "sshd.service", unit.Str())
+}
+
+func TestScrape_Errors(t *testing.T) {
+	conn := newTestConn()
+	delete(conn.properties, "docker.socket")
+	s := newTestScraper(t, &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}, conn)
+
+	md, err := s.scrape(context.Background())
+	assert.EqualError(t, err, "failed to read the properties of docker.socket: unit not found")
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, socketMetricsLen, partialErr.Failed)
+	assert.Equal(t, 4, md.MetricCount())
+
+	conn.err = errors.New("err1")
+	md, err = s.scrape(context.Background())
+	assert.EqualError(t, err, "failed to list systemd units: err1")
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, metricsLen, partialErr.Failed)
+	assert.Equal(t, 0, md.MetricCount())
+
+	s.connect = func(context.Context) (systemdConn, error) { return nil, errors.New("err2") }
+	_, err = s.scrape(context.Background())
+	assert.EqualError(t, err, "failed to connect to systemd: err2")
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package systemscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemscraper"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemscraper/internal/metadata"
+)
+
+const (
+	uptimeMetricsLen   = 1
+	bootTimeMetricsLen = 1
+)
+
+// scraper for System Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking
+	bootTime func(context.Context) (uint64, error)
+	uptime   func(context.Context) (uint64, error)
+}
+
+// newSystemScraper creates a System Scraper
+func newSystemScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext, uptime: host.UptimeWithContext}
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errors scrapererror.ScrapeErrors
+
+	uptime, err := s.uptime(ctx)
+	if err != nil {
+		errors.AddPartial(uptimeMetricsLen, fmt.Errorf("failed to read uptime: %w", err))
+	} else {
+		s.mb.RecordSystemUptimeDataPoint(now, float64(uptime))
+	}
+
+	// the boot time is read again on every scrape, as it moves when the clock of the system is adjusted
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		errors.AddPartial(bootTimeMetricsLen, fmt.Errorf("failed to read boot time: %w", err))
+	} else {
+		s.mb.RecordSystemBootTimeDataPoint(now, int64(bootTime))
+	}
+
+	return s.mb.Emit(), errors.Combine()
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package systemscraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemscraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	type testCase struct {
+		name           string
+		uptimeFunc     func(context.Context) (uint64, error)
+		bootTimeErr    error
+		expectedMetric int
+		expectedErr    string
+	}
+
+	testCases := []testCase{
+		{
+			name:           "Standard",
+			expectedMetric: 2,
+		},
+		{
+			name:           "Uptime error",
+			uptimeFunc:     func(context.Context) (uint64, error) { return 0, errors.New("err1") },
+			expectedMetric: 1,
+			expectedErr:    "failed to read uptime: err1",
+		},
+		{
+			name:           "Boot time error",
+			bootTimeErr:    errors.New("err2"),
+			expectedMetric: 1,
+			expectedErr:    "failed to read boot time: err2",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			s := newSystemScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()})
+			s.bootTime = func(context.Context) (uint64, error) { return 1700000000, nil }
+			s.uptime = func(context.Context) (uint64, error) { return 3600, nil }
+			if test.uptimeFunc != nil {
+				s.uptime = test.uptimeFunc
+			}
+			require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+			if test.bootTimeErr != nil {
+				s.bootTime = func(context.Context) (uint64, error) { return 0, test.bootTimeErr }
+			}
+
+			md, err := s.scrape(context.Background())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				var partialErr scrapererror.PartialScrapeError
+				require.ErrorAs(t, err, &partialErr)
+				assert.Equal(t, 1, partialErr.Failed)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.expectedMetric, md.MetricCount())
+
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				dp := metrics.At(i).Gauge().DataPoints().At(0)
+				assert.Equal(t, pcommon.Timestamp(1700000000*1e9), dp.StartTimestamp())
+				switch metrics.At(i).Name() {
+				case "system.uptime":
+					assert.Equal(t, 3600.0, dp.DoubleValue())
+				case "system.boot.time":
+					assert.Equal(t, int64(1700000000), dp.IntValue())
+				}
+			}
+		})
+	}
+}
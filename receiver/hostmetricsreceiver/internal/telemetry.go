@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
+)
+
+// ScraperKey is the attribute of the internal metrics of the receiver identifying the scraper they are reported for.
+const ScraperKey = "scraper"
+
+// FilterTelemetry records the number of devices, processes and other entities skipped by the filters of a scraper,
+// as the hostmetrics_scraper_filtered internal metric of the collector.
+type FilterTelemetry struct {
+	filtered metric.Int64Counter
+	attrs    metric.MeasurementOption
+}
+
+// NewFilterTelemetry creates a FilterTelemetry for the scraper of the given type, such as `disk`.
+func NewFilterTelemetry(settings component.TelemetrySettings, scraperType string) (*FilterTelemetry, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(settings)
+	if err != nil {
+		return nil, err
+	}
+	return &FilterTelemetry{
+		filtered: telemetryBuilder.HostmetricsScraperFiltered,
+		attrs:    metric.WithAttributes(attribute.String(ScraperKey, scraperType)),
+	}, nil
+}
+
+// RecordFiltered records that count entities were skipped by the filters of the scraper.
+func (t *FilterTelemetry) RecordFiltered(ctx context.Context, count int) {
+	if count > 0 {
+		t.filtered.Add(ctx, int64(count), t.attrs)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+)
+
+const parallelScraperName = "parallel"
+
+var errScrapeInProgress = errors.New("previous scrape still in progress")
+
+// timeoutScraper bounds the duration of the scrapes of a scraper. A scrape exceeding its deadline is abandoned rather
+// than waited for, and the scraper is skipped until it completes.
+type timeoutScraper struct {
+	scraperhelper.Scraper
+	timeout time.Duration
+	running atomic.Bool
+}
+
+type scrapeResult struct {
+	md  pmetric.Metrics
+	err error
+}
+
+func newTimeoutScraper(scraper scraperhelper.Scraper, timeout time.Duration) *timeoutScraper {
+	return &timeoutScraper{Scraper: scraper, timeout: timeout}
+}
+
+func (s *timeoutScraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if !s.running.CompareAndSwap(false, true) {
+		return pmetric.NewMetrics(), errScrapeInProgress
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	results := make(chan scrapeResult, 1)
+	go func() {
+		defer s.running.Store(false)
+		md, err := s.Scraper.Scrape(ctx)
+		results <- scrapeResult{md: md, err: err}
+	}()
+
+	select {
+	case result := <-results:
+		return result.md, result.err
+	case <-ctx.Done():
+		// the scrape may have completed as the deadline expired, in which case its result is kept
+		select {
+		case result := <-results:
+			return result.md, result.err
+		default:
+			return pmetric.NewMetrics(), fmt.Errorf("scrape did not complete within %s: %w", s.timeout, ctx.Err())
+		}
+	}
+}
+
+// newParallelScraper creates a scraper running the given scrapers concurrently, at most maxConcurrency at a time. The
+// errors of the scrapers are reported as partial errors, so that a failing or slow scraper does not discard the
+// metrics of the others.
+func newParallelScraper(scrapers []scraperhelper.Scraper, maxConcurrency int) (scraperhelper.Scraper, error) {
+	start := func(ctx context.Context, host component.Host) error {
+		for _, scraper := range scrapers {
+			if err := scraper.Start(ctx, host); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	shutdown := func(ctx context.Context) error {
+		var err error
+		for _, scraper := range scrapers {
+			err = multierr.Append(err, scraper.Shutdown(ctx))
+		}
+		return err
+	}
+	scrape := func(ctx context.Context) (pmetric.Metrics, error) {
+		results := make([]scrapeResult, len(scrapers))
+		sem := make(chan struct{}, maxConcurrency)
+		var wg sync.WaitGroup
+		for i, scraper := range scrapers {
+			wg.Add(1)
+			go func(i int, scraper scraperhelper.Scraper) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				md, err := scraper.Scrape(ctx)
+				results[i] = scrapeResult{md: md, err: err}
+			}(i, scraper)
+		}
+		wg.Wait()
+
+		md := pmetric.NewMetrics()
+		var errs scrapererror.ScrapeErrors
+		for i, result := range results {
+			if result.err != nil {
+				err := fmt.Errorf("scraper %s: %w", scrapers[i].ID(), result.err)
+				var partialErr scrapererror.PartialScrapeError
+				if !errors.As(result.err, &partialErr) {
+					// the metrics of a failed scrape are discarded, as the scraper controller would
+					errs.AddPartial(0, err)
+					continue
+				}
+				errs.AddPartial(partialErr.Failed, err)
+			}
+			result.md.ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+		}
+		return md, errs.Combine()
+	}
+
+	return scraperhelper.NewScraper(parallelScraperName, scrape, scraperhelper.WithStart(start), scraperhelper.WithShutdown(shutdown))
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+)
+
+func TestParallelScraper(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	newScraper := func(name string, err error) scraperhelper.Scraper {
+		scraper, scraperErr := scraperhelper.NewScraper(name, func(context.Context) (pmetric.Metrics, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for m := maxInFlight.Load(); n > m && !maxInFlight.CompareAndSwap(m, n); m = maxInFlight.Load() {
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			md := pmetric.NewMetrics()
+			md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+			return md, err
+		})
+		require.NoError(t, scraperErr)
+		return scraper
+	}
+
+	scraper, err := newParallelScraper([]scraperhelper.Scraper{
+		newScraper("cpu", nil),
+		newScraper("memory", nil),
+		newScraper("disk", scrapererror.NewPartialScrapeError(errors.New("err1"), 2)),
+		newScraper("network", errors.New("err2")),
+	}, 2)
+	require.NoError(t, err)
+	require.NoError(t, scraper.Start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.Scrape(context.Background())
+	assert.EqualError(t, err, "scraper disk: err1; scraper network: err2")
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, 2, partialErr.Failed)
+	// the metrics of the failed scraper are discarded
+	assert.Equal(t, 3, md.DataPointCount())
+	assert.Equal(t, int32(2), maxInFlight.Load())
+
+	require.NoError(t, scraper.Shutdown(context.Background()))
+}
+
+func TestTimeoutScraper(t *testing.T) {
+	release := make(chan struct{})
+	slowScraper, err := scraperhelper.NewScraper("process", func(ctx context.Context) (pmetric.Metrics, error) {
+		select {
+		case <-release:
+		case <-ctx.Done():
+			// a scraper ignoring the cancellation of its context
+			<-release
+		}
+		return pmetric.NewMetrics(), nil
+	})
+	require.NoError(t, err)
+	scraper := newTimeoutScraper(slowScraper, 10*time.Millisecond)
+
+	_, err = scraper.Scrape(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "scrape did not complete within 10ms: context deadline exceeded")
+
+	// the scraper is skipped while its previous scrape is still in progress
+	_, err = scraper.Scrape(context.Background())
+	assert.ErrorIs(t, err, errScrapeInProgress)
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		_, err = scraper.Scrape(context.Background())
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGatherMetrics_Parallel(t *testing.T) {
+	mFactory := &mockFactory{}
+	mFactory.On("CreateMetricsScraper").Return(newTestScraper(t, mockTypeStr, nil), nil)
+	tmp := scraperFactories
+	scraperFactories = map[string]internal.ScraperFactory{mockTypeStr: mFactory, "mock2": mFactory}
+	defer func() {
+		scraperFactories = tmp
+	}()
+
+	sink := new(consumertest.MetricsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.InitialDelay = 0
+	cfg.Scrapers = map[string]internal.Config{mockTypeStr: &mockConfig{}, "mock2": &mockConfig{}}
+	cfg.MaxConcurrency = 2
+	cfg.ScraperTimeout = time.Second
+	r, err := NewFactory().CreateMetricsReceiver(context.Background(), creationSet, cfg, sink)
+	require.NoError(t, err)
+	mFactory.AssertNumberOfCalls(t, "CreateMetricsScraper", 2)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		return sink.DataPointCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// azureAssetTag is the chassis asset tag of the Azure virtual machines.
+const azureAssetTag = "7783-7084-3265-9085-8269-3286-77"
+
+var hostArchs = map[string]string{
+	"386":     conventions.AttributeHostArchX86,
+	"amd64":   conventions.AttributeHostArchAMD64,
+	"arm":     conventions.AttributeHostArchARM32,
+	"arm64":   conventions.AttributeHostArchARM64,
+	"ppc64":   conventions.AttributeHostArchPPC64,
+	"ppc64le": conventions.AttributeHostArchPPC64,
+	"s390x":   conventions.AttributeHostArchS390x,
+}
+
+// resourceDetector enriches the metrics of the receiver with the resource attributes identifying the host. The
+// attributes are detected once, when the receiver starts, and do not override those set by the scrapers.
+type resourceDetector struct {
+	next       consumer.Metrics
+	logger     *zap.Logger
+	envMap     common.EnvMap
+	attributes pcommon.Map
+
+	// for mocking
+	hostInfo func(context.Context) (*host.InfoStat, error)
+}
+
+var _ consumer.Metrics = (*resourceDetector)(nil)
+
+func newResourceDetector(next consumer.Metrics, logger *zap.Logger, envMap common.EnvMap) *resourceDetector {
+	return &resourceDetector{next: next, logger: logger, envMap: envMap, attributes: pcommon.NewMap(), hostInfo: host.InfoWithContext}
+}
+
+func (d *resourceDetector) Start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, d.envMap)
+	var errs error
+
+	info, err := d.hostInfo(ctx)
+	if err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to read host info: %w", err))
+	} else {
+		hostID := info.HostID
+		if machineID, machineIDErr := d.readMachineID(); machineIDErr == nil {
+			hostID = machineID
+		}
+		putNonEmptyStr(d.attributes, conventions.AttributeHostID, hostID)
+		putNonEmptyStr(d.attributes, conventions.AttributeOSDescription, strings.TrimSpace(info.Platform+" "+info.PlatformVersion))
+	}
+	d.attributes.PutStr(conventions.AttributeOSType, runtime.GOOS)
+	if arch, ok := hostArchs[runtime.GOARCH]; ok {
+		d.attributes.PutStr(conventions.AttributeHostArch, arch)
+	}
+
+	provider, err := d.cloudProvider()
+	if err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to detect cloud provider: %w", err))
+	}
+	putNonEmptyStr(d.attributes, conventions.AttributeCloudProvider, provider)
+
+	// the receiver still runs with the attributes which could be detected
+	if errs != nil {
+		d.logger.Warn("Failed to detect some of the resource attributes of the host", zap.Error(errs))
+	}
+	return nil
+}
+
+func (d *resourceDetector) Shutdown(context.Context) error {
+	return nil
+}
+
+func (d *resourceDetector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (d *resourceDetector) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		attrs := rms.At(i).Resource().Attributes()
+		d.attributes.Range(func(k string, v pcommon.Value) bool {
+			if _, ok := attrs.Get(k); !ok {
+				v.CopyTo(attrs.PutEmpty(k))
+			}
+			return true
+		})
+	}
+	return d.next.ConsumeMetrics(ctx, md)
+}
+
+// readMachineID reads the machine ID of Linux hosts, which, unlike the product UUID gopsutil defaults to, is readable
+// by unprivileged users.
+func (d *resourceDetector) readMachineID() (string, error) {
+	if runtime.GOOS != "linux" {
+		return "", errors.ErrUnsupported
+	}
+	id, err := os.ReadFile(filepath.Join(d.hostPath(common.HostEtcEnvKey, "/etc"), "machine-id"))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(id)), nil
+}
+
+// cloudProvider detects the cloud provider of Linux hosts from their DMI attributes, without querying the metadata
+// services of the cloud providers.
+func (d *resourceDetector) cloudProvider() (string, error) {
+	if runtime.GOOS != "linux" {
+		return "", nil
+	}
+
+	dmi := filepath.Join(d.hostPath(common.HostSysEnvKey, "/sys"), "class", "dmi", "id")
+	readDMI := func(name string) (string, error) {
+		value, err := os.ReadFile(filepath.Join(dmi, name))
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, os.ErrPermission) {
+			return "", nil
+		}
+		return strings.TrimSpace(string(value)), err
+	}
+
+	vendor, err := readDMI("sys_vendor")
+	if err != nil {
+		return "", err
+	}
+	switch vendor {
+	case "Amazon EC2":
+		return conventions.AttributeCloudProviderAWS, nil
+	case "Google":
+		return conventions.AttributeCloudProviderGCP, nil
+	case "Alibaba Cloud":
+		return conventions.AttributeCloudProviderAlibabaCloud, nil
+	case "Tencent Cloud":
+		return conventions.AttributeCloudProviderTencentCloud, nil
+	case "Microsoft Corporation":
+		assetTag, err := readDMI("chassis_asset_tag")
+		if err != nil || assetTag != azureAssetTag {
+			return "", err
+		}
+		return conventions.AttributeCloudProviderAzure, nil
+	}
+
+	// the Xen instances of EC2 do not report Amazon as their vendor
+	biosVersion, err := readDMI("bios_version")
+	if err != nil || !strings.Contains(biosVersion, "amazon") {
+		return "", err
+	}
+	return conventions.AttributeCloudProviderAWS, nil
+}
+
+func (d *resourceDetector) hostPath(key common.EnvKeyType, defaultPath string) string {
+	if path := d.envMap[key]; path != "" {
+		return path
+	}
+	if path := os.Getenv(string(key)); path != "" {
+		return path
+	}
+	return defaultPath
+}
+
+func putNonEmptyStr(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+)
+
+func writeHostFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestResourceDetector(t *testing.T) {
+	root := t.TempDir()
+	envMap := common.EnvMap{common.HostEtcEnvKey: filepath.Join(root, "etc"), common.HostSysEnvKey: filepath.Join(root, "sys")}
+	writeHostFile(t, filepath.Join(root, "etc", "machine-id"), "4b6a3e2c9d1f4e8a9b7c6d5e4f3a2b1c\n")
+	writeHostFile(t, filepath.Join(root, "sys", "class", "dmi", "id", "sys_vendor"), "Amazon EC2\n")
+
+	sink := new(consumertest.MetricsSink)
+	detector := newResourceDetector(sink, zap.NewNop(), envMap)
+	detector.hostInfo = func(context.Context) (*host.InfoStat, error) {
+		return &host.InfoStat{HostID: "ec2a1b2c-0000-0000-0000-000000000000", Platform: "ubuntu", PlatformVersion: "22.04"}, nil
+	}
+	require.NoError(t, detector.Start(context.Background(), componenttest.NewNopHost()))
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty()
+	md.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("os.type", "container")
+	require.NoError(t, detector.ConsumeMetrics(context.Background(), md))
+	require.NoError(t, detector.Shutdown(context.Background()))
+
+	expected := map[string]any{
+		"host.id":        "ec2a1b2c-0000-0000-0000-000000000000",
+		"host.arch":      hostArchs[runtime.GOARCH],
+		"os.type":        runtime.GOOS,
+		"os.description": "ubuntu 22.04",
+	}
+	if runtime.GOOS == "linux" {
+		expected["host.id"] = "4b6a3e2c9d1f4e8a9b7c6d5e4f3a2b1c"
+		expected["cloud.provider"] = "aws"
+	}
+	rms := sink.AllMetrics()[0].ResourceMetrics()
+	assert.Equal(t, expected, rms.At(0).Resource().Attributes().AsRaw())
+	// the attributes set by the scrapers are not overridden
+	expected["os.type"] = "container"
+	assert.Equal(t, expected, rms.At(1).Resource().Attributes().AsRaw())
+}
+
+func TestResourceDetector_HostInfoError(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	detector := newResourceDetector(sink, zap.NewNop(), common.EnvMap{common.HostSysEnvKey: t.TempDir()})
+	detector.hostInfo = func(context.Context) (*host.InfoStat, error) { return nil, errors.New("err1") }
+	require.NoError(t, detector.Start(context.Background(), componenttest.NewNopHost()))
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty()
+	require.NoError(t, detector.ConsumeMetrics(context.Background(), md))
+	assert.Equal(t, map[string]any{
+		"host.arch": hostArchs[runtime.GOARCH],
+		"os.type":   runtime.GOOS,
+	}, sink.AllMetrics()[0].ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+}
+
+func TestResourceDetector_CloudProvider(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the cloud provider is only detected on Linux")
+	}
+
+	tests := []struct {
+		name     string
+		dmi      map[string]string
+		expected string
+	}{
+		{name: "AWS", dmi: map[string]string{"sys_vendor": "Amazon EC2"}, expected: "aws"},
+		{name: "AWS Xen", dmi: map[string]string{"sys_vendor": "Xen", "bios_version": "4.11.amazon"}, expected: "aws"},
+		{name: "GCP", dmi: map[string]string{"sys_vendor": "Google"}, expected: "gcp"},
+		{name: "Azure", dmi: map[string]string{"sys_vendor": "Microsoft Corporation", "chassis_asset_tag": azureAssetTag}, expected: "azure"},
+		{name: "Hyper-V", dmi: map[string]string{"sys_vendor": "Microsoft Corporation", "chassis_asset_tag": "None"}},
+		{name: "Alibaba Cloud", dmi: map[string]string{"sys_vendor": "Alibaba Cloud"}, expected: "alibaba_cloud"},
+		{name: "Bare metal", dmi: map[string]string{"sys_vendor": "Dell Inc.", "bios_version": "2.3.4"}},
+		{name: "No DMI"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			for name, content := range test.dmi {
+				writeHostFile(t, filepath.Join(root, "class", "dmi", "id", name), content+"\n")
+			}
+
+			detector := newResourceDetector(nil, zap.NewNop(), common.EnvMap{common.HostSysEnvKey: root})
+			provider, err := detector.cloudProvider()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, provider)
+		})
+	}
+}
+
+func TestGatherMetrics_ResourceDetection(t *testing.T) {
+	mFactory := &mockFactory{}
+	mFactory.On("CreateMetricsScraper").Return(newTestScraper(t, mockTypeStr, nil), nil)
+	tmp := scraperFactories
+	scraperFactories = map[string]internal.ScraperFactory{mockTypeStr: mFactory}
+	defer func() {
+		scraperFactories = tmp
+	}()
+
+	sink := new(consumertest.MetricsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.InitialDelay = 0
+	cfg.Scrapers = map[string]internal.Config{mockTypeStr: &mockConfig{}}
+	cfg.ResourceDetection.Enabled = true
+	r, err := NewFactory().CreateMetricsReceiver(context.Background(), creationSet, cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	osType, ok := sink.AllMetrics()[0].ResourceMetrics().At(0).Resource().Attributes().Get("os.type")
+	require.True(t, ok)
+	assert.Equal(t, runtime.GOOS, osType.Str())
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
+)
+
+// telemetryScraper records the duration, the data points and the errors of the scrapes of a scraper as internal
+// metrics of the collector, so that a slow or failing scraper can be told apart from the others.
+type telemetryScraper struct {
+	scraperhelper.Scraper
+	telemetryBuilder *metadata.TelemetryBuilder
+	attrs            metric.MeasurementOption
+	now              func() time.Time
+}
+
+func newTelemetryScraper(scraper scraperhelper.Scraper, key string, telemetryBuilder *metadata.TelemetryBuilder) *telemetryScraper {
+	return &telemetryScraper{
+		Scraper:          scraper,
+		telemetryBuilder: telemetryBuilder,
+		attrs:            metric.WithAttributes(attribute.String(internal.ScraperKey, key)),
+		now:              time.Now,
+	}
+}
+
+func (s *telemetryScraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
+	start := s.now()
+	md, err := s.Scraper.Scrape(ctx)
+	s.telemetryBuilder.HostmetricsScraperDuration.Record(ctx, s.now().Sub(start).Seconds(), s.attrs)
+	s.telemetryBuilder.HostmetricsScraperDataPoints.Add(ctx, int64(md.DataPointCount()), s.attrs)
+	if err != nil {
+		s.telemetryBuilder.HostmetricsScraperErrors.Add(ctx, 1, s.attrs)
+	}
+	return md, err
+}
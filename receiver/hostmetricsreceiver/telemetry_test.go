@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
+)
+
+func collectTelemetry(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Metrics {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	metrics := map[string]metricdata.Metrics{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m
+		}
+	}
+	return metrics
+}
+
+func TestTelemetryScraper(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	set := componenttest.NewNopTelemetrySettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set)
+	require.NoError(t, err)
+
+	scraper := newTelemetryScraper(newTestScraper(t, "cpu", errors.New("err1")), "cpu", telemetryBuilder)
+	now := time.Now()
+	scraper.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	for i := 0; i < 2; i++ {
+		_, err = scraper.Scrape(context.Background())
+		assert.EqualError(t, err, "err1")
+	}
+
+	attrs := attribute.NewSet(attribute.String("scraper", "cpu"))
+	metrics := collectTelemetry(t, reader)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "hostmetrics_scraper_data_points",
+		Description: "Number of data points produced by a scraper",
+		Unit:        "1",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Attributes: attrs, Value: 2}},
+		},
+	}, metrics["hostmetrics_scraper_data_points"], metricdatatest.IgnoreTimestamp())
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "hostmetrics_scraper_errors",
+		Description: "Number of scrapes of a scraper which returned an error",
+		Unit:        "1",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Attributes: attrs, Value: 2}},
+		},
+	}, metrics["hostmetrics_scraper_errors"], metricdatatest.IgnoreTimestamp())
+
+	duration := metrics["hostmetrics_scraper_duration"].Data.(metricdata.Histogram[float64])
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, attrs, duration.DataPoints[0].Attributes)
+	assert.Equal(t, uint64(2), duration.DataPoints[0].Count)
+	assert.InDelta(t, 2.0, duration.DataPoints[0].Sum, 0.0001)
+}
+
+func TestFilterTelemetry(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	set := componenttest.NewNopTelemetrySettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	filterTelemetry, err := internal.NewFilterTelemetry(set, "disk")
+	require.NoError(t, err)
+
+	filterTelemetry.RecordFiltered(context.Background(), 3)
+	filterTelemetry.RecordFiltered(context.Background(), 0)
+	filterTelemetry.RecordFiltered(context.Background(), 2)
+
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "hostmetrics_scraper_filtered",
+		Description: "Number of devices, processes and other entities skipped by the filters of a scraper",
+		Unit:        "1",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Attributes: attribute.NewSet(attribute.String("scraper", "disk")), Value: 5}},
+		},
+	}, collectTelemetry(t, reader)["hostmetrics_scraper_filtered"], metricdatatest.IgnoreTimestamp())
+}
+
+func TestGatherMetrics_Telemetry(t *testing.T) {
+	mFactory := &mockFactory{}
+	mFactory.On("CreateMetricsScraper").Return(newTestScraper(t, mockTypeStr, nil), nil)
+	tmp := scraperFactories
+	scraperFactories = map[string]internal.ScraperFactory{mockTypeStr: mFactory}
+	defer func() {
+		scraperFactories = tmp
+	}()
+
+	reader := sdkmetric.NewManualReader()
+	set := receivertest.NewNopCreateSettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	sink := new(consumertest.MetricsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.InitialDelay = 0
+	cfg.Scrapers = map[string]internal.Config{mockTypeStr: &mockConfig{}}
+	r, err := NewFactory().CreateMetricsReceiver(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	dataPoints := collectTelemetry(t, reader)["hostmetrics_scraper_data_points"].Data.(metricdata.Sum[int64]).DataPoints
+	require.Len(t, dataPoints, 1)
+	assert.Equal(t, attribute.NewSet(attribute.String("scraper", mockTypeStr)), dataPoints[0].Attributes)
+	assert.Positive(t, dataPoints[0].Value)
+}
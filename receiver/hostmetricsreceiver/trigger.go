@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const triggerPath = "/scrape"
+
+// lockedScraper serializes the scrapes of a scraper, which are run both by its scraper controller and by the trigger
+// endpoint.
+type lockedScraper struct {
+	scraperhelper.Scraper
+	mu sync.Mutex
+}
+
+func (s *lockedScraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Scraper.Scrape(ctx)
+}
+
+// triggerServer serves the endpoint triggering an immediate scrape of the scrapers of the receiver. It is started
+// after the scraper controllers, so that the scrapers are started by the time they are triggered.
+type triggerServer struct {
+	config   *TriggerConfig
+	logger   *zap.Logger
+	consumer consumer.Metrics
+	scrapers map[string]scraperhelper.Scraper
+	server   *http.Server
+	done     chan struct{}
+}
+
+func newTriggerServer(config *TriggerConfig, logger *zap.Logger, consumer consumer.Metrics) *triggerServer {
+	return &triggerServer{config: config, logger: logger, consumer: consumer, scrapers: map[string]scraperhelper.Scraper{}}
+}
+
+// addScraper registers a scraper, returning the scraper to hand over to its scraper controller.
+func (t *triggerServer) addScraper(key string, scraper scraperhelper.Scraper) scraperhelper.Scraper {
+	locked := &lockedScraper{Scraper: scraper}
+	t.scrapers[key] = locked
+	return locked
+}
+
+func (t *triggerServer) Start(ctx context.Context, _ component.Host) error {
+	addr := confignet.AddrConfig{Endpoint: t.config.Endpoint, Transport: t.config.Transport}
+	if addr.Transport == "" {
+		addr.Transport = confignet.TransportTypeTCP
+	}
+	listener, err := addr.Listen(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to listen on trigger endpoint %q: %w", t.config.Endpoint, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(triggerPath, t.handleScrape)
+	t.server = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	t.done = make(chan struct{})
+	go func() {
+		defer close(t.done)
+		if serveErr := t.server.Serve(listener); !errors.Is(serveErr, http.ErrServerClosed) {
+			t.logger.Error("Trigger endpoint stopped serving", zap.Error(serveErr))
+		}
+	}()
+	return nil
+}
+
+func (t *triggerServer) Shutdown(ctx context.Context) error {
+	if t.server == nil {
+		return nil
+	}
+	err := t.server.Shutdown(ctx)
+	<-t.done
+	return err
+}
+
+// handleScrape scrapes the scrapers listed by the `scraper` query parameters, or all the scrapers if there is none,
+// and sends their metrics down the pipeline.
+func (t *triggerServer) handleScrape(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	keys := r.URL.Query()["scraper"]
+	if len(keys) == 0 {
+		for key := range t.scrapers {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+	}
+	for _, key := range keys {
+		if _, ok := t.scrapers[key]; !ok {
+			http.Error(w, fmt.Sprintf("unknown scraper %q", key), http.StatusBadRequest)
+			return
+		}
+	}
+
+	md := pmetric.NewMetrics()
+	var errs error
+	for _, key := range keys {
+		scraped, err := t.scrapers[key].Scrape(r.Context())
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("scraper %q: %w", key, err))
+		}
+		scraped.ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+	}
+
+	dataPoints := md.DataPointCount()
+	if dataPoints > 0 {
+		if err := t.consumer.ConsumeMetrics(r.Context(), md); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to consume metrics: %w", err))
+		}
+	}
+
+	if errs != nil {
+		http.Error(w, errs.Error(), http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprintf(w, "scraped %d data points\n", dataPoints)
+}
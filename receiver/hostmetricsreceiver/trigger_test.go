@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+)
+
+func newTestScraper(t *testing.T, name string, err error) scraperhelper.Scraper {
+	scraper, scraperErr := scraperhelper.NewScraper(name, func(context.Context) (pmetric.Metrics, error) {
+		md := pmetric.NewMetrics()
+		md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+		return md, err
+	})
+	require.NoError(t, scraperErr)
+	return scraper
+}
+
+func TestTriggerConfigValidate(t *testing.T) {
+	assert.NoError(t, (&TriggerConfig{Endpoint: "localhost:8080"}).Validate())
+	assert.NoError(t, (&TriggerConfig{Endpoint: "/run/otelcol/hostmetrics.sock", Transport: confignet.TransportTypeUnix}).Validate())
+	assert.EqualError(t, (&TriggerConfig{}).Validate(), "trigger endpoint must be specified")
+	assert.EqualError(t, (&TriggerConfig{Endpoint: "localhost:8080", Transport: confignet.TransportTypeUDP}).Validate(), `invalid trigger transport "udp", must be tcp or unix`)
+}
+
+func TestTriggerServer_HandleScrape(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	trigger := newTriggerServer(&TriggerConfig{Endpoint: "localhost:0"}, zap.NewNop(), sink)
+	trigger.addScraper("cpu", newTestScraper(t, "cpu", nil))
+	trigger.addScraper("memory", newTestScraper(t, "memory", nil))
+	trigger.addScraper("disk", newTestScraper(t, "disk", errors.New("err1")))
+
+	tests := []struct {
+		name               string
+		method             string
+		target             string
+		expectedStatus     int
+		expectedBody       string
+		expectedDataPoints int
+	}{
+		{
+			name:           "Method not allowed",
+			method:         http.MethodGet,
+			target:         "/scrape",
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedBody:   "method not allowed\n",
+		},
+		{
+			name:           "Unknown scraper",
+			method:         http.MethodPost,
+			target:         "/scrape?scraper=cpu&scraper=gpu",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "unknown scraper \"gpu\"\n",
+		},
+		{
+			name:               "Selected scrapers",
+			method:             http.MethodPost,
+			target:             "/scrape?scraper=cpu&scraper=memory",
+			expectedStatus:     http.StatusOK,
+			expectedBody:       "scraped 2 data points\n",
+			expectedDataPoints: 2,
+		},
+		{
+			name:               "All scrapers",
+			method:             http.MethodPost,
+			target:             "/scrape",
+			expectedStatus:     http.StatusInternalServerError,
+			expectedBody:       "scraper \"disk\": err1\n",
+			expectedDataPoints: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sink.Reset()
+			rec := httptest.NewRecorder()
+			trigger.handleScrape(rec, httptest.NewRequest(test.method, test.target, nil))
+
+			assert.Equal(t, test.expectedStatus, rec.Code)
+			assert.Equal(t, test.expectedBody, rec.Body.String())
+			assert.Equal(t, test.expectedDataPoints, sink.DataPointCount())
+		})
+	}
+}
+
+func TestGatherMetrics_Trigger(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not supported on all the versions of Windows")
+	}
+
+	scraper := newTestScraper(t, mockTypeStr, nil)
+	mFactory := &mockFactory{}
+	mFactory.On("CreateMetricsScraper").Return(scraper, nil)
+	tmp := scraperFactories
+	scraperFactories = map[string]internal.ScraperFactory{mockTypeStr: mFactory}
+	defer func() {
+		scraperFactories = tmp
+	}()
+
+	socket := filepath.Join(t.TempDir(), "hostmetrics.sock")
+	sink := new(consumertest.MetricsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Scrapers = map[string]internal.Config{mockTypeStr: &mockConfig{}}
+	cfg.Trigger = &TriggerConfig{Endpoint: socket, Transport: confignet.TransportTypeUnix}
+	r, err := NewFactory().CreateMetricsReceiver(context.Background(), creationSet, cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, r.Shutdown(context.Background())) }()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+		},
+	}}
+	defer client.CloseIdleConnections()
+
+	resp, err := client.Post("http://hostmetrics/scrape?scraper="+mockTypeStr, "", strings.NewReader(""))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "scraped 1 data points\n", string(body))
+	assert.GreaterOrEqual(t, sink.DataPointCount(), 1)
+}
@@ -12,6 +12,7 @@ import (
 
 	sapdriver "github.com/SAP/go-hdb/driver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
 )
 
 // Interface for a SAP HANA client. Implementation can be faked for testing.
@@ -89,15 +90,17 @@ type sapHanaClient struct {
 	receiverConfig    *Config
 	connectionFactory sapHanaConnectionFactory
 	client            dbWrapper
+	logger            *zap.Logger
 }
 
 var _ client = (*sapHanaClient)(nil)
 
 // Creates a SAP HANA database client
-func newSapHanaClient(cfg *Config, factory sapHanaConnectionFactory) client {
+func newSapHanaClient(cfg *Config, factory sapHanaConnectionFactory, logger *zap.Logger) client {
 	return &sapHanaClient{
 		receiverConfig:    cfg,
 		connectionFactory: factory,
+		logger:            logger,
 	}
 }
 
@@ -144,9 +147,19 @@ func (c *sapHanaClient) collectDataFromQuery(ctx context.Context, query *monitor
 
 	errors := scrapererror.ScrapeErrors{}
 	var data []map[string]string
+	maxRows := c.receiverConfig.MaxRows
+	scanned := 0
 
 ROW_ITERATOR:
 	for rows.Next() {
+		if maxRows > 0 && scanned >= maxRows {
+			// Stop reading instead of loading an unbounded result into memory
+			c.logger.Warn("Query result exceeds max_rows, skipping the remaining rows",
+				zap.String("query", query.query), zap.Int("max_rows", maxRows))
+			break
+		}
+		scanned++
+
 		expectedFields := len(query.orderedMetricLabels) + len(query.orderedResourceLabels) + len(query.orderedStats)
 		rowFields := make([]any, expectedFields)
 
@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saphanareceiver/internal/metadata"
 )
@@ -99,7 +101,7 @@ func TestBasicConnectAndClose(t *testing.T) {
 	dbWrapper.On("Close").Return(nil)
 
 	factory := &testConnectionFactory{dbWrapper}
-	client := newSapHanaClient(createDefaultConfig().(*Config), factory)
+	client := newSapHanaClient(createDefaultConfig().(*Config), factory, zap.NewNop())
 
 	require.NoError(t, client.Connect(context.TODO()))
 	require.NoError(t, client.Close())
@@ -111,7 +113,7 @@ func TestFailedPing(t *testing.T) {
 	dbWrapper.On("Close").Return(nil)
 
 	factory := &testConnectionFactory{dbWrapper}
-	client := newSapHanaClient(createDefaultConfig().(*Config), factory)
+	client := newSapHanaClient(createDefaultConfig().(*Config), factory, zap.NewNop())
 
 	require.Error(t, client.Connect(context.TODO()))
 	require.NoError(t, client.Close())
@@ -127,7 +129,7 @@ func TestSimpleQueryOutput(t *testing.T) {
 		{str("your_id"), str("alive"), str("2"), str("600.1")},
 	}, nil)
 
-	client := newSapHanaClient(createDefaultConfig().(*Config), &testConnectionFactory{dbWrapper})
+	client := newSapHanaClient(createDefaultConfig().(*Config), &testConnectionFactory{dbWrapper}, zap.NewNop())
 	require.NoError(t, client.Connect(context.TODO()))
 
 	query := &monitoringQuery{
@@ -183,7 +185,7 @@ func TestNullOutput(t *testing.T) {
 		{nil, str("live"), str("3"), str("123.123")},
 	}, nil)
 
-	client := newSapHanaClient(createDefaultConfig().(*Config), &testConnectionFactory{dbWrapper})
+	client := newSapHanaClient(createDefaultConfig().(*Config), &testConnectionFactory{dbWrapper}, zap.NewNop())
 	require.NoError(t, client.Connect(context.TODO()))
 
 	query := &monitoringQuery{
@@ -222,3 +224,55 @@ func TestNullOutput(t *testing.T) {
 
 	require.NoError(t, client.Close())
 }
+
+func TestMaxRows(t *testing.T) {
+	dbWrapper := &testDBWrapper{}
+	dbWrapper.On("PingContext").Return(nil)
+	dbWrapper.On("Close").Return(nil)
+
+	dbWrapper.mockQueryResult("SELECT 1=1", [][]*string{
+		{str("first_id"), str("1")},
+		{str("second_id"), str("2")},
+		{str("third_id"), str("3")},
+	}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxRows = 2
+	core, observedLogs := observer.New(zap.WarnLevel)
+	client := newSapHanaClient(cfg, &testConnectionFactory{dbWrapper}, zap.New(core))
+	require.NoError(t, client.Connect(context.TODO()))
+
+	query := &monitoringQuery{
+		query:               "SELECT 1=1",
+		orderedMetricLabels: []string{"id"},
+		orderedStats: []queryStat{
+			{
+				key: "value",
+				addMetricFunction: func(*metadata.MetricsBuilder, pcommon.Timestamp, string,
+					map[string]string) error {
+					// Function is a no-op as it's not required for this test
+					return nil
+				},
+			},
+		},
+	}
+
+	results, err := client.collectDataFromQuery(context.TODO(), query)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]string{
+		{
+			"id":    "first_id",
+			"value": "1",
+		},
+		{
+			"id":    "second_id",
+			"value": "2",
+		},
+	}, results)
+
+	logs := observedLogs.FilterMessage("Query result exceeds max_rows, skipping the remaining rows").All()
+	require.Len(t, logs, 1)
+	require.Equal(t, int64(2), logs[0].ContextMap()["max_rows"])
+
+	require.NoError(t, client.Close())
+}
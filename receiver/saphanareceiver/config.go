@@ -5,6 +5,8 @@ package saphanareceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -30,8 +32,27 @@ type Config struct {
 
 	Username string              `mapstructure:"username"`
 	Password configopaque.String `mapstructure:"password"`
+
+	// TraceFiles configures the saphana.trace_file.* metrics.
+	TraceFiles TraceFilesConfig `mapstructure:"trace_files"`
+
+	// MaxRows is the maximum number of rows read from the result of each query.
+	// The remaining rows are skipped with a warning. The limit is disabled when 0.
+	MaxRows int `mapstructure:"max_rows"`
+}
+
+// TraceFilesConfig selects the trace files reported from SYS.M_TRACEFILES.
+type TraceFilesConfig struct {
+	// Types restricts the reported trace files to the given file extensions,
+	// for example "trc" or "gz". All trace files are reported when empty.
+	Types []string `mapstructure:"types"`
+	// Limit is the maximum number of trace files, largest first, for which
+	// saphana.trace_file.size is reported.
+	Limit int `mapstructure:"limit"`
 }
 
+var traceFileTypeRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
 func (cfg *Config) Validate() error {
 	var err error
 	if cfg.Username == "" {
@@ -40,6 +61,17 @@ func (cfg *Config) Validate() error {
 	if cfg.Password == "" {
 		err = multierr.Append(err, errors.New(ErrNoPassword))
 	}
+	if cfg.TraceFiles.Limit <= 0 {
+		err = multierr.Append(err, errors.New("invalid config: trace_files.limit must be greater than 0"))
+	}
+	if cfg.MaxRows < 0 {
+		err = multierr.Append(err, errors.New("invalid config: max_rows must not be negative"))
+	}
+	for _, t := range cfg.TraceFiles.Types {
+		if !traceFileTypeRegexp.MatchString(t) {
+			err = multierr.Append(err, fmt.Errorf("invalid config: trace file type %q may only contain letters, digits and underscores", t))
+		}
+	}
 
 	return err
 }
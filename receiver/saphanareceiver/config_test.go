@@ -51,6 +51,39 @@ func TestValidate(t *testing.T) {
 				errors.New(ErrNoUsername),
 			),
 		},
+		{
+			desc: "invalid trace file limit",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.TraceFiles.Limit = 0
+			},
+			expected: multierr.Combine(
+				errors.New("invalid config: trace_files.limit must be greater than 0"),
+			),
+		},
+		{
+			desc: "negative max rows",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.MaxRows = -1
+			},
+			expected: multierr.Combine(
+				errors.New("invalid config: max_rows must not be negative"),
+			),
+		},
+		{
+			desc: "invalid trace file type",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.TraceFiles.Types = []string{"trc", "gz' OR '1'='1"}
+			},
+			expected: multierr.Combine(
+				errors.New(`invalid config: trace file type "gz' OR '1'='1" may only contain letters, digits and underscores`),
+			),
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
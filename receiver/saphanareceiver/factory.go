@@ -19,7 +19,9 @@ import (
 )
 
 const (
-	defaultEndpoint = "localhost:33015"
+	defaultEndpoint       = "localhost:33015"
+	defaultTraceFileLimit = 10
+	defaultMaxRows        = 10000
 )
 
 // NewFactory creates a factory for SAP HANA receiver.
@@ -42,6 +44,10 @@ func createDefaultConfig() component.Config {
 		},
 		ControllerConfig:     scs,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		TraceFiles: TraceFilesConfig{
+			Limit: defaultTraceFileLimit,
+		},
+		MaxRows: defaultMaxRows,
 	}
 }
 
@@ -35,6 +35,7 @@ type MetricsConfig struct {
 	SaphanaConnectionCount                  MetricConfig `mapstructure:"saphana.connection.count"`
 	SaphanaCPUUsed                          MetricConfig `mapstructure:"saphana.cpu.used"`
 	SaphanaDiskSizeCurrent                  MetricConfig `mapstructure:"saphana.disk.size.current"`
+	SaphanaHostCPUTime                      MetricConfig `mapstructure:"saphana.host.cpu.time"`
 	SaphanaHostMemoryCurrent                MetricConfig `mapstructure:"saphana.host.memory.current"`
 	SaphanaHostSwapCurrent                  MetricConfig `mapstructure:"saphana.host.swap.current"`
 	SaphanaInstanceCodeSize                 MetricConfig `mapstructure:"saphana.instance.code_size"`
@@ -44,6 +45,7 @@ type MetricsConfig struct {
 	SaphanaLicenseExpirationTime            MetricConfig `mapstructure:"saphana.license.expiration.time"`
 	SaphanaLicenseLimit                     MetricConfig `mapstructure:"saphana.license.limit"`
 	SaphanaLicensePeak                      MetricConfig `mapstructure:"saphana.license.peak"`
+	SaphanaNetworkBytes                     MetricConfig `mapstructure:"saphana.network.bytes"`
 	SaphanaNetworkRequestAverageTime        MetricConfig `mapstructure:"saphana.network.request.average_time"`
 	SaphanaNetworkRequestCount              MetricConfig `mapstructure:"saphana.network.request.count"`
 	SaphanaNetworkRequestFinishedCount      MetricConfig `mapstructure:"saphana.network.request.finished.count"`
@@ -67,6 +69,8 @@ type MetricsConfig struct {
 	SaphanaServiceMemoryUsed                MetricConfig `mapstructure:"saphana.service.memory.used"`
 	SaphanaServiceStackSize                 MetricConfig `mapstructure:"saphana.service.stack_size"`
 	SaphanaServiceThreadCount               MetricConfig `mapstructure:"saphana.service.thread.count"`
+	SaphanaTraceFileCount                   MetricConfig `mapstructure:"saphana.trace_file.count"`
+	SaphanaTraceFileSize                    MetricConfig `mapstructure:"saphana.trace_file.size"`
 	SaphanaTransactionBlocked               MetricConfig `mapstructure:"saphana.transaction.blocked"`
 	SaphanaTransactionCount                 MetricConfig `mapstructure:"saphana.transaction.count"`
 	SaphanaUptime                           MetricConfig `mapstructure:"saphana.uptime"`
@@ -98,6 +102,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		SaphanaDiskSizeCurrent: MetricConfig{
 			Enabled: true,
 		},
+		SaphanaHostCPUTime: MetricConfig{
+			Enabled: false,
+		},
 		SaphanaHostMemoryCurrent: MetricConfig{
 			Enabled: true,
 		},
@@ -125,6 +132,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		SaphanaLicensePeak: MetricConfig{
 			Enabled: true,
 		},
+		SaphanaNetworkBytes: MetricConfig{
+			Enabled: false,
+		},
 		SaphanaNetworkRequestAverageTime: MetricConfig{
 			Enabled: true,
 		},
@@ -194,6 +204,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		SaphanaServiceThreadCount: MetricConfig{
 			Enabled: true,
 		},
+		SaphanaTraceFileCount: MetricConfig{
+			Enabled: false,
+		},
+		SaphanaTraceFileSize: MetricConfig{
+			Enabled: false,
+		},
 		SaphanaTransactionBlocked: MetricConfig{
 			Enabled: true,
 		},
@@ -33,6 +33,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SaphanaConnectionCount:                  MetricConfig{Enabled: true},
 					SaphanaCPUUsed:                          MetricConfig{Enabled: true},
 					SaphanaDiskSizeCurrent:                  MetricConfig{Enabled: true},
+					SaphanaHostCPUTime:                      MetricConfig{Enabled: true},
 					SaphanaHostMemoryCurrent:                MetricConfig{Enabled: true},
 					SaphanaHostSwapCurrent:                  MetricConfig{Enabled: true},
 					SaphanaInstanceCodeSize:                 MetricConfig{Enabled: true},
@@ -42,6 +43,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SaphanaLicenseExpirationTime:            MetricConfig{Enabled: true},
 					SaphanaLicenseLimit:                     MetricConfig{Enabled: true},
 					SaphanaLicensePeak:                      MetricConfig{Enabled: true},
+					SaphanaNetworkBytes:                     MetricConfig{Enabled: true},
 					SaphanaNetworkRequestAverageTime:        MetricConfig{Enabled: true},
 					SaphanaNetworkRequestCount:              MetricConfig{Enabled: true},
 					SaphanaNetworkRequestFinishedCount:      MetricConfig{Enabled: true},
@@ -65,6 +67,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SaphanaServiceMemoryUsed:                MetricConfig{Enabled: true},
 					SaphanaServiceStackSize:                 MetricConfig{Enabled: true},
 					SaphanaServiceThreadCount:               MetricConfig{Enabled: true},
+					SaphanaTraceFileCount:                   MetricConfig{Enabled: true},
+					SaphanaTraceFileSize:                    MetricConfig{Enabled: true},
 					SaphanaTransactionBlocked:               MetricConfig{Enabled: true},
 					SaphanaTransactionCount:                 MetricConfig{Enabled: true},
 					SaphanaUptime:                           MetricConfig{Enabled: true},
@@ -89,6 +93,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SaphanaConnectionCount:                  MetricConfig{Enabled: false},
 					SaphanaCPUUsed:                          MetricConfig{Enabled: false},
 					SaphanaDiskSizeCurrent:                  MetricConfig{Enabled: false},
+					SaphanaHostCPUTime:                      MetricConfig{Enabled: false},
 					SaphanaHostMemoryCurrent:                MetricConfig{Enabled: false},
 					SaphanaHostSwapCurrent:                  MetricConfig{Enabled: false},
 					SaphanaInstanceCodeSize:                 MetricConfig{Enabled: false},
@@ -98,6 +103,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SaphanaLicenseExpirationTime:            MetricConfig{Enabled: false},
 					SaphanaLicenseLimit:                     MetricConfig{Enabled: false},
 					SaphanaLicensePeak:                      MetricConfig{Enabled: false},
+					SaphanaNetworkBytes:                     MetricConfig{Enabled: false},
 					SaphanaNetworkRequestAverageTime:        MetricConfig{Enabled: false},
 					SaphanaNetworkRequestCount:              MetricConfig{Enabled: false},
 					SaphanaNetworkRequestFinishedCount:      MetricConfig{Enabled: false},
@@ -121,6 +127,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SaphanaServiceMemoryUsed:                MetricConfig{Enabled: false},
 					SaphanaServiceStackSize:                 MetricConfig{Enabled: false},
 					SaphanaServiceThreadCount:               MetricConfig{Enabled: false},
+					SaphanaTraceFileCount:                   MetricConfig{Enabled: false},
+					SaphanaTraceFileSize:                    MetricConfig{Enabled: false},
 					SaphanaTransactionBlocked:               MetricConfig{Enabled: false},
 					SaphanaTransactionCount:                 MetricConfig{Enabled: false},
 					SaphanaUptime:                           MetricConfig{Enabled: false},
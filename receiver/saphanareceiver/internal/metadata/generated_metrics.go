@@ -130,6 +130,40 @@ var MapAttributeConnectionStatus = map[string]AttributeConnectionStatus{
 	"queueing": AttributeConnectionStatusQueueing,
 }
 
+// AttributeCPUState specifies the a value cpu_state attribute.
+type AttributeCPUState int
+
+const (
+	_ AttributeCPUState = iota
+	AttributeCPUStateUser
+	AttributeCPUStateSystem
+	AttributeCPUStateIdle
+	AttributeCPUStateIowait
+)
+
+// String returns the string representation of the AttributeCPUState.
+func (av AttributeCPUState) String() string {
+	switch av {
+	case AttributeCPUStateUser:
+		return "user"
+	case AttributeCPUStateSystem:
+		return "system"
+	case AttributeCPUStateIdle:
+		return "idle"
+	case AttributeCPUStateIowait:
+		return "iowait"
+	}
+	return ""
+}
+
+// MapAttributeCPUState is a helper map of string to AttributeCPUState attribute value.
+var MapAttributeCPUState = map[string]AttributeCPUState{
+	"user":   AttributeCPUStateUser,
+	"system": AttributeCPUStateSystem,
+	"idle":   AttributeCPUStateIdle,
+	"iowait": AttributeCPUStateIowait,
+}
+
 // AttributeCPUType specifies the a value cpu_type attribute.
 type AttributeCPUType int
 
@@ -268,6 +302,32 @@ var MapAttributeMemoryStateUsedFree = map[string]AttributeMemoryStateUsedFree{
 	"free": AttributeMemoryStateUsedFreeFree,
 }
 
+// AttributeNetworkIoDirection specifies the a value network_io_direction attribute.
+type AttributeNetworkIoDirection int
+
+const (
+	_ AttributeNetworkIoDirection = iota
+	AttributeNetworkIoDirectionTransmit
+	AttributeNetworkIoDirectionReceive
+)
+
+// String returns the string representation of the AttributeNetworkIoDirection.
+func (av AttributeNetworkIoDirection) String() string {
+	switch av {
+	case AttributeNetworkIoDirectionTransmit:
+		return "transmit"
+	case AttributeNetworkIoDirectionReceive:
+		return "receive"
+	}
+	return ""
+}
+
+// MapAttributeNetworkIoDirection is a helper map of string to AttributeNetworkIoDirection attribute value.
+var MapAttributeNetworkIoDirection = map[string]AttributeNetworkIoDirection{
+	"transmit": AttributeNetworkIoDirectionTransmit,
+	"receive":  AttributeNetworkIoDirectionReceive,
+}
+
 // AttributeRowMemoryType specifies the a value row_memory_type attribute.
 type AttributeRowMemoryType int
 
@@ -896,6 +956,60 @@ func newMetricSaphanaDiskSizeCurrent(cfg MetricConfig) metricSaphanaDiskSizeCurr
 	return m
 }
 
+type metricSaphanaHostCPUTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.host.cpu.time metric with initial data.
+func (m *metricSaphanaHostCPUTime) init() {
+	m.data.SetName("saphana.host.cpu.time")
+	m.data.SetDescription("CPU time spent on the host, as reported by SAP HANA.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaHostCPUTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, hostAttributeValue string, cpuStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("host", hostAttributeValue)
+	dp.Attributes().PutStr("state", cpuStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaHostCPUTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaHostCPUTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaHostCPUTime(cfg MetricConfig) metricSaphanaHostCPUTime {
+	m := metricSaphanaHostCPUTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaHostMemoryCurrent struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1368,6 +1482,60 @@ func newMetricSaphanaLicensePeak(cfg MetricConfig) metricSaphanaLicensePeak {
 	return m
 }
 
+type metricSaphanaNetworkBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.network.bytes metric with initial data.
+func (m *metricSaphanaNetworkBytes) init() {
+	m.data.SetName("saphana.network.bytes")
+	m.data.SetDescription("The number of bytes sent and received over the network by a service.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaNetworkBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, serviceAttributeValue string, networkIoDirectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("service", serviceAttributeValue)
+	dp.Attributes().PutStr("direction", networkIoDirectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaNetworkBytes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaNetworkBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaNetworkBytes(cfg MetricConfig) metricSaphanaNetworkBytes {
+	m := metricSaphanaNetworkBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaNetworkRequestAverageTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2596,6 +2764,110 @@ func newMetricSaphanaServiceThreadCount(cfg MetricConfig) metricSaphanaServiceTh
 	return m
 }
 
+type metricSaphanaTraceFileCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.trace_file.count metric with initial data.
+func (m *metricSaphanaTraceFileCount) init() {
+	m.data.SetName("saphana.trace_file.count")
+	m.data.SetDescription("The number of trace files.")
+	m.data.SetUnit("{files}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSaphanaTraceFileCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaTraceFileCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaTraceFileCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaTraceFileCount(cfg MetricConfig) metricSaphanaTraceFileCount {
+	m := metricSaphanaTraceFileCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSaphanaTraceFileSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.trace_file.size metric with initial data.
+func (m *metricSaphanaTraceFileSize) init() {
+	m.data.SetName("saphana.trace_file.size")
+	m.data.SetDescription("The size of a trace file.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaTraceFileSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, traceFileAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("trace_file", traceFileAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaTraceFileSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaTraceFileSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaTraceFileSize(cfg MetricConfig) metricSaphanaTraceFileSize {
+	m := metricSaphanaTraceFileSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaTransactionBlocked struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2936,6 +3208,7 @@ type MetricsBuilder struct {
 	metricSaphanaConnectionCount                  metricSaphanaConnectionCount
 	metricSaphanaCPUUsed                          metricSaphanaCPUUsed
 	metricSaphanaDiskSizeCurrent                  metricSaphanaDiskSizeCurrent
+	metricSaphanaHostCPUTime                      metricSaphanaHostCPUTime
 	metricSaphanaHostMemoryCurrent                metricSaphanaHostMemoryCurrent
 	metricSaphanaHostSwapCurrent                  metricSaphanaHostSwapCurrent
 	metricSaphanaInstanceCodeSize                 metricSaphanaInstanceCodeSize
@@ -2945,6 +3218,7 @@ type MetricsBuilder struct {
 	metricSaphanaLicenseExpirationTime            metricSaphanaLicenseExpirationTime
 	metricSaphanaLicenseLimit                     metricSaphanaLicenseLimit
 	metricSaphanaLicensePeak                      metricSaphanaLicensePeak
+	metricSaphanaNetworkBytes                     metricSaphanaNetworkBytes
 	metricSaphanaNetworkRequestAverageTime        metricSaphanaNetworkRequestAverageTime
 	metricSaphanaNetworkRequestCount              metricSaphanaNetworkRequestCount
 	metricSaphanaNetworkRequestFinishedCount      metricSaphanaNetworkRequestFinishedCount
@@ -2968,6 +3242,8 @@ type MetricsBuilder struct {
 	metricSaphanaServiceMemoryUsed                metricSaphanaServiceMemoryUsed
 	metricSaphanaServiceStackSize                 metricSaphanaServiceStackSize
 	metricSaphanaServiceThreadCount               metricSaphanaServiceThreadCount
+	metricSaphanaTraceFileCount                   metricSaphanaTraceFileCount
+	metricSaphanaTraceFileSize                    metricSaphanaTraceFileSize
 	metricSaphanaTransactionBlocked               metricSaphanaTransactionBlocked
 	metricSaphanaTransactionCount                 metricSaphanaTransactionCount
 	metricSaphanaUptime                           metricSaphanaUptime
@@ -2999,6 +3275,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSaphanaConnectionCount:                  newMetricSaphanaConnectionCount(mbc.Metrics.SaphanaConnectionCount),
 		metricSaphanaCPUUsed:                          newMetricSaphanaCPUUsed(mbc.Metrics.SaphanaCPUUsed),
 		metricSaphanaDiskSizeCurrent:                  newMetricSaphanaDiskSizeCurrent(mbc.Metrics.SaphanaDiskSizeCurrent),
+		metricSaphanaHostCPUTime:                      newMetricSaphanaHostCPUTime(mbc.Metrics.SaphanaHostCPUTime),
 		metricSaphanaHostMemoryCurrent:                newMetricSaphanaHostMemoryCurrent(mbc.Metrics.SaphanaHostMemoryCurrent),
 		metricSaphanaHostSwapCurrent:                  newMetricSaphanaHostSwapCurrent(mbc.Metrics.SaphanaHostSwapCurrent),
 		metricSaphanaInstanceCodeSize:                 newMetricSaphanaInstanceCodeSize(mbc.Metrics.SaphanaInstanceCodeSize),
@@ -3008,6 +3285,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSaphanaLicenseExpirationTime:            newMetricSaphanaLicenseExpirationTime(mbc.Metrics.SaphanaLicenseExpirationTime),
 		metricSaphanaLicenseLimit:                     newMetricSaphanaLicenseLimit(mbc.Metrics.SaphanaLicenseLimit),
 		metricSaphanaLicensePeak:                      newMetricSaphanaLicensePeak(mbc.Metrics.SaphanaLicensePeak),
+		metricSaphanaNetworkBytes:                     newMetricSaphanaNetworkBytes(mbc.Metrics.SaphanaNetworkBytes),
 		metricSaphanaNetworkRequestAverageTime:        newMetricSaphanaNetworkRequestAverageTime(mbc.Metrics.SaphanaNetworkRequestAverageTime),
 		metricSaphanaNetworkRequestCount:              newMetricSaphanaNetworkRequestCount(mbc.Metrics.SaphanaNetworkRequestCount),
 		metricSaphanaNetworkRequestFinishedCount:      newMetricSaphanaNetworkRequestFinishedCount(mbc.Metrics.SaphanaNetworkRequestFinishedCount),
@@ -3031,6 +3309,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSaphanaServiceMemoryUsed:                newMetricSaphanaServiceMemoryUsed(mbc.Metrics.SaphanaServiceMemoryUsed),
 		metricSaphanaServiceStackSize:                 newMetricSaphanaServiceStackSize(mbc.Metrics.SaphanaServiceStackSize),
 		metricSaphanaServiceThreadCount:               newMetricSaphanaServiceThreadCount(mbc.Metrics.SaphanaServiceThreadCount),
+		metricSaphanaTraceFileCount:                   newMetricSaphanaTraceFileCount(mbc.Metrics.SaphanaTraceFileCount),
+		metricSaphanaTraceFileSize:                    newMetricSaphanaTraceFileSize(mbc.Metrics.SaphanaTraceFileSize),
 		metricSaphanaTransactionBlocked:               newMetricSaphanaTransactionBlocked(mbc.Metrics.SaphanaTransactionBlocked),
 		metricSaphanaTransactionCount:                 newMetricSaphanaTransactionCount(mbc.Metrics.SaphanaTransactionCount),
 		metricSaphanaUptime:                           newMetricSaphanaUptime(mbc.Metrics.SaphanaUptime),
@@ -3120,6 +3400,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSaphanaConnectionCount.emit(ils.Metrics())
 	mb.metricSaphanaCPUUsed.emit(ils.Metrics())
 	mb.metricSaphanaDiskSizeCurrent.emit(ils.Metrics())
+	mb.metricSaphanaHostCPUTime.emit(ils.Metrics())
 	mb.metricSaphanaHostMemoryCurrent.emit(ils.Metrics())
 	mb.metricSaphanaHostSwapCurrent.emit(ils.Metrics())
 	mb.metricSaphanaInstanceCodeSize.emit(ils.Metrics())
@@ -3129,6 +3410,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSaphanaLicenseExpirationTime.emit(ils.Metrics())
 	mb.metricSaphanaLicenseLimit.emit(ils.Metrics())
 	mb.metricSaphanaLicensePeak.emit(ils.Metrics())
+	mb.metricSaphanaNetworkBytes.emit(ils.Metrics())
 	mb.metricSaphanaNetworkRequestAverageTime.emit(ils.Metrics())
 	mb.metricSaphanaNetworkRequestCount.emit(ils.Metrics())
 	mb.metricSaphanaNetworkRequestFinishedCount.emit(ils.Metrics())
@@ -3152,6 +3434,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSaphanaServiceMemoryUsed.emit(ils.Metrics())
 	mb.metricSaphanaServiceStackSize.emit(ils.Metrics())
 	mb.metricSaphanaServiceThreadCount.emit(ils.Metrics())
+	mb.metricSaphanaTraceFileCount.emit(ils.Metrics())
+	mb.metricSaphanaTraceFileSize.emit(ils.Metrics())
 	mb.metricSaphanaTransactionBlocked.emit(ils.Metrics())
 	mb.metricSaphanaTransactionCount.emit(ils.Metrics())
 	mb.metricSaphanaUptime.emit(ils.Metrics())
@@ -3259,6 +3543,16 @@ func (mb *MetricsBuilder) RecordSaphanaDiskSizeCurrentDataPoint(ts pcommon.Times
 	return nil
 }
 
+// RecordSaphanaHostCPUTimeDataPoint adds a data point to saphana.host.cpu.time metric.
+func (mb *MetricsBuilder) RecordSaphanaHostCPUTimeDataPoint(ts pcommon.Timestamp, inputVal string, hostAttributeValue string, cpuStateAttributeValue AttributeCPUState) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for SaphanaHostCPUTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricSaphanaHostCPUTime.recordDataPoint(mb.startTime, ts, val, hostAttributeValue, cpuStateAttributeValue.String())
+	return nil
+}
+
 // RecordSaphanaHostMemoryCurrentDataPoint adds a data point to saphana.host.memory.current metric.
 func (mb *MetricsBuilder) RecordSaphanaHostMemoryCurrentDataPoint(ts pcommon.Timestamp, inputVal string, memoryStateUsedFreeAttributeValue AttributeMemoryStateUsedFree) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -3349,6 +3643,16 @@ func (mb *MetricsBuilder) RecordSaphanaLicensePeakDataPoint(ts pcommon.Timestamp
 	return nil
 }
 
+// RecordSaphanaNetworkBytesDataPoint adds a data point to saphana.network.bytes metric.
+func (mb *MetricsBuilder) RecordSaphanaNetworkBytesDataPoint(ts pcommon.Timestamp, inputVal string, serviceAttributeValue string, networkIoDirectionAttributeValue AttributeNetworkIoDirection) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for SaphanaNetworkBytes, value was %s: %w", inputVal, err)
+	}
+	mb.metricSaphanaNetworkBytes.recordDataPoint(mb.startTime, ts, val, serviceAttributeValue, networkIoDirectionAttributeValue.String())
+	return nil
+}
+
 // RecordSaphanaNetworkRequestAverageTimeDataPoint adds a data point to saphana.network.request.average_time metric.
 func (mb *MetricsBuilder) RecordSaphanaNetworkRequestAverageTimeDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseFloat(inputVal, 64)
@@ -3579,6 +3883,26 @@ func (mb *MetricsBuilder) RecordSaphanaServiceThreadCountDataPoint(ts pcommon.Ti
 	return nil
 }
 
+// RecordSaphanaTraceFileCountDataPoint adds a data point to saphana.trace_file.count metric.
+func (mb *MetricsBuilder) RecordSaphanaTraceFileCountDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for SaphanaTraceFileCount, value was %s: %w", inputVal, err)
+	}
+	mb.metricSaphanaTraceFileCount.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
+// RecordSaphanaTraceFileSizeDataPoint adds a data point to saphana.trace_file.size metric.
+func (mb *MetricsBuilder) RecordSaphanaTraceFileSizeDataPoint(ts pcommon.Timestamp, inputVal string, traceFileAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for SaphanaTraceFileSize, value was %s: %w", inputVal, err)
+	}
+	mb.metricSaphanaTraceFileSize.recordDataPoint(mb.startTime, ts, val, traceFileAttributeValue)
+	return nil
+}
+
 // RecordSaphanaTransactionBlockedDataPoint adds a data point to saphana.transaction.blocked metric.
 func (mb *MetricsBuilder) RecordSaphanaTransactionBlockedDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -96,6 +96,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSaphanaDiskSizeCurrentDataPoint(ts, "1", "path-val", "disk_usage_type-val", AttributeDiskStateUsedFreeUsed)
 
+			allMetricsCount++
+			mb.RecordSaphanaHostCPUTimeDataPoint(ts, "1", "host-val", AttributeCPUStateUser)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSaphanaHostMemoryCurrentDataPoint(ts, "1", AttributeMemoryStateUsedFreeUsed)
@@ -132,6 +135,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSaphanaLicensePeakDataPoint(ts, "1", "system-val", "product-val")
 
+			allMetricsCount++
+			mb.RecordSaphanaNetworkBytesDataPoint(ts, "1", "service-val", AttributeNetworkIoDirectionTransmit)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSaphanaNetworkRequestAverageTimeDataPoint(ts, "1")
@@ -224,6 +230,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSaphanaServiceThreadCountDataPoint(ts, "1", AttributeThreadStatusActive)
 
+			allMetricsCount++
+			mb.RecordSaphanaTraceFileCountDataPoint(ts, "1")
+
+			allMetricsCount++
+			mb.RecordSaphanaTraceFileSizeDataPoint(ts, "1", "trace_file-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSaphanaTransactionBlockedDataPoint(ts, "1")
@@ -396,6 +408,26 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("state")
 					assert.True(t, ok)
 					assert.EqualValues(t, "used", attrVal.Str())
+				case "saphana.host.cpu.time":
+					assert.False(t, validatedMetrics["saphana.host.cpu.time"], "Found a duplicate in the metrics slice: saphana.host.cpu.time")
+					validatedMetrics["saphana.host.cpu.time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "CPU time spent on the host, as reported by SAP HANA.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "host-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "user", attrVal.Str())
 				case "saphana.host.memory.current":
 					assert.False(t, validatedMetrics["saphana.host.memory.current"], "Found a duplicate in the metrics slice: saphana.host.memory.current")
 					validatedMetrics["saphana.host.memory.current"] = true
@@ -547,6 +579,26 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("product")
 					assert.True(t, ok)
 					assert.EqualValues(t, "product-val", attrVal.Str())
+				case "saphana.network.bytes":
+					assert.False(t, validatedMetrics["saphana.network.bytes"], "Found a duplicate in the metrics slice: saphana.network.bytes")
+					validatedMetrics["saphana.network.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of bytes sent and received over the network by a service.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("service")
+					assert.True(t, ok)
+					assert.EqualValues(t, "service-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "transmit", attrVal.Str())
 				case "saphana.network.request.average_time":
 					assert.False(t, validatedMetrics["saphana.network.request.average_time"], "Found a duplicate in the metrics slice: saphana.network.request.average_time")
 					validatedMetrics["saphana.network.request.average_time"] = true
@@ -976,6 +1028,37 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("status")
 					assert.True(t, ok)
 					assert.EqualValues(t, "active", attrVal.Str())
+				case "saphana.trace_file.count":
+					assert.False(t, validatedMetrics["saphana.trace_file.count"], "Found a duplicate in the metrics slice: saphana.trace_file.count")
+					validatedMetrics["saphana.trace_file.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of trace files.", ms.At(i).Description())
+					assert.Equal(t, "{files}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "saphana.trace_file.size":
+					assert.False(t, validatedMetrics["saphana.trace_file.size"], "Found a duplicate in the metrics slice: saphana.trace_file.size")
+					validatedMetrics["saphana.trace_file.size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The size of a trace file.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("trace_file")
+					assert.True(t, ok)
+					assert.EqualValues(t, "trace_file-val", attrVal.Str())
 				case "saphana.transaction.blocked":
 					assert.False(t, validatedMetrics["saphana.transaction.blocked"], "Found a duplicate in the metrics slice: saphana.transaction.blocked")
 					validatedMetrics["saphana.transaction.blocked"] = true
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"strings"
 
+	sapdriver "github.com/SAP/go-hdb/driver"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saphanareceiver/internal/metadata"
 )
@@ -52,7 +54,13 @@ type monitoringQuery struct {
 	orderedResourceLabels []string
 	orderedMetricLabels   []string
 	orderedStats          []queryStat
-	Enabled               func(c *Config) bool
+	// optional queries read monitoring views that do not exist on every SAP HANA
+	// version. A missing view is skipped instead of being reported as a scrape error.
+	optional bool
+	// buildQuery, when set, builds the query from the receiver configuration
+	// instead of using the static query.
+	buildQuery func(c *Config) string
+	Enabled    func(c *Config) bool
 }
 
 var queries = []monitoringQuery{
@@ -454,6 +462,70 @@ var queries = []monitoringQuery{
 				c.MetricsBuilderConfig.Metrics.SaphanaNetworkRequestAverageTime.Enabled
 		},
 	},
+	{
+		query:                 "SELECT n.HOST, s.SERVICE_NAME, SUM(n.SEND_SIZE) sent, SUM(n.RECEIVE_SIZE) received FROM SYS.M_SERVICE_NETWORK_IO n JOIN SYS.M_SERVICES s ON n.HOST = s.HOST AND n.PORT = s.PORT GROUP BY n.HOST, s.SERVICE_NAME",
+		orderedResourceLabels: []string{"host"},
+		orderedMetricLabels:   []string{"service"},
+		orderedStats: []queryStat{
+			{
+				key: "sent",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaNetworkBytesDataPoint(now, val, row["service"], metadata.AttributeNetworkIoDirectionTransmit)
+				},
+			},
+			{
+				key: "received",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaNetworkBytesDataPoint(now, val, row["service"], metadata.AttributeNetworkIoDirectionReceive)
+				},
+			},
+		},
+		// M_SERVICE_NETWORK_IO is not available on older SAP HANA versions.
+		optional: true,
+		Enabled: func(c *Config) bool {
+			return c.MetricsBuilderConfig.Metrics.SaphanaNetworkBytes.Enabled
+		},
+	},
+	{
+		orderedResourceLabels: []string{"host"},
+		orderedStats: []queryStat{
+			{
+				key: "trace_files",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					_ map[string]string) error {
+					return mb.RecordSaphanaTraceFileCountDataPoint(now, val)
+				},
+			},
+		},
+		buildQuery: func(c *Config) string {
+			return "SELECT HOST, COUNT(*) trace_files FROM SYS.M_TRACEFILES" + traceFileTypeFilter(c) + " GROUP BY HOST"
+		},
+		Enabled: func(c *Config) bool {
+			return c.MetricsBuilderConfig.Metrics.SaphanaTraceFileCount.Enabled
+		},
+	},
+	{
+		orderedResourceLabels: []string{"host"},
+		orderedMetricLabels:   []string{"trace_file"},
+		orderedStats: []queryStat{
+			{
+				key: "file_size",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaTraceFileSizeDataPoint(now, val, row["trace_file"])
+				},
+			},
+		},
+		buildQuery: func(c *Config) string {
+			return fmt.Sprintf("SELECT TOP %d HOST, FILE_NAME, FILE_SIZE FROM SYS.M_TRACEFILES", c.TraceFiles.Limit) +
+				traceFileTypeFilter(c) + " ORDER BY FILE_SIZE DESC"
+		},
+		Enabled: func(c *Config) bool {
+			return c.MetricsBuilderConfig.Metrics.SaphanaTraceFileSize.Enabled
+		},
+	},
 	{
 		query:                 "SELECT HOST, \"PATH\", \"TYPE\", SUM(TOTAL_READS) \"reads\", SUM(TOTAL_WRITES) writes, SUM(TOTAL_READ_SIZE) read_size, SUM(TOTAL_WRITE_SIZE) write_size, SUM(TOTAL_READ_TIME) read_time, SUM(TOTAL_WRITE_TIME) write_time FROM SYS.M_VOLUME_IO_TOTAL_STATISTICS GROUP BY HOST, \"PATH\", \"TYPE\"",
 		orderedResourceLabels: []string{"host"},
@@ -821,12 +893,53 @@ var queries = []monitoringQuery{
 				c.MetricsBuilderConfig.Metrics.SaphanaCPUUsed.Enabled
 		},
 	},
+	{
+		query:                 "SELECT HOST, TOTAL_CPU_USER_TIME, TOTAL_CPU_SYSTEM_TIME, TOTAL_CPU_IDLE_TIME, TOTAL_CPU_WIO_TIME FROM SYS.M_HOST_RESOURCE_UTILIZATION",
+		orderedResourceLabels: []string{"host"},
+		orderedStats: []queryStat{
+			{
+				key: "cpu_user",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaHostCPUTimeDataPoint(now, val, row["host"], metadata.AttributeCPUStateUser)
+				},
+			},
+			{
+				key: "cpu_system",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaHostCPUTimeDataPoint(now, val, row["host"], metadata.AttributeCPUStateSystem)
+				},
+			},
+			{
+				key: "cpu_idle",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaHostCPUTimeDataPoint(now, val, row["host"], metadata.AttributeCPUStateIdle)
+				},
+			},
+			{
+				key: "cpu_iowait",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaHostCPUTimeDataPoint(now, val, row["host"], metadata.AttributeCPUStateIowait)
+				},
+			},
+		},
+		Enabled: func(c *Config) bool {
+			return c.MetricsBuilderConfig.Metrics.SaphanaHostCPUTime.Enabled
+		},
+	},
 }
 
 func (m *monitoringQuery) CollectMetrics(ctx context.Context, s *sapHanaScraper, client client, now pcommon.Timestamp,
 	errs *scrapererror.ScrapeErrors) {
 	rows, err := client.collectDataFromQuery(ctx, m)
 	if err != nil {
+		if m.optional && isInvalidTableError(err) {
+			s.settings.Logger.Debug("Skipping query against monitoring view unavailable on this SAP HANA version", zap.String("query", m.query), zap.Error(err))
+			return
+		}
 		errs.AddPartial(len(m.orderedStats), fmt.Errorf("error running query '%s': %w", m.query, err))
 		return
 	}
@@ -838,3 +951,26 @@ func (m *monitoringQuery) CollectMetrics(ctx context.Context, s *sapHanaScraper,
 		}
 	}
 }
+
+// traceFileTypeFilter returns a WHERE clause matching the configured trace file
+// types against the FILE_NAME extension. The types are validated to only
+// contain word characters, so they can be inlined into the query.
+func traceFileTypeFilter(c *Config) string {
+	if len(c.TraceFiles.Types) == 0 {
+		return ""
+	}
+	conditions := make([]string, 0, len(c.TraceFiles.Types))
+	for _, t := range c.TraceFiles.Types {
+		conditions = append(conditions, "FILE_NAME LIKE '%."+t+"'")
+	}
+	return " WHERE " + strings.Join(conditions, " OR ")
+}
+
+// sqlErrInvalidTableName is the SAP HANA error code returned when a query
+// references a table or view that does not exist.
+const sqlErrInvalidTableName = 259
+
+func isInvalidTableError(err error) bool {
+	var dbErr sapdriver.DBError
+	return errors.As(err, &dbErr) && dbErr.Code() == sqlErrInvalidTableName
+}
@@ -56,7 +56,7 @@ func (s *sapHanaScraper) getMetricsBuilder(resourceAttributes map[string]string)
 // Scrape is called periodically, querying SAP HANA and building Metrics to send to
 // the next consumer.
 func (s *sapHanaScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	client := newSapHanaClient(s.cfg, s.factory)
+	client := newSapHanaClient(s.cfg, s.factory, s.settings.Logger)
 	if err := client.Connect(ctx); err != nil {
 		return pmetric.NewMetrics(), err
 	}
@@ -68,6 +68,9 @@ func (s *sapHanaScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 
 	for _, query := range queries {
 		if query.Enabled == nil || query.Enabled(s.cfg) {
+			if query.buildQuery != nil {
+				query.query = query.buildQuery(s.cfg)
+			}
 			query.CollectMetrics(ctx, s, client, now, errs)
 		}
 	}
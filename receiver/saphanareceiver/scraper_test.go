@@ -6,7 +6,9 @@ package saphanareceiver // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saphanareceiver/internal/metadata"
 )
 
 const fullExpectedMetricsPath = "./testdata/expected_metrics/full.yaml"
@@ -107,6 +110,225 @@ func TestDisabledMetrics(t *testing.T) {
 		pmetrictest.IgnoreResourceMetricsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 }
 
+func TestNetworkBytes(t *testing.T) {
+	t.Parallel()
+
+	dbWrapper := &testDBWrapper{}
+	dbWrapper.On("PingContext").Return(nil)
+	dbWrapper.On("Close").Return(nil)
+	dbWrapper.mockQueryResult(networkIOQuery(t), [][]*string{
+		{str("host"), str("indexserver"), str("1024"), str("2048")},
+		{str("host"), str("nameserver"), str("10"), str("20")},
+	}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{
+		SaphanaNetworkBytes: metadata.MetricConfig{Enabled: true},
+	}
+
+	sc, err := newSapHanaScraper(receivertest.NewNopCreateSettings(), cfg, &testConnectionFactory{dbWrapper})
+	require.NoError(t, err)
+
+	actualMetrics, err := sc.Scrape(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, 1, actualMetrics.MetricCount())
+	metric := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "saphana.network.bytes", metric.Name())
+
+	bytesByServiceAndDirection := map[string]int64{}
+	dps := metric.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		service, _ := dps.At(i).Attributes().Get("service")
+		direction, _ := dps.At(i).Attributes().Get("direction")
+		bytesByServiceAndDirection[service.Str()+"/"+direction.Str()] = dps.At(i).IntValue()
+	}
+	require.Equal(t, map[string]int64{
+		"indexserver/transmit": 1024,
+		"indexserver/receive":  2048,
+		"nameserver/transmit":  10,
+		"nameserver/receive":   20,
+	}, bytesByServiceAndDirection)
+}
+
+func TestNetworkBytesUnavailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc        string
+		err         error
+		expectError bool
+	}{
+		{
+			desc:        "view missing on older versions",
+			err:         &testDBError{code: sqlErrInvalidTableName},
+			expectError: false,
+		},
+		{
+			desc:        "insufficient privilege",
+			err:         &testDBError{code: 258},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			dbWrapper := &testDBWrapper{}
+			dbWrapper.On("PingContext").Return(nil)
+			dbWrapper.On("Close").Return(nil)
+			dbWrapper.mockQueryResult(networkIOQuery(t), nil, tc.err)
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{
+				SaphanaNetworkBytes: metadata.MetricConfig{Enabled: true},
+			}
+
+			sc, err := newSapHanaScraper(receivertest.NewNopCreateSettings(), cfg, &testConnectionFactory{dbWrapper})
+			require.NoError(t, err)
+
+			actualMetrics, err := sc.Scrape(context.Background())
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, 0, actualMetrics.MetricCount())
+		})
+	}
+}
+
+func TestTraceFiles(t *testing.T) {
+	t.Parallel()
+
+	dbWrapper := &testDBWrapper{}
+	dbWrapper.On("PingContext").Return(nil)
+	dbWrapper.On("Close").Return(nil)
+	dbWrapper.mockQueryResult("SELECT HOST, COUNT(*) trace_files FROM SYS.M_TRACEFILES WHERE FILE_NAME LIKE '%.trc' OR FILE_NAME LIKE '%.gz' GROUP BY HOST", [][]*string{
+		{str("host"), str("42")},
+	}, nil)
+	dbWrapper.mockQueryResult("SELECT TOP 3 HOST, FILE_NAME, FILE_SIZE FROM SYS.M_TRACEFILES WHERE FILE_NAME LIKE '%.trc' OR FILE_NAME LIKE '%.gz' ORDER BY FILE_SIZE DESC", [][]*string{
+		{str("host"), str("indexserver_host.30003.000.trc"), str("53687091200")},
+		{str("host"), str("indexserver_host.30003.executed_statements.000.trc"), str("21474836480")},
+		{str("host"), str("nameserver_host.30001.000.trc.gz"), str("4294967296")},
+	}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{
+		SaphanaTraceFileCount: metadata.MetricConfig{Enabled: true},
+		SaphanaTraceFileSize:  metadata.MetricConfig{Enabled: true},
+	}
+	cfg.TraceFiles = TraceFilesConfig{
+		Types: []string{"trc", "gz"},
+		Limit: 3,
+	}
+
+	sc, err := newSapHanaScraper(receivertest.NewNopCreateSettings(), cfg, &testConnectionFactory{dbWrapper})
+	require.NoError(t, err)
+
+	actualMetrics, err := sc.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, actualMetrics.MetricCount())
+
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		switch metric.Name() {
+		case "saphana.trace_file.count":
+			require.Equal(t, 1, metric.Sum().DataPoints().Len())
+			require.Equal(t, int64(42), metric.Sum().DataPoints().At(0).IntValue())
+		case "saphana.trace_file.size":
+			sizeByFile := map[string]int64{}
+			dps := metric.Sum().DataPoints()
+			for j := 0; j < dps.Len(); j++ {
+				file, _ := dps.At(j).Attributes().Get("trace_file")
+				sizeByFile[file.Str()] = dps.At(j).IntValue()
+			}
+			require.Equal(t, map[string]int64{
+				"indexserver_host.30003.000.trc":                     53687091200,
+				"indexserver_host.30003.executed_statements.000.trc": 21474836480,
+				"nameserver_host.30001.000.trc.gz":                   4294967296,
+			}, sizeByFile)
+		default:
+			require.Failf(t, "unexpected metric", "metric %s", metric.Name())
+		}
+	}
+}
+
+func TestHostCPUTime(t *testing.T) {
+	t.Parallel()
+
+	dbWrapper := &testDBWrapper{}
+	dbWrapper.On("PingContext").Return(nil)
+	dbWrapper.On("Close").Return(nil)
+	dbWrapper.mockQueryResult("SELECT HOST, TOTAL_CPU_USER_TIME, TOTAL_CPU_SYSTEM_TIME, TOTAL_CPU_IDLE_TIME, TOTAL_CPU_WIO_TIME FROM SYS.M_HOST_RESOURCE_UTILIZATION", [][]*string{
+		{str("host1"), str("1000"), str("200"), str("5000"), str("30")},
+		{str("host2"), str("2000"), str("400"), str("6000"), str("0")},
+	}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{
+		SaphanaHostCPUTime: metadata.MetricConfig{Enabled: true},
+	}
+
+	sc, err := newSapHanaScraper(receivertest.NewNopCreateSettings(), cfg, &testConnectionFactory{dbWrapper})
+	require.NoError(t, err)
+
+	actualMetrics, err := sc.Scrape(context.Background())
+	require.NoError(t, err)
+
+	timeByHostAndState := map[string]int64{}
+	rms := actualMetrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		metrics := rms.At(i).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 1, metrics.Len())
+		require.Equal(t, "saphana.host.cpu.time", metrics.At(0).Name())
+		dps := metrics.At(0).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			host, _ := dps.At(j).Attributes().Get("host")
+			state, _ := dps.At(j).Attributes().Get("state")
+			timeByHostAndState[host.Str()+"/"+state.Str()] = dps.At(j).IntValue()
+		}
+	}
+	require.Equal(t, map[string]int64{
+		"host1/user":   1000,
+		"host1/system": 200,
+		"host1/idle":   5000,
+		"host1/iowait": 30,
+		"host2/user":   2000,
+		"host2/system": 400,
+		"host2/idle":   6000,
+		"host2/iowait": 0,
+	}, timeByHostAndState)
+}
+
+func networkIOQuery(t *testing.T) string {
+	for _, q := range queries {
+		if strings.Contains(q.query, "M_SERVICE_NETWORK_IO") {
+			return q.query
+		}
+	}
+	require.FailNow(t, "no query found for M_SERVICE_NETWORK_IO")
+	return ""
+}
+
+// testDBError mimics the errors returned by the SAP HANA driver.
+type testDBError struct {
+	code int
+}
+
+func (e *testDBError) Error() string   { return fmt.Sprintf("SQL Error %d", e.code) }
+func (e *testDBError) StmtNo() int     { return 0 }
+func (e *testDBError) Code() int       { return e.code }
+func (e *testDBError) Position() int   { return 0 }
+func (e *testDBError) Level() int      { return 1 }
+func (e *testDBError) Text() string    { return "" }
+func (e *testDBError) IsWarning() bool { return false }
+func (e *testDBError) IsError() bool   { return true }
+func (e *testDBError) IsFatal() bool   { return false }
+
 type queryJSON struct {
 	Query  string
 	Result [][]string